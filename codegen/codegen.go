@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"github.com/zzl/go-tlbimp/typelib"
 	"github.com/zzl/go-tlbimp/utils"
-	"github.com/zzl/go-win32api/v2/win32"
 	"io/ioutil"
 	"os"
 	"path"
@@ -13,60 +12,176 @@ import (
 )
 
 type Generator struct {
-	TypeLib    *typelib.TypeLib
-	RefLibMap  map[string]*typelib.TypeLib
+	TypeLib    typelib.Source
+	RefLibMap  map[string]typelib.Source
 	OutputPath string
 
-	codeMap map[string]string
+	// ImpPkgMap maps the LIBIDs of imported libraries to the packages
+	// generated for them, besides those of RefLibMap. The libraries whose
+	// types are used but have no package are reported.
+	ImpPkgMap map[typelib.GUID]string
+
+	// TypeHook, if set, is called with each type of the library before
+	// code is generated. It may change the type and its members, e.g. by
+	// their custom data, or return false to leave the type out.
+	TypeHook func(ti *typelib.TypeInfo) bool
+
+	// TypeMap, if set, overrides how the types it has are mapped to Go.
+	TypeMap *TypeMap
+
+	// ConstEnums generates the enums as named types with typed constants,
+	// a String method, an <Enum>Values func and a Parse<Enum> func,
+	// instead of the fields of an <Enum>Enum variable.
+	ConstEnums bool
+
+	// Archs are the GOARCHs to generate for, the one of the library's
+	// SYSKIND if empty. The files that differ between them are written
+	// once per arch, with a build constraint.
+	Archs []string
+
+	typeInfos []*typelib.TypeInfo
+	codeMap   map[string]string
+	arch      string
 
 	ownClassSet    map[string]bool
 	sourceClassSet map[string]bool
+	win32Set       map[string]bool //own and referenced types of win32
 
 	refClassMap     map[string]string //name:pkg
 	usedRefClassMap map[string]string
+	usedRefTypeMap  map[string]string //records, unions and aliases
+
+	constNameCount map[string]int //for ConstEnums
 }
 
 func (this *Generator) Generate() {
 
-	this.prepareRefInfo()
-	this.prepareOwnInfo()
+	archs := this.Archs
+	if len(archs) == 0 {
+		archs = []string{this.TypeLib.GetLibAttr().Arch()}
+	}
 
 	this.OutputPath = strings.ReplaceAll(this.OutputPath, "\\", "/")
 	this.cleanOutputDir()
 
-	this.codeMap = make(map[string]string)
-	tiCount := this.TypeLib.GetTypeInfoCount()
-	for n := 0; n < tiCount; n++ {
-		ti := this.TypeLib.GetTypeInfo(n)
-		this.genType(ti)
+	archCodeMaps := make(map[string]map[string]string)
+	for n, arch := range archs {
+		this.arch = arch
+		utils.SetArch(arch)
+
+		this.prepareRefInfo()
+		this.loadTypeInfos(n == 0)
+		this.prepareWin32Info()
+		this.applyTypeMap()
+		this.prepareOwnInfo()
+		this.prepareEnumInfo()
+		this.prepareImpInfo(n == 0)
+
+		this.codeMap = make(map[string]string)
+		this.genLibInfo()
+		for _, ti := range this.typeInfos {
+			this.genType(ti)
+		}
+		archCodeMaps[arch] = this.codeMap
 	}
 
-	this.writeCodes()
+	this.writeCodes(archs, archCodeMaps)
+}
+
+//go:generate go run knowntypes_gen.go
+
+// isWin32Type tells whether the type of the name, of the library or of a
+// referenced one, is taken from go-win32api
+func (this *Generator) isWin32Type(typeName string) bool {
+	return this.win32Set[typeName]
+}
+
+// isWin32VarType tells whether the user defined type t refers to is taken
+// from go-win32api, as told by its TypeInfo, or else by its name, and
+// for records and unions, which have no IID, by their kind
+func (this *Generator) isWin32VarType(t *typelib.VarType) bool {
+	if t.TypeInfo != nil {
+		return isWin32TypeInfo(t.TypeInfo)
+	}
+	if t.Vt == typelib.VT_USERDEFINED && (t.Kind == typelib.TKIND_RECORD ||
+		t.Kind == typelib.TKIND_UNION) {
+		iid, ok := win32Types[t.Name]
+		return ok && iid == ""
+	}
+	return this.isWin32Type(t.Name)
 }
 
-func isWin32Type(typeName string) bool {
-	switch typeName {
-	case "IUnknown", "ISequentialStream", "IStream", "IDispatch",
-		"DISPPARAMS", "EXCEPINFO":
-		return true
+// isWin32TypeInfo tells whether go-win32api has a type of the same kind
+// as ti, an interface with its name and IID or a record or union with
+// its name
+func isWin32TypeInfo(ti *typelib.TypeInfo) bool {
+	if ti.Name == "" { //of a library that was not read
+		return false
+	}
+	iid, ok := win32Types[utils.CapName(ti.Name)]
+	switch ti.Kind {
+	case typelib.TKIND_INTERFACE:
+		return ok && iid == ti.Guid.String()
+	case typelib.TKIND_RECORD, typelib.TKIND_UNION:
+		return ok && iid == ""
 	}
 	return false
 }
 
+// prepareWin32Info finds the types of the library and of the referenced
+// ones that go-win32api has
+func (this *Generator) prepareWin32Info() {
+	this.win32Set = make(map[string]bool)
+	for _, tlb := range this.RefLibMap {
+		for n := 0; n < tlb.GetTypeInfoCount(); n++ {
+			if ti, err := tlb.GetTypeInfo(n); err == nil && isWin32TypeInfo(ti) {
+				this.win32Set[utils.CapName(ti.Name)] = true
+			}
+		}
+	}
+	for _, ti := range this.typeInfos {
+		this.win32Set[utils.CapName(ti.Name)] = isWin32TypeInfo(ti)
+	}
+}
+
+// loadTypeInfos loads the types of the library, leaving out those that
+// cannot be read; their members that cannot be are skipped
+func (this *Generator) loadTypeInfos(report bool) {
+	graph := typelib.NewGraph(this.TypeLib)
+	this.typeInfos = nil
+	if report {
+		for _, err := range graph.Errors {
+			println("warning: skipped type " + err.Error())
+		}
+	}
+	for _, ti := range graph.Types {
+		if ti == nil {
+			continue
+		}
+		if report {
+			for _, err := range ti.Errors {
+				println("warning: skipped member " + err.Error())
+			}
+		}
+		if this.TypeHook != nil && !this.TypeHook(ti) {
+			continue
+		}
+		this.typeInfos = append(this.typeInfos, ti)
+	}
+}
+
 func (this *Generator) prepareOwnInfo() {
 	this.ownClassSet = make(map[string]bool)
 	this.sourceClassSet = make(map[string]bool)
-	tiCount := this.TypeLib.GetTypeInfoCount()
-	for n := 0; n < tiCount; n++ {
-		ti := this.TypeLib.GetTypeInfo(n)
-		if ti.Kind == win32.TKIND_COCLASS ||
-			ti.Kind == win32.TKIND_INTERFACE ||
-			ti.Kind == win32.TKIND_DISPATCH {
-			if !isWin32Type(ti.Name) {
+	for _, ti := range this.typeInfos {
+		if ti.Kind == typelib.TKIND_COCLASS ||
+			ti.Kind == typelib.TKIND_INTERFACE ||
+			ti.Kind == typelib.TKIND_DISPATCH {
+			if !this.isWin32Type(utils.CapName(ti.Name)) {
 				this.ownClassSet[utils.CapName(ti.Name)] = true
 			}
 		}
-		if ti.Kind == win32.TKIND_COCLASS {
+		if ti.Kind == typelib.TKIND_COCLASS {
 			for _, it := range ti.ImplTypes {
 				if it.Source {
 					this.sourceClassSet[utils.CapName(it.Name)] = true
@@ -79,14 +194,18 @@ func (this *Generator) prepareOwnInfo() {
 func (this *Generator) prepareRefInfo() {
 	this.refClassMap = make(map[string]string)
 	this.usedRefClassMap = make(map[string]string)
+	this.usedRefTypeMap = make(map[string]string)
 
 	for pkg, tlb := range this.RefLibMap {
 		tiCount := tlb.GetTypeInfoCount()
 		for n := 0; n < tiCount; n++ {
-			ti := tlb.GetTypeInfo(n)
-			if ti.Kind == win32.TKIND_COCLASS ||
-				ti.Kind == win32.TKIND_INTERFACE ||
-				ti.Kind == win32.TKIND_DISPATCH {
+			ti, err := tlb.GetTypeInfo(n)
+			if err != nil {
+				continue
+			}
+			if ti.Kind == typelib.TKIND_COCLASS ||
+				ti.Kind == typelib.TKIND_INTERFACE ||
+				ti.Kind == typelib.TKIND_DISPATCH {
 				name := utils.CapName(ti.Name)
 				if !this.ownClassSet[name] {
 					this.refClassMap[name] = pkg
@@ -96,12 +215,129 @@ func (this *Generator) prepareRefInfo() {
 	}
 }
 
-func (this *Generator) writeCodes() {
+// prepareImpInfo walks the types used from imported libraries, to take
+// them from the packages generated for those, and reports the libraries
+// that have no package or whose types could not be resolved.
+func (this *Generator) prepareImpInfo(report bool) {
+	var libs []typelib.ImpLib
+	userMap := make(map[typelib.ImpLib][]string) //lib:names of own types
+	unresolvedSet := make(map[typelib.ImpLib]bool)
+
+	use := func(lib *typelib.ImpLib, name string, class bool, native bool, user string) {
+		users, ok := userMap[*lib]
+		if !ok {
+			libs = append(libs, *lib)
+		}
+		if len(users) == 0 || users[len(users)-1] != user {
+			userMap[*lib] = append(users, user)
+		}
+		pkg := this.impPkg(lib)
+		if name == "" { //placeholder
+			unresolvedSet[*lib] = true
+		} else if pkg == "" || native || this.ownClassSet[name] {
+			//
+		} else if class {
+			this.refClassMap[name] = pkg
+		} else {
+			this.usedRefTypeMap[name] = pkg
+		}
+	}
+	visit := func(t *typelib.VarType, user string) {
+		for ; t != nil; t = t.RefType {
+			if t.ImpLib != nil && t.Vt == typelib.VT_UNKNOWN {
+				use(t.ImpLib, "", false, false, user) //unresolved
+			} else if t.ImpLib != nil && !strings.HasPrefix(t.Name, "win32.") &&
+				!this.isWin32VarType(t) {
+				use(t.ImpLib, t.Name, t.Interface, t.Native && !t.Enum, user)
+			}
+		}
+	}
+	for _, ti := range this.typeInfos {
+		user := utils.CapName(ti.Name)
+		if ti.Super != nil && ti.Super.ImpLib != nil && !isWin32TypeInfo(ti.Super) {
+			superName := ti.Super.Name
+			if superName != "" {
+				superName = utils.CapName(superName)
+			}
+			use(ti.Super.ImpLib, superName, true, false, user)
+			if pkg := this.refClassMap[superName]; pkg != "" {
+				this.usedRefClassMap[superName] = pkg
+			}
+		}
+		visit(ti.RelType, user)
+		for _, f := range ti.Fields {
+			visit(f.Type, user)
+		}
+		for _, f := range ti.Funcs {
+			visit(f.ReturnType, user)
+			for _, p := range f.Params {
+				visit(p.Type, user)
+			}
+		}
+	}
+	if !report {
+		return
+	}
+	for _, lib := range libs {
+		users := strings.Join(userMap[lib], ", ")
+		if this.impPkg(&lib) == "" && !lib.Guid.IsNull() {
+			println("warning: imported library " + lib.String() + " is not mapped to a package, used by " + users)
+		} else if unresolvedSet[lib] {
+			println("warning: types of imported library " + lib.String() + " could not be resolved, used by " + users)
+		}
+	}
+}
+
+// impPkg returns the package generated for an imported library
+func (this *Generator) impPkg(lib *typelib.ImpLib) string {
+	if lib.Guid.IsNull() {
+		return ""
+	}
+	if pkg := this.ImpPkgMap[lib.Guid]; pkg != "" {
+		return pkg
+	}
+	for pkg, tlb := range this.RefLibMap {
+		if tlb.GetLibAttr().Guid == lib.Guid {
+			return pkg
+		}
+	}
+	return ""
+}
+
+// writeCodes writes the files generated for each arch, those that
+// are not the same for all of them, or not generated for all of them,
+// as <name>_<arch>.go
+func (this *Generator) writeCodes(archs []string, archCodeMaps map[string]map[string]string) {
 	pkgName := path.Base(this.OutputPath)
-	for name, code := range this.codeMap {
-		code := "package " + pkgName + "\n\n" + genImports(code) + code
-		filePath := path.Join(this.OutputPath, name+".go")
-		ioutil.WriteFile(filePath, []byte(code), os.ModePerm)
+	nameSet := make(map[string]bool)
+	for _, arch := range archs {
+		for name := range archCodeMaps[arch] {
+			nameSet[name] = true
+		}
+	}
+	for name := range nameSet {
+		code, same := archCodeMaps[archs[0]][name]
+		for _, arch := range archs[1:] {
+			if archCode, ok := archCodeMaps[arch][name]; !ok || archCode != code {
+				same = false
+				break
+			}
+		}
+		if same {
+			code := "package " + pkgName + "\n\n" + genImports(code) + code
+			filePath := path.Join(this.OutputPath, name+".go")
+			ioutil.WriteFile(filePath, []byte(code), os.ModePerm)
+			continue
+		}
+		for _, arch := range archs {
+			code, ok := archCodeMaps[arch][name]
+			if !ok {
+				continue
+			}
+			code = "//go:build " + arch + "\n\npackage " + pkgName + "\n\n" + genImports(code) + code
+			filePath := path.Join(this.OutputPath, name+"_"+arch+".go")
+			ioutil.WriteFile(filePath, []byte(code), os.ModePerm)
+		}
 	}
 	refsCode := this.genRefsCode()
 	if refsCode != "" {
@@ -111,19 +347,19 @@ func (this *Generator) writeCodes() {
 }
 
 func (this *Generator) genRefsCode() string {
-	if len(this.usedRefClassMap) == 0 {
+	if len(this.usedRefClassMap) == 0 && len(this.usedRefTypeMap) == 0 {
 		return ""
 	}
 	pkgSet := make(map[string]bool)     //pkg
 	classMap := make(map[string]string) //class:pkg_last
+	typeMap := make(map[string]string)  //type:pkg_last
 	for className, pkg := range this.usedRefClassMap {
 		pkgSet[pkg] = true
-		pos := strings.LastIndexByte(pkg, '/')
-		pkgLast := pkg
-		if pos != -1 {
-			pkgLast = pkg[pos+1:]
-		}
-		classMap[className] = pkgLast
+		classMap[className] = pkgLastName(pkg)
+	}
+	for typeName, pkg := range this.usedRefTypeMap {
+		pkgSet[pkg] = true
+		typeMap[typeName] = pkgLastName(pkg)
 	}
 	var code string
 	pkgName := path.Base(this.OutputPath)
@@ -136,13 +372,24 @@ func (this *Generator) genRefsCode() string {
 
 	for className, pkgLast := range classMap {
 		code += "type " + className + " = " + pkgLast + "." + className + "\n"
-		if !isWin32Type(className) {
+		if !this.isWin32Type(className) {
 			code += "var New" + className + " = " + pkgLast + ".New" + className + "\n\n"
 		}
 	}
+	for typeName, pkgLast := range typeMap {
+		code += "type " + typeName + " = " + pkgLast + "." + typeName + "\n"
+	}
 	return code
 }
 
+func pkgLastName(pkg string) string {
+	pos := strings.LastIndexByte(pkg, '/')
+	if pos != -1 {
+		return pkg[pos+1:]
+	}
+	return pkg
+}
+
 func (this *Generator) cleanOutputDir() {
 	fis, _ := ioutil.ReadDir(this.OutputPath)
 	for _, fi := range fis {
@@ -165,6 +412,9 @@ func genImports(code string) string {
 	if strings.Contains(code, "ole.") {
 		imports += "\t\"github.com/zzl/go-com/ole\"\n"
 	}
+	if strings.Contains(code, "windows.NewLazyDLL") {
+		imports += "\t\"golang.org/x/sys/windows\"\n"
+	}
 	if strings.Contains(code, "syscall") {
 		imports += "\t\"syscall\"\n"
 	}
@@ -180,36 +430,82 @@ func genImports(code string) string {
 	if strings.Contains(code, "reflect.") {
 		imports += "\t\"reflect\"\n"
 	}
+	if strings.Contains(code, "strconv.") {
+		imports += "\t\"strconv\"\n"
+	}
+	if strings.Contains(code, "strings.Split(") {
+		imports += "\t\"strings\"\n"
+	}
+	if strings.Contains(code, "errors.New(") {
+		imports += "\t\"errors\"\n"
+	}
+	if strings.Contains(code, "math.") {
+		imports += "\t\"math\"\n"
+	}
 	if imports != "" {
 		imports = "import (\n" + imports + ")\n\n"
 	}
 	return imports
 }
 
+// genLibInfo emits the attributes of the library, for code to tell
+// which version of it the package was generated from
+func (this *Generator) genLibInfo() {
+	lib := this.TypeLib
+	if lib.GetName() == "" {
+		return
+	}
+	attr := lib.GetLibAttr()
+	name := utils.CapName(strings.ReplaceAll(lib.GetName(), ".", "_"))
+
+	code := "// library " + lib.GetName()
+	if doc := lib.GetDoc(); doc != "" {
+		code += ", " + strings.ReplaceAll(doc, "\n", " ")
+	}
+	code += "\n"
+	code += "// " + attr.Guid.String() + "\n"
+	code += "var LIBID_" + name + " = " + utils.BuildGuidExpr(attr.Guid.String()) + "\n\n"
+
+	code += "const (\n"
+	code += "\t" + name + "_MajorVersion = " + strconv.Itoa(int(attr.MajorVer)) + "\n"
+	code += "\t" + name + "_MinorVersion = " + strconv.Itoa(int(attr.MinorVer)) + "\n"
+	code += "\t" + name + "_Lcid = " + strconv.Itoa(int(attr.Lcid)) + "\n"
+	code += "\t" + name + "_SysKind = " + strconv.Itoa(int(attr.SysKind)) + "\n"
+	code += "\t" + name + "_Flags = " + strconv.Itoa(int(attr.Flags)) + "\n"
+	code += "\t" + name + "_HelpString = " + strconv.Quote(lib.GetDoc()) + "\n"
+	code += "\t" + name + "_HelpFile = " + strconv.Quote(lib.GetHelpFile()) + "\n"
+	code += "\t" + name + "_HelpContext = " + strconv.Itoa(int(lib.GetHelpContext())) + "\n"
+	code += ")\n\n"
+
+	this.codeMap["typelib"] = code
+}
+
 func (this *Generator) genType(ti *typelib.TypeInfo) {
 	switch ti.Kind {
-	case win32.TKIND_ENUM:
+	case typelib.TKIND_ENUM:
 		this.genEnum(ti)
-	case win32.TKIND_RECORD:
+	case typelib.TKIND_RECORD:
 		this.genStruct(ti)
-	case win32.TKIND_UNION:
+	case typelib.TKIND_UNION:
 		this.genUnion(ti)
-	case win32.TKIND_ALIAS:
+	case typelib.TKIND_ALIAS:
 		this.genAlias(ti)
-	case win32.TKIND_INTERFACE:
+	case typelib.TKIND_INTERFACE:
 		if strings.HasSuffix(ti.Name, "Handler") { //?
 			this.genHandlerInterface(ti)
 		} else {
 			this.genInterface(ti)
 		}
-	case win32.TKIND_DISPATCH:
+	case typelib.TKIND_DISPATCH:
 		if this.sourceClassSet[utils.CapName(ti.Name)] {
 			this.genSourceDispInterface(ti)
 		} else {
 			this.genDispInterface(ti)
 		}
-	case win32.TKIND_COCLASS:
+	case typelib.TKIND_COCLASS:
 		this.genCoClass(ti)
+	case typelib.TKIND_MODULE:
+		this.genModule(ti)
 	}
 }
 
@@ -231,12 +527,21 @@ type simpleFieldInfo struct {
 }
 
 func (this *Generator) genUnion(ti *typelib.TypeInfo) {
+	if this.isWin32Type(utils.CapName(ti.Name)) {
+		return
+	}
 
 	if strings.Contains(ti.Name, "MIDL_") && strings.Contains(ti.Name, "WinType") {
 		return
 	}
 
 	size, alignSize := ti.Size, ti.Align
+	if this.usesLibLayout() {
+		this.checkLayout(ti, size, utils.GoAlign(alignSize))
+		if ti.SizeInstance > size {
+			size = alignUp(ti.SizeInstance, alignSize)
+		}
+	}
 	var dataFields []simpleFieldInfo
 
 	embedFieldIndex := -1
@@ -313,28 +618,112 @@ func (this *Generator) genUnion(ti *typelib.TypeInfo) {
 }
 
 func (this *Generator) genStruct(ti *typelib.TypeInfo) {
+	if this.isWin32Type(utils.CapName(ti.Name)) {
+		return
+	}
 	code := this.codeMap["types"]
 	code += "// struct " + ti.Name + "\n"
 	name := utils.CapName(ti.Name)
 	code += "type " + name + " struct {\n"
+
+	//the fields are padded to their offsets in C, as the typelib has
+	//them or else as computed, where Go aligns them less
+	libLayout := this.usesLibLayout()
+	offset, align := 0, 1
+	cOffset := 0
 	count := ti.FieldCount
 	for n := 0; n < count; n++ {
 		f := ti.GetField(n)
+		fAlign := utils.GoAlign(f.Type.Align)
+		offset = alignUp(offset, fAlign)
+		if libLayout {
+			cOffset = f.Offset
+		} else if f.Type.Align > 1 {
+			cOffset = alignUp(cOffset, f.Type.Align)
+		}
+		if cOffset > offset {
+			code += "\t_ [" + strconv.Itoa(cOffset-offset) + "]byte\n"
+			offset = alignUp(cOffset, fAlign)
+		}
+		if cOffset != offset {
+			this.warn(fmt.Sprintf("field %s.%s is at offset %d in the typelib, %d in Go",
+				ti.Name, f.Name, cOffset, offset))
+		}
 		code += "\t" + utils.CapName(f.Name) + " " + f.Type.Name + "\n"
+		offset += f.Type.Size
+		cOffset = offset
+		if fAlign > align {
+			align = fAlign
+		}
+	}
+	cSize := ti.Size
+	if libLayout {
+		cSize = ti.SizeInstance
+	}
+	if cSize > alignUp(offset, align) {
+		code += "\t_ [" + strconv.Itoa(cSize-offset) + "]byte\n"
+		offset = cSize
 	}
 	code += "}\n\n"
+	if libLayout {
+		this.checkLayout(ti, alignUp(offset, align), align)
+	}
 	this.codeMap["types"] = code
 }
 
+// usesLibLayout tells if the offsets and sizes of the typelib apply to
+// the generated code, which they do not if the typelib was built for
+// another pointer size
+func (this *Generator) usesLibLayout() bool {
+	switch this.TypeLib.GetLibAttr().SysKind {
+	case typelib.SYS_WIN32:
+		return utils.PtrSize == 4
+	case typelib.SYS_WIN64:
+		return utils.PtrSize == 8
+	}
+	return false
+}
+
+// checkLayout reports a struct or union whose Go layout differs from
+// the one of the typelib, but for the lesser alignment Go gives 8 byte
+// numbers on 386
+func (this *Generator) checkLayout(ti *typelib.TypeInfo, size int, align int) {
+	if ti.SizeInstance == 0 {
+		return
+	}
+	if size != ti.SizeInstance || align != utils.GoAlign(ti.Alignment) {
+		this.warn(fmt.Sprintf("%s is %d bytes aligned to %d in the typelib, %d aligned to %d in Go",
+			ti.Name, ti.SizeInstance, ti.Alignment, size, align))
+	}
+}
+
+func (this *Generator) warn(msg string) {
+	if len(this.Archs) > 1 {
+		msg = this.arch + ": " + msg
+	}
+	println("warning: " + msg)
+}
+
+func alignUp(offset int, align int) int {
+	return (offset + align - 1) / align * align
+}
+
 func (this *Generator) genEnum(ti *typelib.TypeInfo) {
+	if this.ConstEnums {
+		this.genConstEnum(ti)
+		return
+	}
 	code := this.codeMap["enums"]
 
+	name := utils.CapName(ti.Name)
+	baseType := enumBaseType(ti)
 	code += "// enum " + ti.Name + "\n"
-	code += "var " + utils.CapName(ti.Name) + " = struct {\n"
+	code += "type " + name + " " + baseType + "\n\n"
+	code += "var " + name + "Enum = struct {\n"
 	count := ti.FieldCount
 	for n := 0; n < count; n++ {
 		f := ti.GetField(n)
-		code += "\t" + utils.CapName(f.Name) + " " + f.Type.Name + "\n"
+		code += "\t" + utils.CapName(f.Name) + " " + name + "\n"
 	}
 	code += "}{\n"
 	for n := 0; n < count; n++ {
@@ -347,11 +736,266 @@ func (this *Generator) genEnum(ti *typelib.TypeInfo) {
 	this.codeMap["enums"] = code
 }
 
+// enumBaseType returns the int type of the values of an enum
+func enumBaseType(ti *typelib.TypeInfo) string {
+	if len(ti.Fields) > 0 && ti.Fields[0].Type.Native {
+		return ti.Fields[0].Type.Name
+	}
+	return "int32"
+}
+
+// prepareEnumInfo counts the names the constants of enums and modules
+// are declared with, along with those of the types, for genConstEnum to
+// tell which member names clash
+func (this *Generator) prepareEnumInfo() {
+	this.constNameCount = make(map[string]int)
+	for _, ti := range this.typeInfos {
+		this.constNameCount[utils.CapName(ti.Name)]++
+		if ti.Kind == typelib.TKIND_ENUM || ti.Kind == typelib.TKIND_MODULE {
+			for _, f := range ti.Fields {
+				this.constNameCount[utils.CapName(f.Name)]++
+			}
+		}
+	}
+}
+
+// genConstEnum generates an enum as a named type with typed constants,
+// named by the members, or prefixed with the enum name where a member
+// name clashes. String decomposes the values of flags enums, those with
+// three or more single bit values, and other values that are zero or
+// made of those bits, and ParseX takes the names it returns.
+func (this *Generator) genConstEnum(ti *typelib.TypeInfo) {
+	code := this.codeMap["enums"]
+
+	name := utils.CapName(ti.Name)
+	unsigned := len(ti.Fields) > 0 && ti.Fields[0].Type.Unsigned
+	code += "// enum " + ti.Name + "\n"
+	code += "type " + name + " " + enumBaseType(ti) + "\n\n"
+
+	var constNames, memberNames []string
+	var distinct []int //indexes of the first member of each value
+	valueSet := make(map[string]bool)
+	var masks []int64 //values of more than one bit
+	var bitMask int64
+	flags := true
+	bitCount := 0
+	code += "const (\n"
+	for n, f := range ti.Fields {
+		memberName := utils.CapName(f.Name)
+		constName := memberName
+		if this.constNameCount[memberName] > 1 {
+			constName = name + "_" + memberName
+		}
+		constNames = append(constNames, constName)
+		memberNames = append(memberNames, memberName)
+
+		sValue, _ := goValueExpr(f.Value)
+		code += "\t" + constName + " " + name + " = " + sValue + "\n"
+		if !valueSet[sValue] {
+			valueSet[sValue] = true
+			distinct = append(distinct, n)
+		}
+		value, _ := strconv.ParseInt(sValue, 10, 64)
+		if value < 0 {
+			flags = false
+		} else if value&(value-1) != 0 {
+			masks = append(masks, value)
+		} else if value != 0 && bitMask&value == 0 {
+			bitMask |= value
+			bitCount++
+		}
+	}
+	code += ")\n\n"
+	for _, mask := range masks {
+		if mask&^bitMask != 0 {
+			flags = false
+		}
+	}
+	flags = flags && bitCount > 2
+
+	code += "func (this " + name + ") String() string {\n"
+	code += "\tswitch this {\n"
+	for _, n := range distinct {
+		code += "\tcase " + constNames[n] + ":\n"
+		code += "\t\treturn \"" + memberNames[n] + "\"\n"
+	}
+	code += "\t}\n"
+	if flags {
+		var bits []string
+		for _, n := range distinct {
+			sValue, _ := goValueExpr(ti.Fields[n].Value)
+			if value, _ := strconv.ParseInt(sValue, 10, 64); value != 0 &&
+				value&(value-1) == 0 {
+				bits = append(bits, constNames[n])
+			}
+		}
+		code += "\tvar s string\n"
+		code += "\tv := this\n"
+		code += "\tfor _, bit := range []" + name + "{" + strings.Join(bits, ", ") + "} {\n"
+		code += "\t\tif v&bit != 0 {\n"
+		code += "\t\t\tif s != \"\" {\n"
+		code += "\t\t\t\ts += \"|\"\n"
+		code += "\t\t\t}\n"
+		code += "\t\t\ts += bit.String()\n"
+		code += "\t\t\tv &^= bit\n"
+		code += "\t\t}\n"
+		code += "\t}\n"
+		code += "\tif v != 0 || s == \"\" {\n"
+		code += "\t\tif s != \"\" {\n"
+		code += "\t\t\ts += \"|\"\n"
+		code += "\t\t}\n"
+		code += "\t\ts += \"" + name + "(0x\" + strconv.FormatUint(uint64(v), 16) + \")\"\n"
+		code += "\t}\n"
+		code += "\treturn s\n"
+	} else if unsigned {
+		code += "\treturn \"" + name + "(\" + strconv.FormatUint(uint64(this), 10) + \")\"\n"
+	} else {
+		code += "\treturn \"" + name + "(\" + strconv.FormatInt(int64(this), 10) + \")\"\n"
+	}
+	code += "}\n\n"
+
+	var values []string
+	for _, n := range distinct {
+		values = append(values, constNames[n])
+	}
+	code += "// " + name + "Values returns the values of " + name + "\n"
+	code += "func " + name + "Values() []" + name + " {\n"
+	code += "\treturn []" + name + "{" + strings.Join(values, ", ") + "}\n"
+	code += "}\n\n"
+
+	code += "// Parse" + name + " returns the value of " + name + " of a name"
+	if flags {
+		code += ", or of\n// names joined by |\n"
+	} else {
+		code += "\n"
+	}
+	code += "func Parse" + name + "(name string) (" + name + ", error) {\n"
+	if flags {
+		code += "\tvar v " + name + "\n"
+		code += "\tfor _, s := range strings.Split(name, \"|\") {\n"
+		code += "\t\tswitch s {\n"
+		for n, constName := range constNames {
+			code += "\t\tcase \"" + memberNames[n] + "\":\n"
+			code += "\t\t\tv |= " + constName + "\n"
+		}
+		code += "\t\tdefault:\n"
+		code += "\t\t\treturn 0, errors.New(\"unknown " + name + ": \" + s)\n"
+		code += "\t\t}\n"
+		code += "\t}\n"
+		code += "\treturn v, nil\n"
+	} else {
+		code += "\tswitch name {\n"
+		for n, constName := range constNames {
+			code += "\tcase \"" + memberNames[n] + "\":\n"
+			code += "\t\treturn " + constName + ", nil\n"
+		}
+		code += "\t}\n"
+		code += "\treturn 0, errors.New(\"unknown " + name + ": \" + name)\n"
+	}
+	code += "}\n\n"
+
+	this.codeMap["enums"] = code
+}
+
+// genModule generates the constants of a module, and its functions
+// calling the dll entries, which are loaded on first use
+func (this *Generator) genModule(ti *typelib.TypeInfo) {
+	code := this.codeMap["modules"]
+	code += "// module " + ti.Name + "\n"
+
+	var consts string
+	for _, f := range ti.Fields {
+		expr, ok := goValueExpr(f.Value)
+		if !ok {
+			continue
+		}
+		if f.Doc != "" {
+			consts += "\t// " + strings.ReplaceAll(f.Doc, "\n", " ") + "\n"
+		}
+		consts += "\t" + utils.CapName(f.Name)
+		switch f.Value.(type) {
+		case string, bool:
+		default:
+			if f.Type.Native {
+				consts += " " + f.Type.Name
+			}
+		}
+		consts += " = " + expr + "\n"
+	}
+	if consts != "" {
+		code += "const (\n" + consts + ")\n\n"
+	}
+
+	if ti.DllName == "" || len(ti.Funcs) == 0 {
+		this.codeMap["modules"] = code
+		return
+	}
+	name := utils.CapName(ti.Name)
+	libVar := "lib" + name
+	code += "var " + libVar + " = windows.NewLazyDLL(" + strconv.Quote(ti.DllName) + ")\n\n"
+
+	var procs string
+	for _, f := range ti.Funcs {
+		entry := moduleFuncEntry(f)
+		if entry == "" {
+			continue
+		}
+		procs += "\tproc" + name + "_" + utils.CapName(f.Name) + " = " +
+			libVar + ".NewProc(" + strconv.Quote(entry) + ")\n"
+	}
+	if procs != "" {
+		code += "var (\n" + procs + ")\n\n"
+	}
+
+	for _, f := range ti.Funcs {
+		var addrExpr string
+		if moduleFuncEntry(f) != "" {
+			addrExpr = "proc" + name + "_" + utils.CapName(f.Name) + ".Addr()"
+		} else {
+			addrExpr = "procAddrByOrdinal(" + libVar + ", " + strconv.Itoa(f.Ordinal) + ")"
+			if !strings.Contains(code, "func procAddrByOrdinal(") {
+				code += genProcAddrByOrdinal()
+			}
+		}
+		if f.Doc != "" {
+			code += "// " + strings.ReplaceAll(f.Doc, "\n", " ") + "\n"
+		}
+		code += this.genSyscallFunc("", utils.CapName(f.Name), addrExpr,
+			f.Params, f.ReturnType, false)
+	}
+	this.codeMap["modules"] = code
+}
+
+// moduleFuncEntry returns the name a module func is exported by, that of
+// the func if the typelib gives neither an entry nor an ordinal
+func moduleFuncEntry(f *typelib.FuncInfo) string {
+	if f.DllEntry == "" && f.Ordinal == 0 {
+		return f.Name
+	}
+	return f.DllEntry
+}
+
+func genProcAddrByOrdinal() string {
+	code := "// procAddrByOrdinal returns the address of the function a dll exports by ordinal\n"
+	code += "func procAddrByOrdinal(dll *windows.LazyDLL, ordinal uintptr) uintptr {\n"
+	code += "\terr := dll.Load()\n"
+	code += "\tif err == nil {\n"
+	code += "\t\tvar addr uintptr\n"
+	code += "\t\taddr, err = windows.GetProcAddressByOrdinal(windows.Handle(dll.Handle()), ordinal)\n"
+	code += "\t\tif err == nil {\n"
+	code += "\t\t\treturn addr\n"
+	code += "\t\t}\n"
+	code += "\t}\n"
+	code += "\tpanic(err)\n"
+	code += "}\n\n"
+	return code
+}
+
 func (this *Generator) genDispInterface(ti *typelib.TypeInfo) {
 	className := utils.CapName(ti.Name)
 	code := this.codeMap[className]
 
-	sIid, _ := win32.GuidToStr(&ti.Guid)
+	sIid := ti.Guid.String()
 	iidExpr := utils.BuildGuidExpr(sIid)
 	code += "// " + sIid + "\n"
 	code += "var IID_" + className + " = " + iidExpr + "\n\n"
@@ -408,10 +1052,15 @@ func (this *Generator) genDispInterface(ti *typelib.TypeInfo) {
 			setMethods["Set"+utils.CapName(f.Name)] = true
 		}
 	}
+	for _, field := range ti.Fields {
+		if !field.Flags.ReadOnly {
+			setMethods["Set"+utils.CapName(field.Name)] = true
+		}
+	}
 	var colItemType, itemReturnType *typelib.VarType
 	for n := fromFuncIndex; n < count; n++ {
 		f := ti.GetFunc(n)
-		if f.Id == int32(win32.DISPID_VALUE) {
+		if f.Id == typelib.DISPID_VALUE {
 			colItemType = f.ReturnType
 			break
 		}
@@ -423,7 +1072,9 @@ func (this *Generator) genDispInterface(ti *typelib.TypeInfo) {
 		colItemType = itemReturnType
 	}
 	if colItemType == nil {
-		colItemType = &typelib.VarType{Name: "*IUnknown", Pointer: true}
+		colItemType = &typelib.VarType{Name: "*IUnknown", Vt: typelib.VT_PTR, Pointer: true,
+			RefType: &typelib.VarType{Name: "IUnknown", Vt: typelib.VT_USERDEFINED,
+				Kind: typelib.TKIND_INTERFACE, Interface: true}}
 	}
 
 	setNames := make(map[string]bool)
@@ -449,18 +1100,51 @@ func (this *Generator) genDispInterface(ti *typelib.TypeInfo) {
 		}
 		code += this.genDispMethod(f, className, methodType, setMethods)
 
-		if f.Id == win32.DISPID_NEWENUM {
+		if f.Id == typelib.DISPID_NEWENUM {
 			code += this.genForEachEnum(f, className, colItemType)
 		}
 	}
+	for _, field := range ti.Fields {
+		code += this.genDispProperty(field, className, setMethods)
+	}
 	this.codeMap[className] = code
 }
 
+// genDispProperty generates the accessors of a property declared as a
+// variable of a dispinterface, a getter only if it is readonly
+func (this *Generator) genDispProperty(field *typelib.FieldInfo, className string,
+	setMethods map[string]bool) string {
+
+	getter := &typelib.FuncInfo{
+		Id:         field.Id,
+		Name:       "Get" + utils.CapName(field.Name),
+		Doc:        field.Doc,
+		ReturnType: field.Type,
+	}
+	code := this.genDispMethod(getter, className, "PropGet", setMethods)
+	if field.Flags.ReadOnly {
+		return code
+	}
+	setter := &typelib.FuncInfo{
+		Id:   field.Id,
+		Name: field.Name,
+		Doc:  field.Doc,
+		Params: []*typelib.ParamInfo{{
+			Name:  "rhs",
+			Type:  field.Type,
+			Flags: typelib.ParamFlags{In: true},
+		}},
+		ReturnType: &typelib.VarType{Vt: typelib.VT_VOID},
+	}
+	code += this.genDispMethod(setter, className, "PropPut", setMethods)
+	return code
+}
+
 func (this *Generator) genSourceDispInterface(ti *typelib.TypeInfo) {
 	interfaceName := utils.CapName(ti.Name)
 	code := this.codeMap[interfaceName]
 
-	sIid, _ := win32.GuidToStr(&ti.Guid)
+	sIid := ti.Guid.String()
 	iidExpr := utils.BuildGuidExpr(sIid)
 	code += "// " + sIid + "\n"
 	code += "var IID_" + interfaceName + " = " + iidExpr + "\n\n"
@@ -616,15 +1300,26 @@ func (this *Generator) genDispFuncInvoke(f *typelib.FuncInfo, fName string) stri
 		vArg := "vArgs[" + strconv.Itoa(n) + "]"
 
 		aName := "p" + strconv.Itoa(n+1)
-		sArgs += aName
+		if m := this.TypeMap.Lookup(p.Type); m != nil && (m.GoType != "" || m.FromVariant != "") {
+			sArgs += aName
+			code += "\t\t" + aName + " := " + fromVariantExpr(m, p.Type, vArg) + "\n"
+			continue
+		}
+		if p.Type.Enum {
+			sArgs += goParamType + "(" + aName + ")"
+			goParamType = p.Type.Underlying.Name
+		} else {
+			sArgs += aName
+		}
 		if p.Type.Pointer && goParamType != "string" {
 			code += "\t\t" + aName + " := " +
 				"(" + goParamType + ")(" + vArg + ".ToPointer())\n"
 		} else if goParamType == "ole.Variant" {
 			code += "\t\t" + aName + ", _ := " + vArg + "\n"
 		} else {
+			typeName := goParamType[strings.LastIndex(goParamType, ".")+1:] //time.Time:ToTime
 			code += "\t\t" + aName + ", _ := " +
-				"" + vArg + ".To" + utils.CapName(goParamType) + "()\n"
+				"" + vArg + ".To" + utils.CapName(typeName) + "()\n"
 		}
 	}
 	if goReturnType != "" {
@@ -636,7 +1331,8 @@ func (this *Generator) genDispFuncInvoke(f *typelib.FuncInfo, fName string) stri
 	code += sArgs
 	code += ")\n"
 	if goReturnType != "" {
-		code += "\t\tole.SetVariantParam((*ole.Variant)(pVarResult), ret, &unwrapActions)\n"
+		code += "\t\tole.SetVariantParam((*ole.Variant)(pVarResult), " +
+			this.dispArgExpr(f.ReturnType, "ret") + ", &unwrapActions)\n"
 	}
 	code += "\t\treturn win32.S_OK"
 	return code
@@ -704,8 +1400,8 @@ func (this *Generator) genSourceDispMethodImpl(f *typelib.FuncInfo,
 	code += ")\n"
 	code += "\t}\n"
 	if goReturnType != "" {
-		if goReturnType == "win32.HRESULT" {
-			code += "\treturn win32.E_NOTIMPL\n"
+		if f.ReturnType.Vt == typelib.VT_HRESULT {
+			code += "\treturn com.Error(win32.E_NOTIMPL)\n"
 		} else {
 			code += "\tvar ret " + goReturnType + "\n"
 			code += "\treturn ret\n"
@@ -788,10 +1484,26 @@ func (this *Generator) genDispMethod(f *typelib.FuncInfo, className string,
 
 	optParamCount := 0
 	var optArgsVarName string
+	var defaultDocs []string
+	var defaultCode string
 	for n, p := range f.Params {
-		if !p.Flags.Optional {
+		if !isOptParam(p) {
 			continue
 		}
+		if expr, ok := goValueExpr(p.DefaultValue); ok {
+			sIndex := strconv.Itoa(optParamCount)
+			defaultDocs = append(defaultDocs, p.Name+" = "+expr)
+			defaultCode += "\tif optArgs[" + sIndex + "] == nil {\n"
+			defaultCode += "\t\toptArgs[" + sIndex + "] = " + goTypedValueExpr(p.DefaultValue) + "\n"
+			defaultCode += "\t}\n"
+		}
+		if argExpr := this.dispArgExpr(p.Type, "v"); argExpr != "v" {
+			sIndex := strconv.Itoa(optParamCount)
+			goParamType := this.mapOleTypeToGoType(p.Type, false)
+			defaultCode += "\tif v, ok := optArgs[" + sIndex + "].(" + goParamType + "); ok {\n"
+			defaultCode += "\t\toptArgs[" + sIndex + "] = " + argExpr + "\n"
+			defaultCode += "\t}\n"
+		}
 		if optParamCount == 0 {
 			optArgsVarName = className + "_" + fName + "_OptArgs"
 			code += "var " + optArgsVarName + "= []string{\n\t"
@@ -805,15 +1517,18 @@ func (this *Generator) genDispMethod(f *typelib.FuncInfo, className string,
 		code += "\n}\n\n"
 	}
 
+	if defaultDocs != nil {
+		code += "// default values: " + strings.Join(defaultDocs, ", ") + "\n"
+	}
 	code += "func (this *" + className + ") " + fName + "("
 
 	if className == "Range" && fName == "SetItem" {
 		println("?")
 	}
 
-	var reqParamNames []string
+	var reqParamNames, reqArgs []string
 	for _, p := range f.Params {
-		if p.Flags.Optional {
+		if isOptParam(p) {
 			break
 		}
 		if reqParamNames != nil {
@@ -822,6 +1537,7 @@ func (this *Generator) genDispMethod(f *typelib.FuncInfo, className string,
 		pName := utils.UncapName(p.Name)
 		pName = utils.SafeGoName(pName)
 		reqParamNames = append(reqParamNames, pName)
+		reqArgs = append(reqArgs, this.dispArgExpr(p.Type, pName))
 		code += pName + " "
 		goParamType := this.mapOleTypeToGoType(p.Type, false)
 		code += goParamType
@@ -838,6 +1554,7 @@ func (this *Generator) genDispMethod(f *typelib.FuncInfo, className string,
 
 	if optParamCount > 0 {
 		code += "\toptArgs = ole.ProcessOptArgs(" + optArgsVarName + ", optArgs)\n"
+		code += defaultCode
 	}
 
 	if propSet {
@@ -847,7 +1564,7 @@ func (this *Generator) genDispMethod(f *typelib.FuncInfo, className string,
 	}
 
 	if reqParamNames != nil {
-		code += ", []interface{}{" + strings.Join(reqParamNames, ", ") + "}"
+		code += ", []interface{}{" + strings.Join(reqArgs, ", ") + "}"
 	} else {
 		code += ", nil"
 	}
@@ -869,6 +1586,63 @@ func (this *Generator) genDispMethod(f *typelib.FuncInfo, className string,
 	return code
 }
 
+// a [defaultvalue] param may be left out by callers even if not [optional]
+func isOptParam(p *typelib.ParamInfo) bool {
+	return p.Flags.Optional || p.Flags.HasDefault
+}
+
+// goValueExpr returns the Go literal of a constant value.
+func goValueExpr(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case int8, int16, int32, int64, uint8, uint16, uint32, uint64:
+		return fmt.Sprintf("%v", v), true
+	case float32:
+		return strconv.FormatFloat(float64(v), 'g', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), true
+	}
+	return "", false
+}
+
+// goTypedValueExpr returns the expression of a constant value with the
+// Go type it has, for the variant to have the type of the constant.
+func goTypedValueExpr(v interface{}) string {
+	expr, _ := goValueExpr(v)
+	switch v.(type) {
+	case string, bool:
+		return expr
+	}
+	return fmt.Sprintf("%T", v) + "(" + expr + ")"
+}
+
+// dispArgExpr is the argument passed to Invoke for expr of type t. Enums
+// and the types mapped to other Go types are passed as their int type,
+// which variants are made of, or as the toVariant of their mapping.
+func (this *Generator) dispArgExpr(t *typelib.VarType, expr string) string {
+	if m := this.TypeMap.Lookup(t); m != nil && m.ToVariant != "" {
+		return expandTypeExpr(m.ToVariant, expr)
+	} else if m != nil && m.GoType != "" && m.GoType != t.Name {
+		if t.Enum {
+			return t.Underlying.Name + "(" + expr + ")"
+		}
+		return t.Name + "(" + expr + ")"
+	}
+	if t.Enum {
+		return t.Underlying.Name + "(" + expr + ")"
+	}
+	if m := this.TypeMap.LookupPointee(t); m != nil && m.GoType != t.RefType.Name {
+		return "(*" + t.RefType.Name + ")(" + expr + ")"
+	}
+	if t.Pointer && t.RefType != nil && t.RefType.Enum {
+		return "(*" + t.RefType.Underlying.Name + ")(" + expr + ")"
+	}
+	return expr
+}
+
 func (this *Generator) genDispId(f *typelib.FuncInfo) string {
 	var sDispId string
 	if f.Id < 0 {
@@ -884,73 +1658,77 @@ func (this *Generator) mapOleTypeToGoType(varType *typelib.VarType, forReturn bo
 	if oleType == "" { //void
 		return "" //?
 	}
-	var goType string
-	if oleType == "win32.VARIANT_BOOL" {
-		goType = "bool"
-	} else if oleType == "win32.BSTR" {
-		goType = "string"
-	} else if oleType == "ole.Date" {
-		goType = "time.Time"
-	} else if oleType == "win32.HRESULT" {
-		goType = "com.Error"
-	} else if oleType == "*win32.IUnknown" && forReturn {
-		goType = "*com.UnknownClass"
-	} else if oleType == "*win32.IDispatch" && forReturn {
-		goType = "*ole.DispatchClass"
-	} else if oleType == "win32.PWSTR" {
-		goType = "string"
-	} else if oleType[0] == '*' && isWin32Type(oleType[1:]) {
-		goType = "*win32." + oleType[1:]
-	} else if varType.Pointer && varType.RefType.Interface &&
-		!this.ownClassSet[oleType[1:]] {
-		if this.refClassMap[oleType[1:]] != "" {
-			this.usedRefClassMap[oleType[1:]] = this.refClassMap[oleType[1:]]
-			goType = oleType //
-		} else if forReturn {
-			if varType.RefType.DispInterface {
-				goType = "*ole.DispatchClass"
-			} else {
-				goType = "*com.UnknownClass"
-			}
-		} else {
-			if varType.RefType.DispInterface {
-				goType = "*win32.IDispatch"
-			} else {
-				goType = "*win32.IUnknown"
-			}
+	if m := this.TypeMap.Lookup(varType); m != nil && m.GoType != "" {
+		return m.GoType
+	}
+	if m := this.TypeMap.LookupPointee(varType); m != nil {
+		return "*" + m.GoType
+	}
+	pointee := varType.Pointee()
+	switch varType.Vt {
+	case typelib.VT_BOOL:
+		return "bool"
+	case typelib.VT_BSTR, typelib.VT_LPWSTR:
+		return "string"
+	case typelib.VT_DATE:
+		return "time.Time"
+	case typelib.VT_HRESULT, typelib.VT_ERROR:
+		return "com.Error"
+	case typelib.VT_UNKNOWN:
+		if forReturn {
+			return "*com.UnknownClass"
 		}
-	} else if varType.Pointer && varType.RefType.Pointer &&
-		varType.RefType.RefType != nil && varType.RefType.RefType.Interface &&
-		!this.ownClassSet[oleType[2:]] {
-		if this.refClassMap[oleType[2:]] != "" {
-			this.usedRefClassMap[oleType[2:]] = this.refClassMap[oleType[2:]]
-			goType = oleType //
-		} else if forReturn {
-			if varType.RefType.DispInterface {
-				goType = "**ole.DispatchClass"
-			} else {
-				goType = "**com.UnknownClass"
-			}
-		} else {
-			if varType.RefType.DispInterface {
-				goType = "**win32.IDispatch"
-			} else {
-				goType = "**win32.IUnknown"
-			}
+	case typelib.VT_DISPATCH:
+		if forReturn {
+			return "*ole.DispatchClass"
 		}
-	} else {
-		goType = oleType
-	}
-	if goType == "win32.VARIANT" {
+	case typelib.VT_VARIANT:
 		if forReturn {
 			return "ole.Variant"
 		} else {
 			return "interface{}"
 		}
-	} else if goType == "*win32.VARIANT" {
-		return "*ole.Variant"
+	case typelib.VT_PTR:
+		if pointee != nil && pointee.Vt == typelib.VT_VARIANT {
+			return "*ole.Variant"
+		}
+	}
+	if pointee == nil {
+		return oleType
+	}
+	if pointee.Interface && this.isWin32VarType(pointee) {
+		return "*win32." + pointee.Name
 	}
-	return goType
+	if pointee.Interface && !this.ownClassSet[pointee.Name] {
+		return this.mapRefInterfaceType(pointee, "*", forReturn)
+	}
+	if pointee2 := pointee.Pointee(); pointee2 != nil && pointee2.Interface &&
+		!this.ownClassSet[pointee2.Name] {
+		if this.isWin32VarType(pointee2) {
+			return "**win32." + pointee2.Name
+		}
+		return this.mapRefInterfaceType(pointee2, "**", forReturn)
+	}
+	return oleType
+}
+
+// mapRefInterfaceType maps the pointers to an interface of another
+// library, or to an unknown one
+func (this *Generator) mapRefInterfaceType(intf *typelib.VarType, ptrs string, forReturn bool) string {
+	if this.refClassMap[intf.Name] != "" {
+		this.usedRefClassMap[intf.Name] = this.refClassMap[intf.Name]
+		return ptrs + intf.Name
+	}
+	if forReturn {
+		if intf.DispInterface {
+			return ptrs + "ole.DispatchClass"
+		}
+		return ptrs + "com.UnknownClass"
+	}
+	if intf.DispInterface {
+		return ptrs + "win32.IDispatch"
+	}
+	return ptrs + "win32.IUnknown"
 }
 
 func (this *Generator) genDispReturnCode(varType *typelib.VarType, goType string) string {
@@ -958,6 +1736,9 @@ func (this *Generator) genDispReturnCode(varType *typelib.VarType, goType string
 	if oleType == "" {
 		return "_= retVal"
 	}
+	if m := this.TypeMap.Lookup(varType); m != nil && (m.GoType != "" || m.FromVariant != "") {
+		return "return " + fromVariantExpr(m, varType, "retVal")
+	}
 	if goType[0] == '*' {
 		if varType.RefType != nil && varType.RefType.Interface {
 			if goType == "*com.UnknownClass" {
@@ -973,14 +1754,14 @@ func (this *Generator) genDispReturnCode(varType *typelib.VarType, goType string
 	}
 
 	castExpr := strings.Replace(varType.PVarCastExpr, "$", "retVal", 1)
-	switch oleType {
-	case "ole.Date":
+	switch varType.Vt {
+	case typelib.VT_DATE:
 		return "return " + castExpr + ".ToGoTime()"
-	case "win32.BSTR":
+	case typelib.VT_BSTR:
 		return "return win32.BstrToStrAndFree(" + castExpr + ")"
-	case "win32.HRESULT":
+	case typelib.VT_HRESULT, typelib.VT_ERROR:
 		return "return com.NewError(" + castExpr + ")"
-	case "win32.VARIANT_BOOL":
+	case typelib.VT_BOOL:
 		return "return " + castExpr + " != win32.VARIANT_FALSE"
 	}
 	return "return " + castExpr
@@ -1003,7 +1784,7 @@ func (this *Generator) genCoClass(ti *typelib.TypeInfo) {
 	}
 	implClass := utils.CapName(implTi.Name)
 
-	sIid, _ := win32.GuidToStr(&ti.Guid)
+	sIid := ti.Guid.String()
 	iidExpr := utils.BuildGuidExpr(sIid)
 	code += "var CLSID_" + className + " = " + iidExpr + "\n\n"
 
@@ -1123,19 +1904,22 @@ func (this *Generator) genCoClass(ti *typelib.TypeInfo) {
 
 func (this *Generator) genInterface(ti *typelib.TypeInfo) {
 	className := utils.CapName(ti.Name)
-	if isWin32Type(className) {
+	if this.isWin32Type(className) {
 		return
 	}
 	code := this.codeMap[className]
 
-	sIid, _ := win32.GuidToStr(&ti.Guid)
+	sIid := ti.Guid.String()
 	iidExpr := utils.BuildGuidExpr(sIid)
 	code += "// " + sIid + "\n"
 	code += "var IID_" + className + " = " + iidExpr + "\n\n"
 
 	//
-	superClassName := utils.CapName(ti.Super.Name)
-	if isWin32Type(superClassName) {
+	superClassName := "IUnknown" //unresolved import, as reported
+	if ti.Super.Name != "" {
+		superClassName = utils.CapName(ti.Super.Name)
+	}
+	if ti.Super.Name == "" || isWin32TypeInfo(ti.Super) {
 		superClassName = "win32." + superClassName
 	}
 
@@ -1170,18 +1954,18 @@ func (this *Generator) genInterface(ti *typelib.TypeInfo) {
 	fCount := ti.FuncCount
 	funcs := ti.Funcs
 
-	superFuncCount := len(collectInheritedFuncs(ti.Super))
-
 	setMethods := make(map[string]bool)
 	for n := 0; n < fCount; n++ {
 		f := funcs[n]
 		if f.Flags.PropPut || f.Flags.PropPutRef {
 			setMethods["Set"+utils.CapName(f.Name)] = true
+		} else if f.Flags.PropGet {
+			setMethods["Get"+utils.CapName(f.Name)] = true
 		}
 	}
 	for n := 0; n < fCount; n++ {
 		f := funcs[n]
-		fIndex := n + superFuncCount
+		fIndex := f.VtblOffset / utils.PtrSize
 		if f.Flags.PropGet {
 			code += this.genPropGet(className, fIndex, f)
 		} else if f.Flags.PropPut {
@@ -1197,40 +1981,45 @@ func (this *Generator) genInterface(ti *typelib.TypeInfo) {
 
 func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	class := utils.CapName(ti.Name)
-	superClass := utils.CapName(ti.Super.Name)
+	superClass, superFuncs := this.handlerBase(ti)
+	funcs := append(superFuncs, ti.Funcs...)
 
 	code := this.codeMap[class]
 
-	sIid, _ := win32.GuidToStr(&ti.Guid)
+	sIid := ti.Guid.String()
 	iidExpr := utils.BuildGuidExpr(sIid)
 	code += "// " + sIid + "\n"
 	code += "var IID_" + class + " = " + iidExpr + "\n\n"
 
+	realSuperClass := "IUnknown" //unresolved import, as reported
+	if ti.Super.Name != "" {
+		realSuperClass = utils.CapName(ti.Super.Name)
+	}
 	code += "type " + class + " struct {\n"
 	code += "\t"
-	if isWin32Type(superClass) {
+	if ti.Super.Name == "" || isWin32TypeInfo(ti.Super) {
 		code += "win32."
 	}
-	code += superClass + "\n"
+	code += realSuperClass + "\n"
 	code += "}\n\n"
 
 	code += "type " + class + "Interface interface {\n"
 	code += "\t"
-	if isWin32Type(superClass) {
+	if comImplPkgs[superClass] != "" {
 		code += "win32."
 	}
 	code += superClass + "Interface" + "\n"
-	fCount := ti.FuncCount
+	fCount := len(funcs)
 
 	fNames := make([]string, fCount)
 	for n := 0; n < fCount; n++ {
-		f := ti.GetFunc(n)
+		f := funcs[n]
 		fName := utils.CapName(f.Name)
 		fNames[n] = fName
 	}
 
 	for n := 0; n < fCount; n++ {
-		f := ti.GetFunc(n)
+		f := funcs[n]
 		code += "\t" + this.genFunc("", fNames[n], n, f.Params, f.ReturnType, true) + "\n"
 	}
 
@@ -1239,8 +2028,8 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	classImpl := class + "Impl"
 	code += "type " + classImpl + " struct {\n"
 	code += "\t"
-	if isWin32Type(superClass) {
-		code += "com."
+	if pkg := comImplPkgs[superClass]; pkg != "" {
+		code += pkg + "."
 	}
 	code += superClass + "Impl\n" //?
 	code += "\tRealObject " + class + "Interface\n"
@@ -1252,7 +2041,12 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 
 	code += "func (this *" + classImpl + ") QueryInterface(" +
 		"riid *syscall.GUID, ppvObject unsafe.Pointer) win32.HRESULT {\n"
-	code += "\tif *riid == IID_" + class + " {\n"
+	code += "\tif *riid == IID_" + class
+	for super := ti.Super; super != nil && super.Name != "" &&
+		utils.CapName(super.Name) != superClass; super = super.Super {
+		code += " ||\n\t\t*riid == (" + utils.BuildGuidExpr(super.Guid.String()) + ")"
+	}
+	code += " {\n"
 	code += "\t\tthis.AssignPpvObject(ppvObject)\n"
 	code += "\t\tthis.AddRef()\n"
 	code += "\t\treturn win32.S_OK\n"
@@ -1261,7 +2055,7 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	code += "}\n\n"
 
 	for n := 0; n < fCount; n++ {
-		f := ti.GetFunc(n)
+		f := funcs[n]
 		code += "func (this *" + classImpl + ") " + fNames[n] + "("
 		for m, p := range f.Params {
 			if m > 0 {
@@ -1288,12 +2082,12 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	//
 	code += "type " + class + "Vtbl struct {\n"
 	code += "\t"
-	if isWin32Type(superClass) {
+	if comImplPkgs[superClass] != "" {
 		code += "win32."
 	}
 	code += superClass + "Vtbl\n"
-	for n := 0; n < ti.FuncCount; n++ {
-		f := ti.Funcs[n]
+	for n := 0; n < fCount; n++ {
+		f := funcs[n]
 		code += "\t" + utils.CapName(f.Name) + " uintptr\n"
 	}
 	code += "}\n\n"
@@ -1301,8 +2095,8 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	//
 	code += "type " + class + "ComObj struct {\n"
 	code += "\t"
-	if isWin32Type(superClass) {
-		code += "com."
+	if pkg := comImplPkgs[superClass]; pkg != "" {
+		code += pkg + "."
 	}
 	code += superClass + "ComObj\n"
 	code += "}\n\n"
@@ -1312,7 +2106,7 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	code += "}\n\n"
 
 	for n := 0; n < fCount; n++ {
-		f := ti.Funcs[n]
+		f := funcs[n]
 		fName := utils.CapName(f.Name)
 
 		//
@@ -1345,15 +2139,10 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 			if m > 0 {
 				code += ", "
 			}
-			goParamType := this.mapOleTypeToGoType(p.Type, false)
-			if goParamType == "string" {
-				if p.Type.Name == "win32.BSTR" {
-					code += "win32.BstrToStr(" + pName + ")"
-				} else if p.Type.Name == "win32.PWSTR" {
-					code += "win32.PwstrToStr(" + pName + ")"
-				} else {
-					panic("?")
-				}
+			if p.Type.Vt == typelib.VT_BSTR {
+				code += "win32.BstrToStr(" + pName + ")"
+			} else if p.Type.Vt == typelib.VT_LPWSTR {
+				code += "win32.PwstrToStr(" + pName + ")"
 			} else {
 				code += pName
 			}
@@ -1377,8 +2166,8 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	code += "\t_p" + class + "Vtbl = &" + class + "Vtbl{\n"
 	code += "\t\t" + superClass + "Vtbl: *this." +
 		superClass + "ComObj.BuildVtbl(false),\n"
-	for n := 0; n < ti.FuncCount; n++ {
-		f := ti.Funcs[n]
+	for n := 0; n < fCount; n++ {
+		f := funcs[n]
 		fName := utils.CapName(f.Name)
 		code += "\t\t" + fName + ":\tsyscall.NewCallback((*" +
 			class + "ComObj)." + fName + "),\n"
@@ -1419,7 +2208,7 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 		code += "type " + class + "ByFuncImpl struct {\n"
 		code += "\t" + classImpl + "\n"
 
-		f := ti.GetFunc(0)
+		f := funcs[0]
 		code += "\thandlerFunc func " + this.genFunc("", "", 0, f.Params, f.ReturnType, true) + "\n"
 		code += "}\n"
 
@@ -1450,6 +2239,23 @@ func (this *Generator) genHandlerInterface(ti *typelib.TypeInfo) {
 	this.codeMap[class] = code
 }
 
+// handlerBase returns the super interface whose Impl and ComObj a handler
+// interface embeds, a handler of the library or an interface go-com has
+// them of, and the funcs of the interfaces between them, which the handler
+// implements itself. Those with no such super are based on IUnknown.
+func (this *Generator) handlerBase(ti *typelib.TypeInfo) (string, []*typelib.FuncInfo) {
+	var funcs []*typelib.FuncInfo
+	for super := ti.Super; super != nil && super.Name != ""; super = super.Super {
+		name := utils.CapName(super.Name)
+		if comImplPkgs[name] != "" || this.ownClassSet[name] &&
+			super.Kind == typelib.TKIND_INTERFACE && strings.HasSuffix(super.Name, "Handler") {
+			return name, funcs
+		}
+		funcs = append(append([]*typelib.FuncInfo(nil), super.Funcs...), funcs...)
+	}
+	return "IUnknown", funcs
+}
+
 func collectInheritedFuncs(superTi *typelib.TypeInfo) []*typelib.FuncInfo {
 	var funcs []*typelib.FuncInfo
 	if superTi.Super != nil {
@@ -1495,13 +2301,22 @@ func (this *Generator) genMethod(className string, fIndex int, f *typelib.FuncIn
 func (this *Generator) genFunc(className string, fName string, fIndex int,
 	params []*typelib.ParamInfo, returnType *typelib.VarType, noBody bool) string {
 
+	addrExpr := "(*this.LpVtbl)[" + strconv.Itoa(fIndex) + "]"
+	return this.genSyscallFunc(className, fName, addrExpr, params, returnType, noBody)
+}
+
+// genSyscallFunc generates a func calling the function at addrExpr,
+// a method passing this first if className is not empty.
+func (this *Generator) genSyscallFunc(className string, fName string, addrExpr string,
+	params []*typelib.ParamInfo, returnType *typelib.VarType, noBody bool) string {
+
 	var code string
 	goReturnType := this.mapOleTypeToGoType(returnType, true)
 
 	if className != "" {
 		code += "func (this *" + className + ") "
-	} else {
-		code += ""
+	} else if !noBody {
+		code += "func "
 	}
 	code += fName + "("
 
@@ -1530,45 +2345,51 @@ func (this *Generator) genFunc(className string, fName string, fIndex int,
 
 	code += " {\n"
 
-	code += "\taddr := (*this.LpVtbl)[" + strconv.Itoa(fIndex) + "]\n"
+	code += "\taddr := " + addrExpr + "\n"
 	code += "\t"
 	if goReturnType != "" {
 		code += "ret, _, _ :="
 	} else {
 		code += "_, _, _ ="
 	}
-	code += " syscall.SyscallN(addr, uintptr(unsafe.Pointer(this))"
+	code += " syscall.SyscallN(addr"
+	if className != "" {
+		code += ", uintptr(unsafe.Pointer(this))"
+	}
 	var outInterfaceParams []string
 	for n, pName := range pNames {
 		code += ", "
 		param := params[n]
 		pType := pTypes[n]
-		if pType == "bool" {
-			if param.Type.Name == "VARIANT_BOOL" {
-				code += "uintptr(^(*(*VARIANT_BOOL)" +
-					"(unsafe.Pointer(&" + pName + ")) - 1))"
-			} else {
-				code += "uintptr(*(*uint8)(unsafe.Pointer(&" + pName + ")))"
+		m := this.TypeMap.Lookup(param.Type)
+		byVt := m == nil || m.GoType == "" //else by the mapped type
+		arg := pName
+		if !byVt && m.GoType != param.Type.Name {
+			arg = param.Type.Name + "(" + pName + ")"
+			if param.Type.Name[0] == '*' {
+				arg = "(" + param.Type.Name + ")(" + pName + ")"
 			}
+		}
+		if m != nil && m.ToNative != "" {
+			code += expandTypeExpr(m.ToNative, pName)
+		} else if byVt && param.Type.Vt == typelib.VT_BOOL {
+			code += "uintptr(^(win32.VARIANT_BOOL(*(*uint8)(unsafe.Pointer(&" + pName + "))) - 1))"
+		} else if byVt && param.Type.Vt == typelib.VT_DATE {
+			code += genScalarArg(param.Type, "ole.NewOleDateFromGoTime("+pName+")")
 		} else if pType[0] == '*' {
-			code += "uintptr(unsafe.Pointer(" + pName + "))"
-			if pType[1] == '*' && params[n].Type.RefType.RefType != nil &&
-				(params[n].Type.RefType.RefType.Interface ||
-					params[n].Type.RefType.RefType.DispInterface) {
+			code += "uintptr(unsafe.Pointer(" + arg + "))"
+			if pointee := param.Type.Pointee(); pointee != nil && pointee.Pointee() != nil &&
+				pointee.Pointee().Interface {
 				outInterfaceParams = append(outInterfaceParams, pName)
 			}
 		} else if pType == "uintptr" {
-			code += pName
-		} else if pType == "string" {
+			code += arg
+		} else if byVt && (param.Type.Vt == typelib.VT_BSTR || param.Type.Vt == typelib.VT_LPWSTR) {
 			code += "uintptr(win32.StrToPointer(" + pName + "))"
 		} else if param.Type.Struct {
-			if param.Type.Size > utils.PtrSize {
-				code += "(uintptr)(unsafe.Pointer(&" + pName + "))"
-			} else {
-				code += "*(*uintptr)(unsafe.Pointer(&" + pName + "))"
-			}
+			code += genStructArg(pName, param.Type.Size)
 		} else {
-			code += "uintptr(" + pName + ")"
+			code += genScalarArg(param.Type, arg)
 		}
 	}
 	code += ")\n"
@@ -1583,21 +2404,83 @@ func (this *Generator) genFunc(className string, fName string, fIndex int,
 	return code
 }
 
+// genScalarArg passes a number as the syscall args it takes: floats by
+// their bits, and 8 byte values in two words on 386
+func genScalarArg(t *typelib.VarType, expr string) string {
+	switch t.Vt {
+	case typelib.VT_R4:
+		return "uintptr(math.Float32bits(float32(" + expr + ")))"
+	case typelib.VT_R8, typelib.VT_DATE:
+		expr = "math.Float64bits(float64(" + expr + "))"
+	case typelib.VT_I8, typelib.VT_UI8:
+		if utils.PtrSize == 4 {
+			expr = "uint64(" + expr + ")"
+		}
+	default:
+		return "uintptr(" + expr + ")"
+	}
+	if utils.PtrSize == 4 {
+		return "uintptr(" + expr + "), uintptr(" + expr + " >> 32)"
+	}
+	return "uintptr(" + expr + ")"
+}
+
+// genStructArg passes a struct by value the way the target arch does:
+// on the stack on 386, in up to 1 register on amd64 and 2 on arm64, or
+// else by reference.
+func genStructArg(pName string, size int) string {
+	words := (size + utils.PtrSize - 1) / utils.PtrSize
+	byValue := false
+	switch utils.Arch {
+	case "386":
+		byValue = true
+	case "amd64":
+		byValue = size == 1 || size == 2 || size == 4 || size == 8
+	case "arm64":
+		byValue = size <= 16
+	}
+	if !byValue {
+		return "(uintptr)(unsafe.Pointer(&" + pName + "))"
+	}
+	if words <= 1 {
+		return "*(*uintptr)(unsafe.Pointer(&" + pName + "))"
+	}
+	var args []string
+	for n := 0; n < words; n++ {
+		args = append(args, "(*["+strconv.Itoa(words)+"]uintptr)(unsafe.Pointer(&"+
+			pName+"))["+strconv.Itoa(n)+"]")
+	}
+	return strings.Join(args, ", ")
+}
+
 func (this *Generator) genReturnCode(typ *typelib.VarType, goType string) string {
+	m := this.TypeMap.Lookup(typ)
+	if m != nil && m.FromNative != "" {
+		return "return " + expandTypeExpr(m.FromNative, "ret")
+	}
+	vt := typ.Vt
+	if m != nil && m.GoType != "" {
+		vt = typelib.VT_EMPTY //by the mapped type
+	}
 	var castExpr string
-	switch goType {
-	case "bool":
+	switch vt {
+	case typelib.VT_BOOL:
 		castExpr = "ret != 0"
-	case "string":
-		castExpr = "win32.BstrToStrAndFree(win32.BSTR(unsafe.pointer(ret))"
-	case "time.Time":
+	case typelib.VT_BSTR:
+		castExpr = "win32.BstrToStrAndFree(win32.BSTR(unsafe.Pointer(ret)))"
+	case typelib.VT_LPWSTR:
+		castExpr = "win32.PwstrToStr(win32.PWSTR(unsafe.Pointer(ret)))"
+	case typelib.VT_DATE:
 		castExpr = "ole.Date(ret).ToGoTime()"
-	case "com.Error":
+	case typelib.VT_HRESULT, typelib.VT_ERROR:
 		castExpr = "com.Error(ret)"
-	case "uintptr":
-		castExpr = "ret"
-	case "unsafe.Pointer":
-		castExpr = "unsafe.Pointer(ret)"
+	default:
+		switch goType {
+		case "uintptr":
+			castExpr = "ret"
+		case "unsafe.Pointer":
+			castExpr = "unsafe.Pointer(ret)"
+		}
 	}
 	if castExpr != "" {
 		return "return " + castExpr
@@ -0,0 +1,377 @@
+package codegen
+
+import (
+	"encoding/json"
+	"github.com/zzl/go-tlbimp/typelib"
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// generate generates the code of the library of the IDL source into a
+// temp dir, returning the files by name
+func generate(t *testing.T, g *Generator, src string) map[string]string {
+	lib, err := typelib.NewTypeLibFromIdl(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return generateLib(t, g, lib)
+}
+
+// generateLib is generate of a library read already
+func generateLib(t *testing.T, g *Generator, lib typelib.Source) map[string]string {
+	g.TypeLib = lib
+	g.OutputPath = filepath.Join(t.TempDir(), "testlib")
+	if err := os.Mkdir(g.OutputPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if g.Archs == nil {
+		g.Archs = []string{"amd64"}
+	}
+	g.Generate()
+	paths, err := filepath.Glob(filepath.Join(g.OutputPath, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]string)
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[filepath.Base(path)] = string(data)
+	}
+	return files
+}
+
+// funcBody returns the code of the func or method named in code
+func funcBody(code string, name string) string {
+	pos := strings.Index(code, ") "+name+"(")
+	if pos == -1 {
+		pos = strings.Index(code, "func "+name+"(")
+	}
+	if pos == -1 {
+		return ""
+	}
+	end := strings.Index(code[pos:], "\n}\n")
+	if end == -1 {
+		return code[pos:]
+	}
+	return code[pos : pos+end]
+}
+
+func TestVtblSlots(t *testing.T) {
+	//the slots of the funcs as recorded, C after a gap
+	slots := map[string]int{"A": 4, "B": 3, "C": 7}
+	g := &Generator{TypeHook: func(ti *typelib.TypeInfo) bool {
+		if ti.Name == "IGap" {
+			for _, f := range ti.Funcs {
+				f.VtblOffset = slots[f.Name] * utils.PtrSize
+			}
+		}
+		return true
+	}}
+	files := generate(t, g, `[uuid(60000000-0000-0000-0000-000000000000)]
+library GapLib {
+	importlib("stdole2.tlb");
+	[object, uuid(60000001-0000-0000-0000-000000000000)]
+	interface IGap : IUnknown { HRESULT A([in] long a); HRESULT B(); HRESULT C(); };
+};`)
+	code := files["IGap.go"]
+	for name, slot := range slots {
+		want := "addr := (*this.LpVtbl)[" + strconv.Itoa(slot) + "]"
+		if body := funcBody(code, name); !strings.Contains(body, want) {
+			t.Errorf("%s does not call slot %d:\n%s", name, slot, body)
+		}
+	}
+}
+
+func TestStructPadding(t *testing.T) {
+	//the offsets and size as a packing pragma would have them
+	g := &Generator{Archs: []string{}, TypeHook: func(ti *typelib.TypeInfo) bool {
+		if ti.Name == "Padded" {
+			ti.Fields[1].Offset, ti.Fields[2].Offset = 8, 12
+			ti.SizeInstance = 24
+		}
+		return true
+	}}
+	files := generate(t, g, `[uuid(60000010-0000-0000-0000-000000000000)]
+library PadLib {
+	importlib("stdole2.tlb");
+	typedef struct Plain { long x; long y; } Plain;
+	typedef struct Padded { byte a; long b; long c; } Padded;
+};`)
+	code := files["types.go"]
+	tests := []struct {
+		name   string
+		fields string
+	}{
+		{"Plain", "\tX int32\n\tY int32\n"},
+		{"Padded", "\tA byte\n\t_ [4]byte\n\tB int32\n\tC int32\n\t_ [8]byte\n"},
+	}
+	for _, test := range tests {
+		want := "type " + test.name + " struct {\n" + test.fields + "}\n"
+		if !strings.Contains(code, want) {
+			t.Errorf("%s is not laid out as\n%s\n%s", test.name, want, code)
+		}
+	}
+}
+
+func TestUnresolvedBase(t *testing.T) {
+	//the base is of an imported library that cannot be read
+	files := generate(t, &Generator{}, `[uuid(60000030-0000-0000-0000-000000000000)]
+library VendorUser {
+	importlib("stdole2.tlb");
+	importlib("vendor.tlb");
+	[object, uuid(60000031-0000-0000-0000-000000000000)]
+	interface IDerived : IVendorBase { HRESULT A(); };
+};`)
+	//the methods are left out, for their slots are not known
+	code := files["IDerived.go"]
+	if !strings.Contains(code, "type IDerived struct {") || funcBody(code, "A") != "" {
+		t.Errorf("IDerived is not generated without its methods:\n%s", code)
+	}
+}
+
+// testAlignIdl has structs of 8 byte numbers, which C aligns to 8 and Go
+// to 4 on 386
+const testAlignIdl = `[uuid(60000040-0000-0000-0000-000000000000)]
+library AlignLib {
+	importlib("stdole2.tlb");
+	typedef struct S2 { double d; char c; } S2;
+	typedef struct S3 { char c; S2 s; hyper h; } S3;
+	typedef struct S4 { long a; long b; } S4;
+};`
+
+func TestStructAlign(t *testing.T) {
+	files := generate(t, &Generator{Archs: []string{"386"}}, testAlignIdl)
+	code := files["types.go"]
+	tests := []struct {
+		name   string
+		fields string
+	}{
+		{"S2", "\tD float64\n\tC int8\n\t_ [7]byte\n"},
+		{"S3", "\tC int8\n\t_ [4]byte\n\tS S2\n\tH int64\n"},
+		{"S4", "\tA int32\n\tB int32\n"},
+	}
+	for _, test := range tests {
+		want := "type " + test.name + " struct {\n" + test.fields + "}\n"
+		if !strings.Contains(code, want) {
+			t.Errorf("%s is not laid out as\n%s\n%s", test.name, want, code)
+		}
+	}
+}
+
+func TestArchFiles(t *testing.T) {
+	files := generate(t, &Generator{Archs: []string{"386", "amd64"}}, testAlignIdl)
+	if _, ok := files["types.go"]; ok {
+		t.Fatal("types.go is shared by 386 and amd64")
+	}
+	tests := []struct {
+		arch string
+		s2   string
+	}{
+		{"386", "type S2 struct {\n\tD float64\n\tC int8\n\t_ [7]byte\n}\n"},
+		{"amd64", "type S2 struct {\n\tD float64\n\tC int8\n}\n"},
+	}
+	for _, test := range tests {
+		code := files["types_"+test.arch+".go"]
+		if !strings.HasPrefix(code, "//go:build "+test.arch+"\n") || !strings.Contains(code, test.s2) {
+			t.Errorf("%s: no build line or S2 as\n%s\n%s", test.arch, test.s2, code)
+		}
+	}
+	//the library info is the same for both
+	if _, ok := files["typelib.go"]; !ok {
+		t.Error("typelib.go is not shared")
+	}
+}
+
+const testCodeIdl = `[uuid(60000030-0000-0000-0000-000000000000), version(1.0)]
+library CodeLib {
+	importlib("stdole2.tlb");
+	typedef [uuid(60000031-0000-0000-0000-000000000000)]
+	enum Mode { mRead = 1, mWrite = 2, mBoth = 3, mAppend = 4 } Mode;
+	typedef struct Item { long n; double d; } Item;
+	[object, dual, uuid(60000032-0000-0000-0000-000000000000)]
+	interface IDoc : IDispatch {
+		[propget, id(1)] HRESULT Title([out, retval] BSTR* v);
+		[id(2)] HRESULT Open([in] BSTR path, [in, defaultvalue(mRead)] Mode mode,
+			[in, defaultvalue(1.5)] double scale, [in, defaultvalue("x")] BSTR tag,
+			[in, optional] VARIANT extra);
+		[id(3)] HRESULT Get([in] Item* item, [out, retval] IDoc** doc);
+	};
+	[uuid(60000033-0000-0000-0000-000000000000)]
+	coclass Doc { [default] interface IDoc; };
+	[dllname("code.dll"), uuid(60000034-0000-0000-0000-000000000000)]
+	module CodeApi {
+		const long MaxDocs = 10;
+		const LPSTR Name = "code";
+		[entry("OpenAll")] HRESULT __stdcall OpenAll([in] long n);
+		[entry(7)] long __stdcall Count();
+	};
+};`
+
+func TestDumpCode(t *testing.T) {
+	lib, err := typelib.NewTypeLibFromIdl(testCodeIdl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dump, errs := typelib.NewTypeLibDump(lib)
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	data, err := json.Marshal(dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := typelib.NewTypeLibDumpFromJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the code of the dump is the code of the library
+	archs := []string{"386", "amd64"}
+	files := generateLib(t, &Generator{Archs: archs}, lib)
+	dumpFiles := generateLib(t, &Generator{Archs: archs}, loaded)
+	if len(files) != len(dumpFiles) {
+		t.Errorf("files %d, of the dump %d", len(files), len(dumpFiles))
+	}
+	for name, code := range files {
+		if dumpFiles[name] != code {
+			t.Errorf("%s differs:\n%s\nof the dump:\n%s", name, code, dumpFiles[name])
+		}
+	}
+}
+
+func TestDispDefaultValues(t *testing.T) {
+	code := generate(t, &Generator{}, testCodeIdl)["IDoc.go"]
+	tests := []struct {
+		code string
+		has  bool
+	}{
+		{"// default values: mode = 1, scale = 1.5, tag = \"x\"\nfunc (this *IDoc) Open(", true},
+		//the defaults of those omitted, the enum as its number
+		{"if optArgs[0] == nil {\n\t\toptArgs[0] = int32(1)\n\t}", true},
+		{"if v, ok := optArgs[0].(Mode); ok {\n\t\toptArgs[0] = int32(v)\n\t}", true},
+		{"if optArgs[1] == nil {\n\t\toptArgs[1] = float64(1.5)\n\t}", true},
+		{"if optArgs[2] == nil {\n\t\toptArgs[2] = \"x\"\n\t}", true},
+		//an optional parameter without a default is left out
+		{"optArgs[3] =", false},
+	}
+	for _, test := range tests {
+		if strings.Contains(code, test.code) != test.has {
+			t.Errorf("has %q: %v, want %v:\n%s", test.code, !test.has, test.has, funcBody(code, "Open"))
+		}
+	}
+}
+
+func TestModule(t *testing.T) {
+	code := generate(t, &Generator{}, testCodeIdl)["modules.go"]
+	tests := []struct {
+		code string
+		has  bool
+	}{
+		{"const (\n\tMaxDocs int32 = 10\n\tName = \"code\"\n)\n", true},
+		{"var libCodeApi = windows.NewLazyDLL(\"code.dll\")\n", true},
+		//a func is bound by its entry name
+		{"procCodeApi_OpenAll = libCodeApi.NewProc(\"OpenAll\")\n", true},
+		{"addr := procCodeApi_OpenAll.Addr()\n", true},
+		//or by its ordinal
+		{"procCodeApi_Count", false},
+		{"addr := procAddrByOrdinal(libCodeApi, 7)\n", true},
+		{"func procAddrByOrdinal(", true},
+	}
+	for _, test := range tests {
+		if strings.Contains(code, test.code) != test.has {
+			t.Errorf("has %q: %v, want %v:\n%s", test.code, !test.has, test.has, code)
+		}
+	}
+	if body := funcBody(code, "Count"); !strings.Contains(body, "return int32(ret)") {
+		t.Errorf("Count returns no int32:\n%s", body)
+	}
+}
+
+func TestConstEnums(t *testing.T) {
+	code := generate(t, &Generator{ConstEnums: true}, `[uuid(60000040-0000-0000-0000-000000000000)]
+library EnumLib {
+	typedef enum Access { aNone = 0, aRead = 1, aWrite = 2, aExec = 4, aAll = 7 } Access;
+	typedef enum Side { None = 0, Left = 1, Right = 2 } Side;
+	typedef enum Edge { eNone = 0, Top = 1, Left2 = 1 } Edge;
+	typedef enum Pos { Left = 5, Bottom = 6 } Pos;
+};`)["enums.go"]
+	tests := []struct {
+		name string
+		code string
+	}{
+		//three single bits make flags, decomposed by String
+		{"Access", "type Access int32\n\nconst (\n\tANone Access = 0\n\tARead Access = 1\n"},
+		{"String", "for _, bit := range []Access{ARead, AWrite, AExec} {"},
+		{"String", "s += \"Access(0x\" + strconv.FormatUint(uint64(v), 16) + \")\""},
+		{"ParseAccess", "for _, s := range strings.Split(name, \"|\") {"},
+		{"ParseAccess", "case \"AAll\":\n\t\t\tv |= AAll\n"},
+		//a member name of two enums is prefixed by the enum names
+		{"Side", "\tNone Side = 0\n\tSide_Left Side = 1\n\tRight Side = 2\n"},
+		{"Pos", "\tPos_Left Pos = 5\n\tBottom Pos = 6\n"},
+		{"ParseSide", "case \"Left\":\n\t\treturn Side_Left, nil\n"},
+		//values are listed once, all the names are parsed
+		{"EdgeValues", "return []Edge{ENone, Top}\n"},
+		{"ParseEdge", "case \"Left2\":\n\t\treturn Left2, nil\n"},
+		{"Edge", "return \"Edge(\" + strconv.FormatInt(int64(this), 10) + \")\""},
+	}
+	for _, test := range tests {
+		if !strings.Contains(code, test.code) {
+			t.Errorf("%s: no %q:\n%s", test.name, test.code, code)
+		}
+	}
+	//not flags, the names of a value only
+	if body := funcBody(code, "ParseSide"); strings.Contains(body, "strings.Split") {
+		t.Errorf("Side is taken as flags:\n%s", body)
+	}
+}
+
+func TestWin32Types(t *testing.T) {
+	files := generate(t, &Generator{}, `[uuid(60000050-0000-0000-0000-000000000000)]
+library Win32User {
+	importlib("stdole2.tlb");
+	typedef struct RECT { long left; long top; long right; long bottom; } RECT;
+	[object, uuid(00020404-0000-0000-C000-000000000046)]
+	interface IEnumVARIANT : IUnknown {
+		HRESULT Next([in] unsigned long celt, [out] VARIANT* rgVar, [out] unsigned long* fetched);
+		HRESULT Skip([in] unsigned long celt);
+		HRESULT Reset();
+		HRESULT Clone([out] IEnumVARIANT** ppEnum);
+	};
+	[object, uuid(60000051-0000-0000-0000-000000000000)]
+	interface IFont : IUnknown { HRESULT Size([out, retval] long* v); };
+	[object, uuid(60000052-0000-0000-0000-000000000000)]
+	interface IUser : IUnknown {
+		HRESULT Items([out, retval] IEnumVARIANT** e);
+		HRESULT Bounds([in] RECT* r);
+		HRESULT Font([out, retval] IFont** f);
+	};
+};`)
+	//an interface of the IID of go-win32api and a record of its name are taken from it
+	for _, name := range []string{"IEnumVARIANT.go", "types.go"} {
+		if _, ok := files[name]; ok {
+			t.Errorf("%s is generated", name)
+		}
+	}
+	user := files["IUser.go"]
+	tests := []string{
+		"Items(e **win32.IEnumVARIANT) com.Error {",
+		"Bounds(r *win32.RECT) com.Error {",
+		//IFont of another IID is the library's own
+		"Font(f **IFont) com.Error {",
+	}
+	for _, code := range tests {
+		if !strings.Contains(user, code) {
+			t.Errorf("IUser.go has no %q:\n%s", code, user)
+		}
+	}
+	if _, ok := files["IFont.go"]; !ok {
+		t.Error("IFont.go is not generated")
+	}
+}
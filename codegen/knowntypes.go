@@ -0,0 +1,3611 @@
+// Code generated by knowntypes_gen.go; DO NOT EDIT.
+
+package codegen
+
+// win32Types are the interfaces and structs of go-win32api, with the IIDs of the interfaces
+var win32Types = map[string]string{
+	"AASHELLMENUFILENAME":                "",
+	"AASHELLMENUITEM":                    "",
+	"ABC":                                "",
+	"ABCFLOAT":                           "",
+	"ABORTPATH":                          "",
+	"ACCEL":                              "",
+	"ACCESSTIMEOUT":                      "",
+	"ACCESS_ALLOWED_ACE":                 "",
+	"ACCESS_ALLOWED_CALLBACK_ACE":        "",
+	"ACCESS_ALLOWED_CALLBACK_OBJECT_ACE": "",
+	"ACCESS_ALLOWED_OBJECT_ACE":          "",
+	"ACCESS_DENIED_ACE":                  "",
+	"ACCESS_DENIED_CALLBACK_ACE":         "",
+	"ACCESS_DENIED_CALLBACK_OBJECT_ACE":  "",
+	"ACCESS_DENIED_OBJECT_ACE":           "",
+	"ACCESS_REASONS":                     "",
+	"ACE_HEADER":                         "",
+	"ACL":                                "",
+	"ACL_REVISION_INFORMATION":           "",
+	"ACL_SIZE_INFORMATION":               "",
+	"ACPI_REAL_TIME":                     "",
+	"ACTCTX_SECTION_KEYED_DATA_2600":     "",
+	"ACTCTX_SECTION_KEYED_DATA_ASSEMBLY_METADATA": "",
+	"ACTIVATION_CONTEXT_BASIC_INFORMATION":        "",
+	"ADDRESS64":                                   "",
+	"ADMINISTRATOR_POWER_POLICY":                  "",
+	"AER_BRIDGE_DESCRIPTOR_FLAGS":                 "",
+	"AER_BRIDGE_DESCRIPTOR_FLAGS_Anonymous":       "",
+	"AER_ENDPOINT_DESCRIPTOR_FLAGS":               "",
+	"AER_ENDPOINT_DESCRIPTOR_FLAGS_Anonymous":     "",
+	"AER_ROOTPORT_DESCRIPTOR_FLAGS":               "",
+	"AER_ROOTPORT_DESCRIPTOR_FLAGS_Anonymous":     "",
+	"ALTTABINFO":                            "",
+	"ANIMATIONINFO":                         "",
+	"ANON_OBJECT_HEADER":                    "",
+	"ANON_OBJECT_HEADER_BIGOBJ":             "",
+	"ANON_OBJECT_HEADER_V2":                 "",
+	"API_VERSION":                           "",
+	"APPBARDATA":                            "",
+	"APPCATEGORYINFO":                       "",
+	"APPCATEGORYINFOLIST":                   "",
+	"APPINFODATA":                           "",
+	"APPLICATIONLAUNCH_SETTING_VALUE":       "",
+	"APP_LOCAL_DEVICE_ID":                   "",
+	"APP_MEMORY_INFORMATION":                "",
+	"ARM64_NT_CONTEXT":                      "",
+	"ARM64_NT_CONTEXT_Anonymous":            "",
+	"ARM64_NT_CONTEXT_Anonymous_Anonymous":  "",
+	"ARM64_NT_NEON128":                      "",
+	"ARM64_NT_NEON128_Anonymous":            "",
+	"ARRAYDESC":                             "",
+	"ASSOCIATIONELEMENT":                    "",
+	"AUDIODESCRIPTION":                      "",
+	"AUTHENTICATEINFO":                      "",
+	"AUTO_SCROLL_DATA":                      "",
+	"AXESLISTA":                             "",
+	"AXESLISTW":                             "",
+	"AXISINFOA":                             "",
+	"AXISINFOW":                             "",
+	"ArrayDimension":                        "",
+	"AsyncIAdviseSink":                      "00000150-0000-0000-C000-000000000046",
+	"AsyncIAdviseSink2":                     "00000151-0000-0000-C000-000000000046",
+	"AsyncIDebugApplicationNodeEvents":      "A2E3AA3B-AA8D-4EBF-84CD-648B737B8C13",
+	"AsyncIMultiQI":                         "000E0020-0000-0000-C000-000000000046",
+	"AsyncIPipeByte":                        "DB2F3ACB-2F86-11D1-8E04-00C04FB9989A",
+	"AsyncIPipeDouble":                      "DB2F3ACF-2F86-11D1-8E04-00C04FB9989A",
+	"AsyncIPipeLong":                        "DB2F3ACD-2F86-11D1-8E04-00C04FB9989A",
+	"AsyncIUnknown":                         "000E0000-0000-0000-C000-000000000046",
+	"BANDINFOSFB":                           "",
+	"BANDSITEINFO":                          "",
+	"BANNER_NOTIFICATION":                   "",
+	"BASEBROWSERDATALH":                     "",
+	"BASEBROWSERDATAXP":                     "",
+	"BATTERY_CHARGER_STATUS":                "",
+	"BATTERY_CHARGING_SOURCE":               "",
+	"BATTERY_CHARGING_SOURCE_INFORMATION":   "",
+	"BATTERY_INFORMATION":                   "",
+	"BATTERY_MANUFACTURE_DATE":              "",
+	"BATTERY_QUERY_INFORMATION":             "",
+	"BATTERY_REPORTING_SCALE":               "",
+	"BATTERY_SET_INFORMATION":               "",
+	"BATTERY_STATUS":                        "",
+	"BATTERY_USB_CHARGER_STATUS":            "",
+	"BATTERY_WAIT_STATUS":                   "",
+	"BIDIOPTIONS":                           "",
+	"BINDINFO":                              "",
+	"BINDPTR":                               "",
+	"BIND_OPTS":                             "",
+	"BIND_OPTS2":                            "",
+	"BIND_OPTS3":                            "",
+	"BITMAP":                                "",
+	"BITMAPCOREHEADER":                      "",
+	"BITMAPCOREINFO":                        "",
+	"BITMAPFILEHEADER":                      "",
+	"BITMAPINFO":                            "",
+	"BITMAPINFOHEADER":                      "",
+	"BITMAPV4HEADER":                        "",
+	"BITMAPV5HEADER":                        "",
+	"BLENDFUNCTION":                         "",
+	"BLOB":                                  "",
+	"BP_ANIMATIONPARAMS":                    "",
+	"BP_PAINTPARAMS":                        "",
+	"BROWSEINFOA":                           "",
+	"BROWSEINFOW":                           "",
+	"BSMINFO":                               "",
+	"BSTRBLOB":                              "",
+	"BUSDATA":                               "",
+	"BUTTON_IMAGELIST":                      "",
+	"BUTTON_SPLITINFO":                      "",
+	"BYTE_BLOB":                             "",
+	"BYTE_SIZEDARR":                         "",
+	"BY_HANDLE_FILE_INFORMATION":            "",
+	"CABINETSTATE":                          "",
+	"CABINFOA":                              "",
+	"CABINFOW":                              "",
+	"CABOOL":                                "",
+	"CABSTR":                                "",
+	"CABSTRBLOB":                            "",
+	"CAC":                                   "",
+	"CACHE_DESCRIPTOR":                      "",
+	"CACHE_RELATIONSHIP":                    "",
+	"CACHE_RELATIONSHIP_Anonymous":          "",
+	"CACLIPDATA":                            "",
+	"CACLSID":                               "",
+	"CACY":                                  "",
+	"CADATE":                                "",
+	"CADBL":                                 "",
+	"CADWORD":                               "",
+	"CAFILETIME":                            "",
+	"CAFLT":                                 "",
+	"CAH":                                   "",
+	"CAI":                                   "",
+	"CAL":                                   "",
+	"CALPOLESTR":                            "",
+	"CALPSTR":                               "",
+	"CALPWSTR":                              "",
+	"CAPROPVARIANT":                         "",
+	"CARET_INFO":                            "",
+	"CASCODE":                               "",
+	"CATEGORYINFO":                          "",
+	"CATEGORY_INFO":                         "",
+	"CAUB":                                  "",
+	"CAUH":                                  "",
+	"CAUI":                                  "",
+	"CAUL":                                  "",
+	"CAUUID":                                "",
+	"CBTACTIVATESTRUCT":                     "",
+	"CBT_CREATEWNDA":                        "",
+	"CBT_CREATEWNDW":                        "",
+	"CCINFOA":                               "",
+	"CCINFOW":                               "",
+	"CCSTYLEA":                              "",
+	"CCSTYLEFLAGA":                          "",
+	"CCSTYLEFLAGW":                          "",
+	"CCSTYLEW":                              "",
+	"CFG_CALL_TARGET_INFO":                  "",
+	"CHANGEFILTERSTRUCT":                    "",
+	"CHANGENOTIFY":                          "",
+	"CHARFORMAT2A":                          "",
+	"CHARFORMAT2A_Anonymous":                "",
+	"CHARFORMAT2W":                          "",
+	"CHARFORMAT2W_Anonymous":                "",
+	"CHARFORMATA":                           "",
+	"CHARFORMATW":                           "",
+	"CHARRANGE":                             "",
+	"CHARSETINFO":                           "",
+	"CHAR_INFO":                             "",
+	"CHAR_INFO_Char":                        "",
+	"CHOOSECOLORA":                          "",
+	"CHOOSECOLORW":                          "",
+	"CHOOSEFONTA":                           "",
+	"CHOOSEFONTW":                           "",
+	"CIDA":                                  "",
+	"CIE4ConnectionPoint":                   "00000000-0000-0000-0000-000000000000",
+	"CIEXYZ":                                "",
+	"CIEXYZTRIPLE":                          "",
+	"CLAIM_SECURITY_ATTRIBUTES_INFORMATION": "",
+	"CLAIM_SECURITY_ATTRIBUTES_INFORMATION_Attribute":         "",
+	"CLAIM_SECURITY_ATTRIBUTE_FQBN_VALUE":                     "",
+	"CLAIM_SECURITY_ATTRIBUTE_OCTET_STRING_VALUE":             "",
+	"CLAIM_SECURITY_ATTRIBUTE_RELATIVE_V1":                    "",
+	"CLAIM_SECURITY_ATTRIBUTE_RELATIVE_V1_Values":             "",
+	"CLAIM_SECURITY_ATTRIBUTE_V1":                             "",
+	"CLAIM_SECURITY_ATTRIBUTE_V1_Values":                      "",
+	"CLEANLOCALSTORAGE":                                       "",
+	"CLFS_LOG_NAME_INFORMATION":                               "",
+	"CLFS_MGMT_NOTIFICATION":                                  "",
+	"CLFS_MGMT_POLICY":                                        "",
+	"CLFS_MGMT_POLICY_PolicyParameters":                       "",
+	"CLFS_MGMT_POLICY_PolicyParameters_AutoGrow":              "",
+	"CLFS_MGMT_POLICY_PolicyParameters_AutoShrink":            "",
+	"CLFS_MGMT_POLICY_PolicyParameters_GrowthRate":            "",
+	"CLFS_MGMT_POLICY_PolicyParameters_LogTail":               "",
+	"CLFS_MGMT_POLICY_PolicyParameters_MaximumSize":           "",
+	"CLFS_MGMT_POLICY_PolicyParameters_MinimumSize":           "",
+	"CLFS_MGMT_POLICY_PolicyParameters_NewContainerExtension": "",
+	"CLFS_MGMT_POLICY_PolicyParameters_NewContainerPrefix":    "",
+	"CLFS_MGMT_POLICY_PolicyParameters_NewContainerSize":      "",
+	"CLFS_MGMT_POLICY_PolicyParameters_NewContainerSuffix":    "",
+	"CLFS_NODE_ID":                                 "",
+	"CLFS_PHYSICAL_LSN_INFORMATION":                "",
+	"CLFS_STREAM_ID_INFORMATION":                   "",
+	"CLIENTCREATESTRUCT":                           "",
+	"CLIENT_ID":                                    "",
+	"CLIPBOARDFORMAT":                              "",
+	"CLIPDATA":                                     "",
+	"CLS_ARCHIVE_DESCRIPTOR":                       "",
+	"CLS_CONTAINER_INFORMATION":                    "",
+	"CLS_INFORMATION":                              "",
+	"CLS_IO_STATISTICS":                            "",
+	"CLS_IO_STATISTICS_HEADER":                     "",
+	"CLS_LSN":                                      "",
+	"CLS_SCAN_CONTEXT":                             "",
+	"CLS_WRITE_ENTRY":                              "",
+	"CMINVOKECOMMANDINFO":                          "",
+	"CMINVOKECOMMANDINFOEX":                        "",
+	"CMINVOKECOMMANDINFOEX_REMOTE":                 "",
+	"CM_COLUMNINFO":                                "",
+	"CM_POWER_DATA":                                "",
+	"COAUTHIDENTITY":                               "",
+	"COAUTHINFO":                                   "",
+	"COLORADJUSTMENT":                              "",
+	"COLORMAP":                                     "",
+	"COLORSCHEME":                                  "",
+	"COMBOBOXEXITEMA":                              "",
+	"COMBOBOXEXITEMW":                              "",
+	"COMBOBOXINFO":                                 "",
+	"COMDLG_FILTERSPEC":                            "",
+	"COMPAREITEMSTRUCT":                            "",
+	"COMPCOLOR":                                    "",
+	"COMPONENT_FILTER":                             "",
+	"CONFIRM_CONFLICT_ITEM":                        "",
+	"CONFIRM_CONFLICT_RESULT_INFO":                 "",
+	"CONNECTDATA":                                  "",
+	"CONNECTION_INFO_0":                            "",
+	"CONNECTION_INFO_1":                            "",
+	"CONSOLE_CURSOR_INFO":                          "",
+	"CONSOLE_FONT_INFO":                            "",
+	"CONSOLE_FONT_INFOEX":                          "",
+	"CONSOLE_HISTORY_INFO":                         "",
+	"CONSOLE_READCONSOLE_CONTROL":                  "",
+	"CONSOLE_SCREEN_BUFFER_INFO":                   "",
+	"CONSOLE_SCREEN_BUFFER_INFOEX":                 "",
+	"CONSOLE_SELECTION_INFO":                       "",
+	"CONTEXT":                                      "",
+	"CONTEXT_Anonymous":                            "",
+	"CONTEXT_Anonymous_Anonymous":                  "",
+	"CONTROLINFO":                                  "",
+	"CONVCONTEXT":                                  "",
+	"CONVINFO":                                     "",
+	"COORD":                                        "",
+	"COPYDATASTRUCT":                               "",
+	"COPYFILE2_EXTENDED_PARAMETERS":                "",
+	"COPYFILE2_EXTENDED_PARAMETERS_V2":             "",
+	"COPYFILE2_MESSAGE":                            "",
+	"COPYFILE2_MESSAGE_Info":                       "",
+	"COPYFILE2_MESSAGE_Info_ChunkFinished":         "",
+	"COPYFILE2_MESSAGE_Info_ChunkStarted":          "",
+	"COPYFILE2_MESSAGE_Info_Error":                 "",
+	"COPYFILE2_MESSAGE_Info_PollContinue":          "",
+	"COPYFILE2_MESSAGE_Info_StreamFinished":        "",
+	"COPYFILE2_MESSAGE_Info_StreamStarted":         "",
+	"COSERVERINFO":                                 "",
+	"CPINFO":                                       "",
+	"CPINFOEXA":                                    "",
+	"CPINFOEXW":                                    "",
+	"CPLINFO":                                      "",
+	"CPU_INFORMATION":                              "",
+	"CPU_INFORMATION_OtherCpuInfo":                 "",
+	"CPU_INFORMATION_X86CpuInfo":                   "",
+	"CREATEFILE2_EXTENDED_PARAMETERS":              "",
+	"CREATESTRUCTA":                                "",
+	"CREATESTRUCTW":                                "",
+	"CREATE_PROCESS_DEBUG_INFO":                    "",
+	"CREATE_THREAD_DEBUG_INFO":                     "",
+	"CREDENTIAL_PROVIDER_CREDENTIAL_SERIALIZATION": "",
+	"CREDENTIAL_PROVIDER_FIELD_DESCRIPTOR":         "",
+	"CSFV":                                         "",
+	"CSPLATFORM":                                   "",
+	"CSTRING":                                      "",
+	"CURRENCYFMTA":                                 "",
+	"CURRENCYFMTW":                                 "",
+	"CURSORINFO":                                   "",
+	"CURSORSHAPE":                                  "",
+	"CUSTDATA":                                     "",
+	"CUSTDATAITEM":                                 "",
+	"CUSTOM_SYSTEM_EVENT_TRIGGER_CONFIG":           "",
+	"CWPRETSTRUCT":                                 "",
+	"CWPSTRUCT":                                    "",
+	"CY":                                           "",
+	"CY_Anonymous":                                 "",
+	"ComCallData":                                  "",
+	"DATABLOCK_HEADER":                             "",
+	"DATETIME":                                     "",
+	"DATETIMEPICKERINFO":                           "",
+	"DBGHELP_DATA_REPORT_STRUCT":                   "",
+	"DBGKD_DEBUG_DATA_HEADER32":                    "",
+	"DBGKD_DEBUG_DATA_HEADER64":                    "",
+	"DBGKD_GET_VERSION32":                          "",
+	"DBGKD_GET_VERSION64":                          "",
+	"DCICMD":                                       "",
+	"DCICREATEINPUT":                               "",
+	"DCIENUMINPUT":                                 "",
+	"DCIOFFSCREEN":                                 "",
+	"DCIOVERLAY":                                   "",
+	"DCISURFACEINFO":                               "",
+	"DDEACK":                                       "",
+	"DDEADVISE":                                    "",
+	"DDEDATA":                                      "",
+	"DDELN":                                        "",
+	"DDEML_MSG_HOOK_DATA":                          "",
+	"DDEPOKE":                                      "",
+	"DDEUP":                                        "",
+	"DEADKEY":                                      "",
+	"DEBUGHOOKINFO":                                "",
+	"DEBUG_BREAKPOINT_PARAMETERS":                  "",
+	"DEBUG_CACHED_SYMBOL_INFO":                     "",
+	"DEBUG_CLIENT_CONTEXT":                         "",
+	"DEBUG_CREATE_PROCESS_OPTIONS":                 "",
+	"DEBUG_EVENT":                                  "",
+	"DEBUG_EVENT_CONTEXT":                          "",
+	"DEBUG_EVENT_U":                                "",
+	"DEBUG_EXCEPTION_FILTER_PARAMETERS":            "",
+	"DEBUG_GET_TEXT_COMPLETIONS_IN":                "",
+	"DEBUG_GET_TEXT_COMPLETIONS_OUT":               "",
+	"DEBUG_HANDLE_DATA_BASIC":                      "",
+	"DEBUG_LAST_EVENT_INFO_BREAKPOINT":             "",
+	"DEBUG_LAST_EVENT_INFO_EXCEPTION":              "",
+	"DEBUG_LAST_EVENT_INFO_EXIT_PROCESS":           "",
+	"DEBUG_LAST_EVENT_INFO_EXIT_THREAD":            "",
+	"DEBUG_LAST_EVENT_INFO_LOAD_MODULE":            "",
+	"DEBUG_LAST_EVENT_INFO_SERVICE_EXCEPTION":      "",
+	"DEBUG_LAST_EVENT_INFO_SYSTEM_ERROR":           "",
+	"DEBUG_LAST_EVENT_INFO_UNLOAD_MODULE":          "",
+	"DEBUG_MODULE_AND_ID":                          "",
+	"DEBUG_MODULE_PARAMETERS":                      "",
+	"DEBUG_OFFSET_REGION":                          "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_ALL":           "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_ALPHA":         "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_AMD64":         "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_ARM":           "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_ARM64":         "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_IA64":          "",
+	"DEBUG_PROCESSOR_IDENTIFICATION_X86":           "",
+	"DEBUG_READ_USER_MINIDUMP_STREAM":              "",
+	"DEBUG_REGISTER_DESCRIPTION":                   "",
+	"DEBUG_SPECIFIC_FILTER_PARAMETERS":             "",
+	"DEBUG_STACK_FRAME":                            "",
+	"DEBUG_STACK_FRAME_EX":                         "",
+	"DEBUG_SYMBOL_ENTRY":                           "",
+	"DEBUG_SYMBOL_PARAMETERS":                      "",
+	"DEBUG_SYMBOL_SOURCE_ENTRY":                    "",
+	"DEBUG_THREAD_BASIC_INFORMATION":               "",
+	"DEBUG_TYPED_DATA":                             "",
+	"DEBUG_VALUE":                                  "",
+	"DEBUG_VALUE_Anonymous":                        "",
+	"DEBUG_VALUE_Anonymous_Anonymous":              "",
+	"DEBUG_VALUE_Anonymous_F128Parts64":            "",
+	"DEBUG_VALUE_Anonymous_I64Parts32":             "",
+	"DECIMAL":                                      "",
+	"DECIMAL_Anonymous1":                           "",
+	"DECIMAL_Anonymous1_Anonymous":                 "",
+	"DECIMAL_Anonymous2":                           "",
+	"DECIMAL_Anonymous2_Anonymous":                 "",
+	"DEFCONTEXTMENU":                               "",
+	"DELAYLOAD_INFO":                               "",
+	"DELAYLOAD_PROC_DESCRIPTOR":                    "",
+	"DELAYLOAD_PROC_DESCRIPTOR_Description":        "",
+	"DELEGATEITEMID":                               "",
+	"DELETEITEMSTRUCT":                             "",
+	"DESIGNVECTOR":                                 "",
+	"DESKBANDINFO":                                 "",
+	"DETAILSINFO":                                  "",
+	"DEVICE_EVENT_BECOMING_READY":                  "",
+	"DEVICE_EVENT_EXTERNAL_REQUEST":                "",
+	"DEVICE_EVENT_GENERIC_DATA":                    "",
+	"DEVICE_EVENT_MOUNT":                           "",
+	"DEVICE_EVENT_RBC_DATA":                        "",
+	"DEVICE_NOTIFY_SUBSCRIBE_PARAMETERS":           "",
+	"DEVMODEA":                                     "",
+	"DEVMODEA_Anonymous1":                          "",
+	"DEVMODEA_Anonymous1_Anonymous1":               "",
+	"DEVMODEA_Anonymous1_Anonymous2":               "",
+	"DEVMODEA_Anonymous2":                          "",
+	"DEVMODEW":                                     "",
+	"DEVMODEW_Anonymous1":                          "",
+	"DEVMODEW_Anonymous1_Anonymous1":               "",
+	"DEVMODEW_Anonymous1_Anonymous2":               "",
+	"DEVMODEW_Anonymous2":                          "",
+	"DEVNAMES":                                     "",
+	"DEV_BROADCAST_DEVICEINTERFACE_A":              "",
+	"DEV_BROADCAST_DEVICEINTERFACE_W":              "",
+	"DEV_BROADCAST_DEVNODE":                        "",
+	"DEV_BROADCAST_HANDLE":                         "",
+	"DEV_BROADCAST_HANDLE32":                       "",
+	"DEV_BROADCAST_HANDLE64":                       "",
+	"DEV_BROADCAST_HDR":                            "",
+	"DEV_BROADCAST_HEADER_":                        "",
+	"DEV_BROADCAST_NET":                            "",
+	"DEV_BROADCAST_OEM":                            "",
+	"DEV_BROADCAST_PORT_A":                         "",
+	"DEV_BROADCAST_PORT_W":                         "",
+	"DEV_BROADCAST_USERDEFINED_":                   "",
+	"DEV_BROADCAST_VOLUME":                         "",
+	"DFConstraint":                                 "4A3DF050-23BD-11D2-939F-00A0C91EEDBA",
+	"DFMICS":                                       "",
+	"DIBSECTION":                                   "",
+	"DISKQUOTA_USER_INFORMATION":                   "",
+	"DISK_HEALTH_NOTIFICATION_DATA":                "",
+	"DISK_SPACE_INFORMATION":                       "",
+	"DISPATCHER_CONTEXT":                           "",
+	"DISPATCHER_CONTEXT_NONVOLREG_ARM64":           "",
+	"DISPATCHER_CONTEXT_NONVOLREG_ARM64_Anonymous": "",
+	"DISPLAY_DEVICEA":                              "",
+	"DISPLAY_DEVICEW":                              "",
+	"DISPPARAMS":                                   "",
+	"DLGITEMTEMPLATE":                              "",
+	"DLGTEMPLATE":                                  "",
+	"DLLVERSIONINFO":                               "",
+	"DLLVERSIONINFO2":                              "",
+	"DOCINFOA":                                     "",
+	"DOCINFOW":                                     "",
+	"DPASTREAMINFO":                                "",
+	"DRAGINFOA":                                    "",
+	"DRAGINFOW":                                    "",
+	"DRAGLISTINFO":                                 "",
+	"DRAWITEMSTRUCT":                               "",
+	"DRAWPATRECT":                                  "",
+	"DRAWTEXTPARAMS":                               "",
+	"DROPDESCRIPTION":                              "",
+	"DROPFILES":                                    "",
+	"DROPSTRUCT":                                   "",
+	"DSKTLSYSTEMTIME":                              "",
+	"DShellFolderViewEvents":                       "62112AA2-EBE4-11CF-A5FB-0020AFE7292D",
+	"DShellNameSpaceEvents":                        "55136806-B2DE-11D1-B9F2-00A0C98BC547",
+	"DShellWindowsEvents":                          "FE4106E0-399A-11D0-A48C-00A0C90A8F39",
+	"DTBGOPTS":                                     "",
+	"DTTOPTS":                                      "",
+	"DUMP_FILE_ATTRIBUTES":                         "",
+	"DUMP_FILE_ATTRIBUTES_Anonymous":               "",
+	"DUMP_HEADER32":                                "",
+	"DUMP_HEADER32_Anonymous":                      "",
+	"DUMP_HEADER64":                                "",
+	"DUMP_HEADER64_Anonymous":                      "",
+	"DVASPECTINFO":                                 "",
+	"DVEXTENTINFO":                                 "",
+	"DVTARGETDEVICE":                               "",
+	"DWORD_BLOB":                                   "",
+	"DWORD_SIZEDARR":                               "",
+	"DWebBrowserEvents":                            "EAB22AC2-30C1-11CF-A7EB-0000C05BAE0B",
+	"DWebBrowserEvents2":                           "34A715A0-6587-11D0-924A-0020AFC7AC4D",
+	"DYNAMIC_TIME_ZONE_INFORMATION":                "",
+	"DebugBaseEventCallbacks":                      "00000000-0000-0000-0000-000000000000",
+	"DebugBaseEventCallbacksWide":                  "00000000-0000-0000-0000-000000000000",
+	"DebugPropertyInfo":                            "",
+	"DebugStackFrameDescriptor":                    "",
+	"DebugStackFrameDescriptor64":                  "",
+	"DetectEncodingInfo":                           "",
+	"DispatcherQueueOptions":                       "",
+	"EDITBALLOONTIP":                               "",
+	"EDITSTREAM":                                   "",
+	"EFS_CERTIFICATE_BLOB":                         "",
+	"EFS_COMPATIBILITY_INFO":                       "",
+	"EFS_DECRYPTION_STATUS_INFO":                   "",
+	"EFS_ENCRYPTION_STATUS_INFO":                   "",
+	"EFS_HASH_BLOB":                                "",
+	"EFS_KEY_INFO":                                 "",
+	"EFS_PIN_BLOB":                                 "",
+	"EFS_RPC_BLOB":                                 "",
+	"EFS_VERSION_INFO":                             "",
+	"ELEMDESC":                                     "",
+	"ELEMDESC_Anonymous":                           "",
+	"EMI_CHANNEL_MEASUREMENT_DATA":                 "",
+	"EMI_CHANNEL_V2":                               "",
+	"EMI_MEASUREMENT_DATA_V2":                      "",
+	"EMI_METADATA_SIZE":                            "",
+	"EMI_METADATA_V1":                              "",
+	"EMI_METADATA_V2":                              "",
+	"EMI_VERSION":                                  "",
+	"EMR":                                          "",
+	"EMRALPHABLEND":                                "",
+	"EMRANGLEARC":                                  "",
+	"EMRARC":                                       "",
+	"EMRBITBLT":                                    "",
+	"EMRCOLORCORRECTPALETTE":                       "",
+	"EMRCOLORMATCHTOTARGET":                        "",
+	"EMRCREATEBRUSHINDIRECT":                       "",
+	"EMRCREATEDIBPATTERNBRUSHPT":                   "",
+	"EMRCREATEMONOBRUSH":                           "",
+	"EMRCREATEPALETTE":                             "",
+	"EMRCREATEPEN":                                 "",
+	"EMRELLIPSE":                                   "",
+	"EMREOF":                                       "",
+	"EMREXCLUDECLIPRECT":                           "",
+	"EMREXTCREATEFONTINDIRECTW":                    "",
+	"EMREXTCREATEPEN":                              "",
+	"EMREXTESCAPE":                                 "",
+	"EMREXTFLOODFILL":                              "",
+	"EMREXTSELECTCLIPRGN":                          "",
+	"EMREXTTEXTOUTA":                               "",
+	"EMRFILLPATH":                                  "",
+	"EMRFILLRGN":                                   "",
+	"EMRFORMAT":                                    "",
+	"EMRFRAMERGN":                                  "",
+	"EMRGDICOMMENT":                                "",
+	"EMRGLSBOUNDEDRECORD":                          "",
+	"EMRGLSRECORD":                                 "",
+	"EMRGRADIENTFILL":                              "",
+	"EMRINVERTRGN":                                 "",
+	"EMRLINETO":                                    "",
+	"EMRMASKBLT":                                   "",
+	"EMRMODIFYWORLDTRANSFORM":                      "",
+	"EMRNAMEDESCAPE":                               "",
+	"EMROFFSETCLIPRGN":                             "",
+	"EMRPLGBLT":                                    "",
+	"EMRPOLYDRAW":                                  "",
+	"EMRPOLYDRAW16":                                "",
+	"EMRPOLYLINE":                                  "",
+	"EMRPOLYLINE16":                                "",
+	"EMRPOLYPOLYLINE":                              "",
+	"EMRPOLYPOLYLINE16":                            "",
+	"EMRPOLYTEXTOUTA":                              "",
+	"EMRRESIZEPALETTE":                             "",
+	"EMRRESTOREDC":                                 "",
+	"EMRROUNDRECT":                                 "",
+	"EMRSCALEVIEWPORTEXTEX":                        "",
+	"EMRSELECTCLIPPATH":                            "",
+	"EMRSELECTOBJECT":                              "",
+	"EMRSELECTPALETTE":                             "",
+	"EMRSETARCDIRECTION":                           "",
+	"EMRSETCOLORADJUSTMENT":                        "",
+	"EMRSETCOLORSPACE":                             "",
+	"EMRSETDIBITSTODEVICE":                         "",
+	"EMRSETICMPROFILE":                             "",
+	"EMRSETMAPPERFLAGS":                            "",
+	"EMRSETMITERLIMIT":                             "",
+	"EMRSETPALETTEENTRIES":                         "",
+	"EMRSETPIXELV":                                 "",
+	"EMRSETTEXTCOLOR":                              "",
+	"EMRSETVIEWPORTEXTEX":                          "",
+	"EMRSETVIEWPORTORGEX":                          "",
+	"EMRSETWORLDTRANSFORM":                         "",
+	"EMRSTRETCHBLT":                                "",
+	"EMRSTRETCHDIBITS":                             "",
+	"EMRTEXT":                                      "",
+	"EMRTRANSPARENTBLT":                            "",
+	"ENCLAVE_IDENTITY":                             "",
+	"ENCLAVE_INFORMATION":                          "",
+	"ENCLAVE_VBS_BASIC_KEY_REQUEST":                "",
+	"ENCORRECTTEXT":                                "",
+	"ENCRYPTED_FILE_METADATA_SIGNATURE":            "",
+	"ENCRYPTION_CERTIFICATE":                       "",
+	"ENCRYPTION_CERTIFICATE_HASH":                  "",
+	"ENCRYPTION_CERTIFICATE_HASH_LIST":             "",
+	"ENCRYPTION_CERTIFICATE_LIST":                  "",
+	"ENCRYPTION_PROTECTOR":                         "",
+	"ENCRYPTION_PROTECTOR_LIST":                    "",
+	"ENDCOMPOSITIONNOTIFY":                         "",
+	"ENDROPFILES":                                  "",
+	"ENHMETAHEADER":                                "",
+	"ENHMETARECORD":                                "",
+	"ENLINK":                                       "",
+	"ENLISTMENT_BASIC_INFORMATION":                 "",
+	"ENLISTMENT_CRM_INFORMATION":                   "",
+	"ENLOWFIRTF":                                   "",
+	"ENOLEOPFAILED":                                "",
+	"ENPROTECTED":                                  "",
+	"ENSAVECLIPBOARD":                              "",
+	"ENUMLOGFONTA":                                 "",
+	"ENUMLOGFONTEXA":                               "",
+	"ENUMLOGFONTEXDVA":                             "",
+	"ENUMLOGFONTEXDVW":                             "",
+	"ENUMLOGFONTEXW":                               "",
+	"ENUMLOGFONTW":                                 "",
+	"ENUMTEXTMETRICA":                              "",
+	"ENUMTEXTMETRICW":                              "",
+	"ENUMUILANG":                                   "",
+	"ENUM_SERVICE_STATUSA":                         "",
+	"ENUM_SERVICE_STATUSW":                         "",
+	"ENUM_SERVICE_STATUS_PROCESSA":                 "",
+	"ENUM_SERVICE_STATUS_PROCESSW":                 "",
+	"EVENTLOGRECORD":                               "",
+	"EVENTLOG_FULL_INFORMATION":                    "",
+	"EVENTMSG":                                     "",
+	"EVENTSFORLOGFILE":                             "",
+	"EVT_RPC_LOGIN":                                "",
+	"EVT_VARIANT":                                  "",
+	"EVT_VARIANT_Anonymous":                        "",
+	"EXCEPINFO":                                    "",
+	"EXCEPTION_DEBUG_INFO":                         "",
+	"EXCEPTION_POINTERS":                           "",
+	"EXCEPTION_RECORD":                             "",
+	"EXCEPTION_RECORD32":                           "",
+	"EXCEPTION_RECORD64":                           "",
+	"EXCEPTION_REGISTRATION_RECORD":                "",
+	"EXIT_PROCESS_DEBUG_INFO":                      "",
+	"EXIT_THREAD_DEBUG_INFO":                       "",
+	"EXP_DARWIN_LINK":                              "",
+	"EXP_PROPERTYSTORAGE":                          "",
+	"EXP_SPECIAL_FOLDER":                           "",
+	"EXP_SZ_LINK":                                  "",
+	"EXTLOGFONTA":                                  "",
+	"EXTLOGFONTW":                                  "",
+	"EXTLOGPEN":                                    "",
+	"EXTLOGPEN32":                                  "",
+	"EXTRASEARCH":                                  "",
+	"EXTSTACKTRACE":                                "",
+	"EXTSTACKTRACE32":                              "",
+	"EXTSTACKTRACE64":                              "",
+	"EXT_API_VERSION":                              "",
+	"EXT_FIND_FILE":                                "",
+	"EXT_MATCH_PATTERN_A":                          "",
+	"EXT_TYPED_DATA":                               "",
+	"EventRegistrationToken":                       "",
+	"ExtendedDebugPropertyInfo":                    "",
+	"ExtendedProperty":                             "",
+	"FEATURE_ERROR":                                "",
+	"FH_OVERLAPPED":                                "",
+	"FIELD_INFO":                                   "",
+	"FIELD_INFO_Anonymous":                         "",
+	"FIELD_INFO_BitField":                          "",
+	"FILEDESCRIPTORA":                              "",
+	"FILEDESCRIPTORW":                              "",
+	"FILEGROUPDESCRIPTORA":                         "",
+	"FILEGROUPDESCRIPTORW":                         "",
+	"FILEMUIINFO":                                  "",
+	"FILETIME":                                     "",
+	"FILE_ALIGNMENT_INFO":                          "",
+	"FILE_ALLOCATION_INFO":                         "",
+	"FILE_ATTRIBUTES_ARRAY":                        "",
+	"FILE_ATTRIBUTE_TAG_INFO":                      "",
+	"FILE_BASIC_INFO":                              "",
+	"FILE_CASE_SENSITIVE_INFO":                     "",
+	"FILE_COMPRESSION_INFO":                        "",
+	"FILE_DISPOSITION_INFO":                        "",
+	"FILE_DISPOSITION_INFO_EX":                     "",
+	"FILE_END_OF_FILE_INFO":                        "",
+	"FILE_EXTENT":                                  "",
+	"FILE_FULL_DIR_INFO":                           "",
+	"FILE_ID_128":                                  "",
+	"FILE_ID_BOTH_DIR_INFO":                        "",
+	"FILE_ID_DESCRIPTOR":                           "",
+	"FILE_ID_DESCRIPTOR_Anonymous":                 "",
+	"FILE_ID_EXTD_DIR_INFO":                        "",
+	"FILE_ID_INFO":                                 "",
+	"FILE_INFO_2":                                  "",
+	"FILE_INFO_3":                                  "",
+	"FILE_IO_PRIORITY_HINT_INFO":                   "",
+	"FILE_NAME_INFO":                               "",
+	"FILE_NOTIFY_EXTENDED_INFORMATION":             "",
+	"FILE_NOTIFY_INFORMATION":                      "",
+	"FILE_REMOTE_PROTOCOL_INFO":                    "",
+	"FILE_REMOTE_PROTOCOL_INFO_GenericReserved":    "",
+	"FILE_REMOTE_PROTOCOL_INFO_ProtocolSpecific":   "",
+	"FILE_REMOTE_PROTOCOL_INFO_ProtocolSpecific_Smb2":        "",
+	"FILE_REMOTE_PROTOCOL_INFO_ProtocolSpecific_Smb2_Server": "",
+	"FILE_REMOTE_PROTOCOL_INFO_ProtocolSpecific_Smb2_Share":  "",
+	"FILE_RENAME_INFO":                         "",
+	"FILE_RENAME_INFO_Anonymous":               "",
+	"FILE_SEGMENT_ELEMENT":                     "",
+	"FILE_STANDARD_INFO":                       "",
+	"FILE_STORAGE_INFO":                        "",
+	"FILE_STREAM_INFO":                         "",
+	"FILTERKEYS":                               "",
+	"FINDREPLACEA":                             "",
+	"FINDREPLACEW":                             "",
+	"FINDTEXTA":                                "",
+	"FINDTEXTEXA":                              "",
+	"FINDTEXTEXW":                              "",
+	"FINDTEXTW":                                "",
+	"FIO_CONTEXT":                              "",
+	"FIXED":                                    "",
+	"FLAGGED_BYTE_BLOB":                        "",
+	"FLAGGED_WORD_BLOB":                        "",
+	"FLAG_STGMEDIUM":                           "",
+	"FLASHWINFO":                               "",
+	"FLOAT128":                                 "",
+	"FLOATING_SAVE_AREA":                       "",
+	"FOCUS_EVENT_RECORD":                       "",
+	"FOLDERSETDATA":                            "",
+	"FOLDERSETTINGS":                           "",
+	"FONTDESC":                                 "",
+	"FONTSIGNATURE":                            "",
+	"FORMATETC":                                "",
+	"FORMATRANGE":                              "",
+	"FPO_DATA":                                 "",
+	"FUNCDESC":                                 "",
+	"Folder":                                   "BBCBDE60-C3FF-11CE-8350-444553540000",
+	"Folder2":                                  "F0D2D8EF-3890-11D2-BF8B-00C04FB93661",
+	"Folder3":                                  "A7AE5F64-C4D7-4D7F-9307-4D24EE54B841",
+	"FolderItem":                               "FAC32C80-CBE4-11CE-8350-444553540000",
+	"FolderItem2":                              "EDC817AA-92B8-11D1-B075-00C04FC33AA5",
+	"FolderItemVerb":                           "08EC3E00-50B0-11CF-960C-0080C7F4EE85",
+	"FolderItemVerbs":                          "1F8352C0-50B0-11CF-960C-0080C7F4EE85",
+	"FolderItems":                              "744129E0-CBE5-11CE-8350-444553540000",
+	"FolderItems2":                             "C94F0AD0-F363-11D2-A327-00C04F8EEC7F",
+	"FolderItems3":                             "EAA7C309-BBEC-49D5-821D-64D966CB667F",
+	"GCP_RESULTSA":                             "",
+	"GCP_RESULTSW":                             "",
+	"GDI_NONREMOTE":                            "",
+	"GDI_NONREMOTE_U":                          "",
+	"GDI_OBJECT":                               "",
+	"GDI_OBJECT_U":                             "",
+	"GENERIC_MAPPING":                          "",
+	"GETCONTEXTMENUEX":                         "",
+	"GETTEXTEX":                                "",
+	"GETTEXTLENGTHEX":                          "",
+	"GET_CONTEXT_EX":                           "",
+	"GET_CURRENT_PROCESS_ADDRESS":              "",
+	"GET_CURRENT_THREAD_ADDRESS":               "",
+	"GET_EXPRESSION_EX":                        "",
+	"GET_INPUT_LINE":                           "",
+	"GET_PEB_ADDRESS":                          "",
+	"GET_SET_SYMPATH":                          "",
+	"GET_TEB_ADDRESS":                          "",
+	"GLOBAL_MACHINE_POWER_POLICY":              "",
+	"GLOBAL_POWER_POLICY":                      "",
+	"GLOBAL_USER_POWER_POLICY":                 "",
+	"GLYPHMETRICS":                             "",
+	"GLYPHSET":                                 "",
+	"GOFFSET":                                  "",
+	"GRADIENT_RECT":                            "",
+	"GRADIENT_TRIANGLE":                        "",
+	"GROUPTYPINGCHANGE":                        "",
+	"GROUP_AFFINITY":                           "",
+	"GROUP_RELATIONSHIP":                       "",
+	"GUID_IO_DISK_CLONE_ARRIVAL_INFORMATION":   "",
+	"GUITHREADINFO":                            "",
+	"HANDLETABLE":                              "",
+	"HARDWAREHOOKSTRUCT":                       "",
+	"HARDWAREINPUT":                            "",
+	"HDHITTESTINFO":                            "",
+	"HDITEMA":                                  "",
+	"HDITEMW":                                  "",
+	"HDLAYOUT":                                 "",
+	"HD_TEXTFILTERA":                           "",
+	"HD_TEXTFILTERW":                           "",
+	"HEAPENTRY32":                              "",
+	"HEAPLIST32":                               "",
+	"HEAP_OPTIMIZE_RESOURCES_INFORMATION":      "",
+	"HEAP_SUMMARY":                             "",
+	"HELPINFO":                                 "",
+	"HELPWININFOA":                             "",
+	"HELPWININFOW":                             "",
+	"HIBERFILE_BUCKET":                         "",
+	"HIGHCONTRASTA":                            "",
+	"HIGHCONTRASTW":                            "",
+	"HIORING__":                                "",
+	"HLBWINFO":                                 "",
+	"HLITEM":                                   "",
+	"HLSURF__":                                 "",
+	"HLTBINFO":                                 "",
+	"HSPRITE__":                                "",
+	"HSTRING_HEADER":                           "",
+	"HSTR__":                                   "",
+	"HSZPAIR":                                  "",
+	"HUMPD__":                                  "",
+	"HW_PROFILE_INFOA":                         "",
+	"HW_PROFILE_INFOW":                         "",
+	"HYPER_SIZEDARR":                           "",
+	"HYPHENATEINFO":                            "",
+	"HYPHRESULT":                               "",
+	"IACList":                                  "77A130B0-94FD-11D0-A544-00C04FD7D062",
+	"IACList2":                                 "470141A0-5186-11D2-BBB6-0060977B464C",
+	"IAccIdentity":                             "7852B78D-1CFD-41C1-A615-9C0C85960B5F",
+	"IAccPropServer":                           "76C0DBBB-15E0-4E7B-B61B-20EEEA2001E0",
+	"IAccPropServices":                         "6E26E776-04F0-495D-80E4-3330352E3169",
+	"IAccessibilityDockingService":             "8849DC22-CEDF-4C95-998D-051419DD3F76",
+	"IAccessibilityDockingServiceCallback":     "157733FD-A592-42E5-B594-248468C5A81B",
+	"IAccessible":                              "618736E0-3C3D-11CF-810C-00AA00389B71",
+	"IAccessibleEx":                            "F8B80ADA-2C44-48D0-89BE-5FF23C9CD875",
+	"IAccessibleHandler":                       "03022430-ABC4-11D0-BDE2-00AA001A1953",
+	"IAccessibleHostingElementProviders":       "33AC331B-943E-4020-B295-DB37784974A3",
+	"IAccessibleObject":                        "95A391C5-9ED4-4C28-8401-AB9E06719E11",
+	"IAccessibleWindowlessSite":                "BF3ABD9C-76DA-4389-9EB6-1427D25ABAB7",
+	"IAccountsSettingsPaneInterop":             "D3EE12AD-3865-4362-9746-B75A682DF0E6",
+	"IActionProgress":                          "49FF1173-EADC-446D-9285-156453A6431C",
+	"IActionProgressDialog":                    "49FF1172-EADC-446D-9285-156453A6431C",
+	"IActivationFactory":                       "00000035-0000-0000-C000-000000000046",
+	"IActivationFilter":                        "00000017-0000-0000-C000-000000000046",
+	"IActiveScript":                            "BB1A2AE1-A4F9-11CF-8F20-00805F2CD064",
+	"IActiveScriptAuthor":                      "9C109DA0-7006-11D1-B36C-00A0C911E8B2",
+	"IActiveScriptAuthorProcedure":             "7E2D4B70-BD9A-11D0-9336-00A0C90DCAA9",
+	"IActiveScriptDebug32":                     "51973C10-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IActiveScriptDebug64":                     "BC437E23-F5B8-47F4-BB79-7D1CE5483B86",
+	"IActiveScriptEncode":                      "BB1A2AE3-A4F9-11CF-8F20-00805F2CD064",
+	"IActiveScriptError":                       "EAE1BA61-A4ED-11CF-8F20-00805F2CD064",
+	"IActiveScriptError64":                     "B21FB2A1-5B8F-4963-8C21-21450F84ED7F",
+	"IActiveScriptErrorDebug":                  "51973C12-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IActiveScriptErrorDebug110":               "516E42B6-89A8-4530-937B-5F0708431442",
+	"IActiveScriptGarbageCollector":            "6AA2C4A0-2B53-11D4-A2A0-00104BD35090",
+	"IActiveScriptHostEncode":                  "BEE9B76E-CFE3-11D1-B747-00C04FC2B085",
+	"IActiveScriptParse32":                     "BB1A2AE2-A4F9-11CF-8F20-00805F2CD064",
+	"IActiveScriptParse64":                     "C7EF7658-E1EE-480E-97EA-D52CB4D76D17",
+	"IActiveScriptParseProcedure2_32":          "71EE5B20-FB04-11D1-B3A8-00A0C911E8B2",
+	"IActiveScriptParseProcedure2_64":          "FE7C4271-210C-448D-9F54-76DAB7047B28",
+	"IActiveScriptParseProcedure32":            "AA5B6A80-B834-11D0-932F-00A0C90DCAA9",
+	"IActiveScriptParseProcedure64":            "C64713B6-E029-4CC5-9200-438B72890B6A",
+	"IActiveScriptParseProcedureOld32":         "1CFF0050-6FDD-11D0-9328-00A0C90DCAA9",
+	"IActiveScriptParseProcedureOld64":         "21F57128-08C9-4638-BA12-22D15D88DC5C",
+	"IActiveScriptProfilerCallback":            "740ECA23-7D9D-42E5-BA9D-F8B24B1C7A9B",
+	"IActiveScriptProfilerCallback2":           "31B7F8AD-A637-409C-B22F-040995B6103D",
+	"IActiveScriptProfilerCallback3":           "6AC5AD25-2037-4687-91DF-B59979D93D73",
+	"IActiveScriptProfilerControl":             "784B5FF0-69B0-47D1-A7DC-2518F4230E90",
+	"IActiveScriptProfilerControl2":            "47810165-498F-40BE-94F1-653557E9E7DA",
+	"IActiveScriptProfilerControl3":            "0B403015-F381-4023-A5D0-6FED076DE716",
+	"IActiveScriptProfilerControl4":            "160F94FD-9DBC-40D4-9EAC-2B71DB3132F4",
+	"IActiveScriptProfilerControl5":            "1C01A2D1-8F0F-46A5-9720-0D7ED2C62F0A",
+	"IActiveScriptProfilerHeapEnum":            "32E4694E-0D37-419B-B93D-FA20DED6E8EA",
+	"IActiveScriptProperty":                    "4954E0D0-FBC7-11D1-8410-006008C3FBFC",
+	"IActiveScriptSIPInfo":                     "764651D0-38DE-11D4-A2A3-00104BD35090",
+	"IActiveScriptSite":                        "DB01A1E3-A42B-11CF-8F20-00805F2CD064",
+	"IActiveScriptSiteDebug32":                 "51973C11-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IActiveScriptSiteDebug64":                 "D6B96B0A-7463-402C-92AC-89984226942F",
+	"IActiveScriptSiteDebugEx":                 "BB722CCB-6AD2-41C6-B780-AF9C03EE69F5",
+	"IActiveScriptSiteInterruptPoll":           "539698A0-CDCA-11CF-A5EB-00AA0047A063",
+	"IActiveScriptSiteTraceInfo":               "4B7272AE-1955-4BFE-98B0-780621888569",
+	"IActiveScriptSiteUIControl":               "AEDAE97E-D7EE-4796-B960-7F092AE844AB",
+	"IActiveScriptSiteWindow":                  "D10F6761-83E9-11CF-8F20-00805F2CD064",
+	"IActiveScriptStats":                       "B8DA6310-E19B-11D0-933C-00A0C90DCAA9",
+	"IActiveScriptStringCompare":               "58562769-ED52-42F7-8403-4963514E1F11",
+	"IActiveScriptTraceInfo":                   "C35456E7-BEBF-4A1B-86A9-24D56BE8B369",
+	"IActiveScriptWinRTErrorDebug":             "73A3F82A-0FE9-4B33-BA3B-FE095F697E0A",
+	"IAddrExclusionControl":                    "00000148-0000-0000-C000-000000000046",
+	"IAddrTrackingControl":                     "00000147-0000-0000-C000-000000000046",
+	"IAdviseSink":                              "0000010F-0000-0000-C000-000000000046",
+	"IAdviseSink2":                             "00000125-0000-0000-C000-000000000046",
+	"IAdviseSinkEx":                            "3AF24290-0C96-11CE-A0CF-00AA00600AB8",
+	"IAgileObject":                             "94EA2B94-E9CC-49E0-C0FF-EE64CA8F5B90",
+	"IAgileReference":                          "C03F6A43-65A4-9818-987E-E0B810D2A6F2",
+	"IAnnotationProvider":                      "F95C7E80-BD63-4601-9782-445EBFF011FC",
+	"IApartmentShutdown":                       "A2F05A09-27A2-42B5-BC0E-AC163EF49D9B",
+	"IAppActivationUIInfo":                     "ABAD189D-9FA3-4278-B3CA-8CA448A88DCB",
+	"IAppPublisher":                            "07250A10-9CF9-11D1-9076-006008059382",
+	"IAppServiceConnectionExtendedExecution":   "65219584-F9CB-4AE3-81F9-A28A6CA450D9",
+	"IAppVisibility":                           "2246EA2D-CAEA-4444-A3C4-6DE827E44313",
+	"IAppVisibilityEvents":                     "6584CE6B-7D82-49C2-89C9-C6BC02BA8C38",
+	"IApplicationActivationManager":            "2E941141-7F97-4756-BA1D-9DECDE894A3D",
+	"IApplicationAssociationRegistration":      "4E530B0A-E611-4C77-A3AC-9031D022281B",
+	"IApplicationAssociationRegistrationUI":    "1F76A169-F994-40AC-8FC8-0959E8874710",
+	"IApplicationDebugger":                     "51973C2A-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IApplicationDebuggerUI":                   "51973C2B-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IApplicationDesignModeSettings":           "2A3DEE9A-E31D-46D6-8508-BCC597DB3557",
+	"IApplicationDesignModeSettings2":          "490514E1-675A-4D6E-A58D-E54901B4CA2F",
+	"IApplicationDestinations":                 "12337D35-94C6-48A0-BCE7-6A9C69D4D600",
+	"IApplicationDocumentLists":                "3C594F9F-9F30-47A1-979A-C9E83D3D0A06",
+	"IAssocHandler":                            "F04061AC-1659-4A3F-A954-775AA57FC083",
+	"IAssocHandlerInvoker":                     "92218CAB-ECAA-4335-8133-807FD234C2EE",
+	"IAsyncManager":                            "0000002A-0000-0000-C000-000000000046",
+	"IAsyncRpcChannelBuffer":                   "A5029FB6-3C34-11D1-9C99-00C04FB998AA",
+	"IAttachmentExecute":                       "73DB1241-1E85-4581-8E4F-A81E1D0F8C57",
+	"IAuthenticate":                            "79EAC9D0-BAF9-11CE-8C82-00AA004BA90B",
+	"IAuthenticateEx":                          "2AD1EDAF-D83D-48B5-9ADF-03DBE19F53BD",
+	"IAutoComplete":                            "00BB2762-6A77-11D0-A535-00C04FD7D062",
+	"IAutoComplete2":                           "EAC04BC0-3791-11D2-BB95-0060977B464C",
+	"IAutoCompleteDropDown":                    "3CD141F4-3C6A-11D2-BCAA-00C04FD929DB",
+	"IBandHost":                                "B9075C7C-D48E-403F-AB99-D6C77A1084AC",
+	"IBandSite":                                "4CF504B0-DE96-11D0-8B3F-00A0C911E8E5",
+	"IBannerNotificationHandler":               "8D7B2BA7-DB05-46A8-823C-D2B6DE08EE91",
+	"IBanneredBar":                             "596A9A94-013E-11D1-8D34-00A0C90F2719",
+	"IBindCtx":                                 "0000000E-0000-0000-C000-000000000046",
+	"IBindEventHandler":                        "63CDBCB0-C1B1-11D0-9336-00A0C90DCAA9",
+	"IBindHost":                                "FC4801A1-2BA9-11CF-A229-00AA003D7352",
+	"IBindStatusCallback":                      "79EAC9C1-BAF9-11CE-8C82-00AA004BA90B",
+	"IBindStatusCallbackEx":                    "AAA74EF9-8EE7-4659-88D9-F8C504DA73CC",
+	"IBinding":                                 "79EAC9C0-BAF9-11CE-8C82-00AA004BA90B",
+	"IBlockingLock":                            "30F3D47A-6447-11D1-8E3C-00C04FB9386D",
+	"IBrowserFrameOptions":                     "10DF43C8-1DBE-11D3-8B34-006097DF5BD4",
+	"IBrowserService":                          "02BA3B52-0547-11D1-B833-00C04FC9B31F",
+	"IBrowserService2":                         "68BD21CC-438B-11D2-A560-00A0C92DBFE8",
+	"IBrowserService3":                         "27D7CE21-762D-48F3-86F3-40E2FD3749C4",
+	"IBrowserService4":                         "639F1BFF-E135-4096-ABD8-E0F504D649A4",
+	"IBufferByteAccess":                        "905A0FEF-BC53-11DF-8C49-001E4FC686DA",
+	"ICDBurn":                                  "3D73A659-E5D0-4D42-AFC0-5121BA425C8D",
+	"ICDBurnExt":                               "2271DCCA-74FC-4414-8FB7-C56B05ACE2D7",
+	"ICONINFO":                                 "",
+	"ICONINFOEXA":                              "",
+	"ICONINFOEXW":                              "",
+	"ICONMETRICSA":                             "",
+	"ICONMETRICSW":                             "",
+	"ICallFactory":                             "1C733A30-2A1C-11CE-ADE5-00AA0044773D",
+	"ICameraUIControl":                         "B8733ADF-3D68-4B8F-BB08-E28A0BED0376",
+	"ICameraUIControlEventCallback":            "1BFA0C2C-FBCD-4776-BDA4-88BF974E74F4",
+	"ICanHandleException":                      "C5598E60-B307-11D1-B27D-006008C3FBFB",
+	"ICancelMethodCalls":                       "00000029-0000-0000-C000-000000000046",
+	"ICastingController":                       "F0A56423-A664-4FBD-8B43-409A45E8D9A1",
+	"ICastingEventHandler":                     "C79A6CB7-BEBD-47A6-A2AD-4D45AD79C7BC",
+	"ICastingSourceInfo":                       "45101AB7-7C3A-4BCE-9500-12C09024B298",
+	"ICatInformation":                          "0002E013-0000-0000-C000-000000000046",
+	"ICatRegister":                             "0002E012-0000-0000-C000-000000000046",
+	"ICategorizer":                             "A3B14589-9174-49A8-89A3-06A1AE2B9BA7",
+	"ICategoryProvider":                        "9AF64809-5864-4C26-A720-C1F78C086EE3",
+	"IChannelHook":                             "1008C4A0-7613-11CF-9AF1-0020AF6E72F4",
+	"IClassActivator":                          "00000140-0000-0000-C000-000000000046",
+	"IClassFactory":                            "00000001-0000-0000-C000-000000000046",
+	"IClassFactory2":                           "B196B28F-BAB4-101A-B69C-00AA00341D07",
+	"IClientSecurity":                          "0000013D-0000-0000-C000-000000000046",
+	"IClipServiceNotificationHelper":           "C39948F0-6142-44FD-98CA-E1681A8D68B5",
+	"ICodeAddressConcept":                      "C7371568-5C78-4A00-A4AB-6EF8823184CB",
+	"IColumnManager":                           "D8EC27BB-3F3B-4042-B10A-4ACFD924D453",
+	"IColumnProvider":                          "E8025004-1C42-11D2-BE2C-00A0C9A83DA1",
+	"IComThreadingInfo":                        "000001CE-0000-0000-C000-000000000046",
+	"ICommDlgBrowser":                          "000214F1-0000-0000-C000-000000000046",
+	"ICommDlgBrowser2":                         "10339516-2894-11D2-9039-00C04F8EEB3E",
+	"ICommDlgBrowser3":                         "C8AD25A1-3294-41EE-8165-71174BD01C57",
+	"IComparableConcept":                       "A7830646-9F0C-4A31-BA19-503F33E6C8A3",
+	"IComprehensiveSpellCheckProvider":         "0C58F8DE-8E94-479E-9717-70C42C4AD2C3",
+	"IComputerInfoChangeNotify":                "0DF60D92-6818-46D6-B358-D66170DDE466",
+	"IConnectableCredentialProviderCredential": "9387928B-AC75-4BF9-8AB2-2B93C4A55290",
+	"IConnectionPoint":                         "B196B286-BAB4-101A-B69C-00AA00341D07",
+	"IConnectionPointContainer":                "B196B284-BAB4-101A-B69C-00AA00341D07",
+	"IContactManagerInterop":                   "99EACBA7-E073-43B6-A896-55AFE48A0833",
+	"IContainerActivationHelper":               "B524F93F-80D5-4EC7-AE9E-D66E93ADE1FA",
+	"IContextCallback":                         "000001DA-0000-0000-C000-000000000046",
+	"IContextMenu":                             "000214E4-0000-0000-C000-000000000046",
+	"IContextMenu2":                            "000214F4-0000-0000-C000-000000000046",
+	"IContextMenu3":                            "BCFCE0A0-EC17-11D0-8D10-00A0C90F2719",
+	"IContextMenuCB":                           "3409E930-5A39-11D1-83FA-00A0C90DC849",
+	"IContextMenuSite":                         "0811AEBE-0B87-4C54-9E72-548CF649016B",
+	"IContinue":                                "0000012A-0000-0000-C000-000000000046",
+	"IContinueCallback":                        "B722BCCA-4E68-101B-A2BC-00AA00404770",
+	"ICopyHookA":                               "000214EF-0000-0000-C000-000000000046",
+	"ICopyHookW":                               "000214FC-0000-0000-C000-000000000046",
+	"ICoreInputInterop":                        "40BFE3E3-B75A-4479-AC96-475365749BB8",
+	"ICoreWindowAdapterInterop":                "7A5B6FD1-CD73-4B6C-9CF4-2E869EAF470A",
+	"ICoreWindowComponentInterop":              "0576AB31-A310-4C40-BA31-FD37E0298DFA",
+	"ICoreWindowInterop":                       "45D64A29-A63E-4CB6-B498-5781D298CB4F",
+	"ICorrelationVectorInformation":            "83C78B3C-D88B-4950-AA6E-22B8D22AABD3",
+	"ICorrelationVectorSource":                 "152B8A3B-B9B9-4685-B56E-974847BC7545",
+	"ICreateErrorInfo":                         "22F03340-547D-101B-8E65-08002B2BD119",
+	"ICreateObject":                            "75121952-E0D0-43E5-9380-1D80483ACF72",
+	"ICreateProcessInputs":                     "F6EF6140-E26F-4D82-BAC4-E9BA5FD239A8",
+	"ICreateTypeInfo":                          "00020405-0000-0000-C000-000000000046",
+	"ICreateTypeInfo2":                         "0002040E-0000-0000-C000-000000000046",
+	"ICreateTypeLib":                           "00020406-0000-0000-C000-000000000046",
+	"ICreateTypeLib2":                          "0002040F-0000-0000-C000-000000000046",
+	"ICreatingProcess":                         "C2B937A9-3110-4398-8A56-F34C6342D244",
+	"ICredentialProvider":                      "D27C3481-5A1C-45B2-8AAA-C20EBBE8229E",
+	"ICredentialProviderCredential":            "63913A93-40C1-481A-818D-4072FF8C70CC",
+	"ICredentialProviderCredential2":           "FD672C54-40EA-4D6E-9B49-CFB1A7507BD7",
+	"ICredentialProviderCredentialEvents":      "FA6FA76B-66B7-4B11-95F1-86171118E816",
+	"ICredentialProviderCredentialEvents2":     "B53C00B6-9922-4B78-B1F4-DDFE774DC39B",
+	"ICredentialProviderCredentialWithFieldOptions": "DBC6FB30-C843-49E3-A645-573E6F39446A",
+	"ICredentialProviderEvents":                     "34201E5A-A787-41A3-A5A4-BD6DCF2A854E",
+	"ICredentialProviderFilter":                     "A5DA53F9-D475-4080-A120-910C4A739880",
+	"ICredentialProviderSetUserArray":               "095C1484-1C0C-4388-9C6D-500E61BF84BD",
+	"ICredentialProviderUser":                       "13793285-3EA6-40FD-B420-15F47DA41FBB",
+	"ICredentialProviderUserArray":                  "90C119AE-0F18-4520-A1F1-114366A40FE8",
+	"ICurrentItem":                                  "240A7174-D653-4A1D-A6D3-D4943CFBFE3D",
+	"ICurrentWorkingDirectory":                      "91956D21-9276-11D1-921A-006097DF5BD4",
+	"ICustomDestinationList":                        "6332DEBF-87B5-4670-90C0-5E57B408A49E",
+	"ICustomNavigationProvider":                     "2062A28A-8C07-4B94-8E12-7037C622AEB8",
+	"IDLDESC":                                       "",
+	"IDataAdviseHolder":                             "00000110-0000-0000-C000-000000000046",
+	"IDataModelConcept":                             "FCB98D1D-1114-4FBF-B24C-EFFCB5DEF0D3",
+	"IDataModelManager":                             "73FE19F4-A110-4500-8ED9-3C28896F508C",
+	"IDataModelManager2":                            "F412C5EA-2284-4622-A660-A697160D3312",
+	"IDataModelNameBinder":                          "AF352B7B-8292-4C01-B360-2DC3696C65E7",
+	"IDataModelScript":                              "7B4D30FC-B14A-49F8-8D87-D9A1480C97F7",
+	"IDataModelScriptClient":                        "3B362B0E-89F0-46C6-A663-DFDC95194AEF",
+	"IDataModelScriptDebug":                         "DE8E0945-9750-4471-AB76-A8F79D6EC350",
+	"IDataModelScriptDebug2":                        "CBB10ED3-839E-426C-9243-E23535C1AE1A",
+	"IDataModelScriptDebugBreakpoint":               "6BB27B35-02E6-47CB-90A0-5371244032DE",
+	"IDataModelScriptDebugBreakpointEnumerator":     "39484A75-B4F3-4799-86DA-691AFA57B299",
+	"IDataModelScriptDebugClient":                   "53159B6D-D4C4-471B-A863-5B110CA800CA",
+	"IDataModelScriptDebugStack":                    "051364DD-E449-443E-9762-FE578F4A5473",
+	"IDataModelScriptDebugStackFrame":               "DEC6ED5E-6360-4941-AB4C-A26409DE4F82",
+	"IDataModelScriptDebugVariableSetEnumerator":    "0F9FEED7-D045-4AC3-98A8-A98942CF6A35",
+	"IDataModelScriptHostContext":                   "014D366A-1F23-4981-9219-B2DB8B402054",
+	"IDataModelScriptManager":                       "6FD11E33-E5AD-410B-8011-68C6BC4BF80D",
+	"IDataModelScriptProvider":                      "513461E0-4FCA-48CE-8658-32F3E2056F3B",
+	"IDataModelScriptProviderEnumerator":            "95BA00E2-704A-4FE2-A8F1-A7E7D8FB0941",
+	"IDataModelScriptTemplate":                      "1303DEC4-FA3B-4F1B-9224-B953D16BABB5",
+	"IDataModelScriptTemplateEnumerator":            "69CE6AE2-2268-4E6F-B062-20CE62BFE677",
+	"IDataObject":                                   "0000010E-0000-0000-C000-000000000046",
+	"IDataObjectAsyncCapability":                    "3D8B0590-F691-11D2-8EA9-006097DF5BD4",
+	"IDataObjectProvider":                           "3D25F6D6-4B2A-433C-9184-7C33AD35D001",
+	"IDataTransferManagerInterop":                   "3A3DCD6C-3EAB-43DC-BCDE-45671CE800C8",
+	"IDebugAdvanced":                                "F2DF5F53-071F-47BD-9DE6-5734C3FED689",
+	"IDebugAdvanced2":                               "716D14C9-119B-4BA5-AF1F-0890E672416A",
+	"IDebugAdvanced3":                               "CBA4ABB4-84C4-444D-87CA-A04E13286739",
+	"IDebugAdvanced4":                               "D1069067-2A65-4BF0-AE97-76184B67856B",
+	"IDebugApplication11032":                        "BDB3B5DE-89F2-4E11-84A5-97445F941C7D",
+	"IDebugApplication11064":                        "2039D958-4EEB-496A-87BB-2E5201EADEEF",
+	"IDebugApplication32":                           "51973C32-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugApplication64":                           "4DEDC754-04C7-4F10-9E60-16A390FE6E62",
+	"IDebugApplicationNode":                         "51973C34-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugApplicationNode100":                      "90A7734E-841B-4F77-9384-A2891E76E7E2",
+	"IDebugApplicationNodeEvents":                   "51973C35-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugApplicationThread":                       "51973C38-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugApplicationThread11032":                  "2194AC5C-6561-404A-A2E9-F57D72DE3702",
+	"IDebugApplicationThread11064":                  "420AA4CC-EFD8-4DAC-983B-47127826917D",
+	"IDebugApplicationThread64":                     "9DAC5886-DBAD-456D-9DEE-5DEC39AB3DDA",
+	"IDebugApplicationThreadEvents110":              "84E5E468-D5DA-48A8-83F4-40366429007B",
+	"IDebugAsyncOperation":                          "51973C1B-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugAsyncOperationCallBack":                  "51973C1C-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugBreakpoint":                              "5BD9D474-5975-423A-B88B-65A8E7110E65",
+	"IDebugBreakpoint2":                             "1B278D20-79F2-426E-A3F9-C1DDF375D48E",
+	"IDebugBreakpoint3":                             "38F5C249-B448-43BB-9835-579D4EC02249",
+	"IDebugClient":                                  "27FE5639-8407-4F47-8364-EE118FB08AC8",
+	"IDebugClient2":                                 "EDBED635-372E-4DAB-BBFE-ED0D2F63BE81",
+	"IDebugClient3":                                 "DD492D7F-71B8-4AD6-A8DC-1C887479FF91",
+	"IDebugClient4":                                 "CA83C3DE-5089-4CF8-93C8-D892387F2A5E",
+	"IDebugClient5":                                 "E3ACB9D7-7EC2-4F0C-A0DA-E81E0CBBE628",
+	"IDebugClient6":                                 "FD28B4C5-C498-4686-A28E-62CAD2154EB3",
+	"IDebugClient7":                                 "13586BE3-542E-481E-B1F2-8497BA74F9A9",
+	"IDebugClient8":                                 "CEC43ADD-6375-469E-83D5-414E4033C19A",
+	"IDebugCodeContext":                             "51973C13-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugControl":                                 "5182E668-105E-416E-AD92-24EF800424BA",
+	"IDebugControl2":                                "D4366723-44DF-4BED-8C7E-4C05424F4588",
+	"IDebugControl3":                                "7DF74A86-B03F-407F-90AB-A20DADCEAD08",
+	"IDebugControl4":                                "94E60CE9-9B41-4B19-9FC0-6D9EB35272B3",
+	"IDebugControl5":                                "B2FFE162-2412-429F-8D1D-5BF6DD824696",
+	"IDebugControl6":                                "BC0D583F-126D-43A1-9CC4-A860AB1D537B",
+	"IDebugControl7":                                "B86FB3B1-80D4-475B-AEA3-CF06539CF63A",
+	"IDebugCookie":                                  "51973C39-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDataSpaces":                              "88F7DFAB-3EA7-4C3A-AEFB-C4E8106173AA",
+	"IDebugDataSpaces2":                             "7A5E852F-96E9-468F-AC1B-0B3ADDC4A049",
+	"IDebugDataSpaces3":                             "23F79D6C-8AAF-4F7C-A607-9995F5407E63",
+	"IDebugDataSpaces4":                             "D98ADA1F-29E9-4EF5-A6C0-E53349883212",
+	"IDebugDocument":                                "51973C21-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentContext":                         "51973C28-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentHelper32":                        "51973C26-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentHelper64":                        "C4C7363C-20FD-47F9-BD82-4855E0150871",
+	"IDebugDocumentHost":                            "51973C27-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentInfo":                            "51973C1F-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentProvider":                        "51973C20-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentText":                            "51973C22-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentTextAuthor":                      "51973C24-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentTextEvents":                      "51973C23-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugDocumentTextExternalAuthor":              "51973C25-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugEventCallbacks":                          "337BE28B-5036-4D72-B6BF-C45FBB9F2EAA",
+	"IDebugEventCallbacksWide":                      "0690E046-9C23-45AC-A04F-987AC29AD0D3",
+	"IDebugEventContextCallbacks":                   "61A4905B-23F9-4247-B3C5-53D087529AB7",
+	"IDebugExpression":                              "51973C14-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugExpressionCallBack":                      "51973C16-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugExpressionContext":                       "51973C15-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugExtendedProperty":                        "51973C52-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugFormatter":                               "51973C05-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugHelper":                                  "51973C3F-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugHost":                                    "B8C74943-6B2C-4EEB-B5C5-35D378A6D99D",
+	"IDebugHostBaseClass":                           "B94D57D2-390B-40F7-B5B4-B6DB897D974B",
+	"IDebugHostConstant":                            "62787EDC-FA76-4690-BD71-5E8C3E2937EC",
+	"IDebugHostContext":                             "A68C70D8-5EC0-46E5-B775-3134A48EA2E3",
+	"IDebugHostData":                                "A3D64993-826C-44FA-897D-926F2FE7AD0B",
+	"IDebugHostErrorSink":                           "C8FF0F0B-FCE9-467E-8BB3-5D69EF109C00",
+	"IDebugHostEvaluator":                           "0FEF9A21-577E-4997-AC7B-1C4883241D99",
+	"IDebugHostEvaluator2":                          "A117A435-1FB4-4092-A2AB-A929576C1E87",
+	"IDebugHostExtensibility":                       "3C2B24E1-11D0-4F86-8AE5-4DF166F73253",
+	"IDebugHostField":                               "E06F6495-16BC-4CC9-B11D-2A6B23FA72F3",
+	"IDebugHostMemory":                              "212149C9-9183-4A3E-B00E-4FD1DC95339B",
+	"IDebugHostMemory2":                             "EEA033DE-38F6-416B-A251-1D3771001270",
+	"IDebugHostModule":                              "C9BA3E18-D070-4378-BBD0-34613B346E1E",
+	"IDebugHostModule2":                             "B51887E8-BCD0-4E8F-A8C7-434398B78C37",
+	"IDebugHostModuleSignature":                     "31E53A5A-01EE-4BBB-B899-4B46AE7D595C",
+	"IDebugHostPublic":                              "6C597AC9-FB4D-4F6D-9F39-22488539F8F4",
+	"IDebugHostScriptHost":                          "B70334A4-B92C-4570-93A1-D3EB686649A0",
+	"IDebugHostStatus":                              "4F3E1CE2-86B2-4C7A-9C65-D0A9D0EECF44",
+	"IDebugHostSymbol":                              "0F819103-87DE-4E96-8277-E05CD441FB22",
+	"IDebugHostSymbol2":                             "21515B67-6720-4257-8A68-077DC944471C",
+	"IDebugHostSymbolEnumerator":                    "28D96C86-10A3-4976-B14E-EAEF4790AA1F",
+	"IDebugHostSymbols":                             "854FD751-C2E1-4EB2-B525-6619CB97A588",
+	"IDebugHostType":                                "3AADC353-2B14-4ABB-9893-5E03458E07EE",
+	"IDebugHostType2":                               "B28632B9-8506-4676-87CE-8F7E05E59876",
+	"IDebugHostTypeSignature":                       "3AADC353-2B14-4ABB-9893-5E03458E07EE",
+	"IDebugInputCallbacks":                          "9F50E42C-F136-499E-9A97-73036C94ED2D",
+	"IDebugOutputCallbacks":                         "4BF58045-D654-4C40-B0AF-683090F356DC",
+	"IDebugOutputCallbacks2":                        "67721FE9-56D2-4A44-A325-2B65513CE6EB",
+	"IDebugOutputCallbacksWide":                     "4C7FD663-C394-4E26-8EF1-34AD5ED3764C",
+	"IDebugOutputStream":                            "7782D8F2-2B85-4059-AB88-28CEDDCA1C80",
+	"IDebugPlmClient":                               "A02B66C4-AEA3-4234-A9F7-FE4C383D4E29",
+	"IDebugPlmClient2":                              "597C980D-E7BD-4309-962C-9D9B69A7372C",
+	"IDebugPlmClient3":                              "D4A5DBD1-CA02-4D90-856A-2A92BFD0F20F",
+	"IDebugProperty":                                "51973C50-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugPropertyEnumType_All":                    "51973C55-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugPropertyEnumType_Arguments":              "51973C57-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugPropertyEnumType_Locals":                 "51973C56-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugPropertyEnumType_LocalsPlusArgs":         "51973C58-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugPropertyEnumType_Registers":              "51973C59-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugRegisters":                               "CE289126-9E84-45A7-937E-67BB18691493",
+	"IDebugRegisters2":                              "1656AFA9-19C6-4E3A-97E7-5DC9160CF9C4",
+	"IDebugSessionProvider":                         "51973C29-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugStackFrame":                              "51973C17-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugStackFrame110":                           "4B509611-B6EA-4B24-ADCB-D0CCFD1A7E33",
+	"IDebugStackFrameSniffer":                       "51973C18-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugStackFrameSnifferEx32":                   "51973C19-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugStackFrameSnifferEx64":                   "8CD12AF4-49C1-4D52-8D8A-C146F47581AA",
+	"IDebugSymbolGroup":                             "F2528316-0F1A-4431-AEED-11D096E1E2AB",
+	"IDebugSymbolGroup2":                            "6A7CCC5F-FB5E-4DCC-B41C-6C20307BCCC7",
+	"IDebugSymbols":                                 "8C31E98C-983A-48A5-9016-6FE5D667A950",
+	"IDebugSymbols2":                                "3A707211-AFDD-4495-AD4F-56FECDF8163F",
+	"IDebugSymbols3":                                "F02FBECC-50AC-4F36-9AD9-C975E8F32FF8",
+	"IDebugSymbols4":                                "E391BBD8-9D8C-4418-840B-C006592A1752",
+	"IDebugSymbols5":                                "C65FA83E-1E69-475E-8E0E-B5D79E9CC17E",
+	"IDebugSyncOperation":                           "51973C1A-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugSystemObjects":                           "6B86FE2C-2C4F-4F0C-9DA2-174311ACC327",
+	"IDebugSystemObjects2":                          "0AE9F5FF-1852-4679-B055-494BEE6407EE",
+	"IDebugSystemObjects3":                          "E9676E2F-E286-4EA3-B0F9-DFE5D9FC330E",
+	"IDebugSystemObjects4":                          "489468E6-7D0F-4AF5-87AB-25207454D553",
+	"IDebugThreadCall32":                            "51973C36-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IDebugThreadCall64":                            "CB3FA335-E979-42FD-9FCF-A7546A0F3905",
+	"IDefaultBrowserSyncSettings":                   "7A27FAAD-5AE6-4255-9030-C530936292E3",
+	"IDefaultExtractIconInit":                       "41DED17D-D6B3-4261-997D-88C60E4B1D58",
+	"IDefaultFolderMenuInitialize":                  "7690AA79-F8FC-4615-A327-36F7D18F5D91",
+	"IDelayedPropertyStoreFactory":                  "40D4577F-E237-4BDB-BD69-58F089431B6A",
+	"IDelegateFolder":                               "ADD8BA80-002B-11D0-8F0F-00C04FD7D062",
+	"IDelegateItem":                                 "3C5A1C94-C951-4CB7-BB6D-3B93F30CCE93",
+	"IDeleteBrowsingHistory":                        "CF38ED4B-2BE7-4461-8B5E-9A466DC82AE3",
+	"IDeskBand":                                     "EB0FE172-1A3A-11D0-89B3-00A0C90A90AC",
+	"IDeskBand2":                                    "79D16DE4-ABEE-4021-8D9D-9169B261D657",
+	"IDeskBandInfo":                                 "77E425FC-CBF9-4307-BA6A-BB5727745661",
+	"IDeskBar":                                      "EB0FE173-1A3A-11D0-89B3-00A0C90A90AC",
+	"IDeskBarClient":                                "EB0FE175-1A3A-11D0-89B3-00A0C90A90AC",
+	"IDesktopGadget":                                "C1646BC4-F298-4F91-A204-EB2DD1709D1A",
+	"IDesktopWallpaper":                             "B92B56A9-8B55-4E14-9A89-0199BBB6F93B",
+	"IDestinationStreamFactory":                     "8A87781B-39A7-4A1F-AAB3-A39B9C34A7D9",
+	"IDirectWriterLock":                             "0E6D4D92-6738-11CF-9608-00AA00680DB4",
+	"IDiskQuotaControl":                             "7988B572-EC89-11CF-9C00-00AA00A14F56",
+	"IDiskQuotaEvents":                              "7988B579-EC89-11CF-9C00-00AA00A14F56",
+	"IDiskQuotaUser":                                "7988B574-EC89-11CF-9C00-00AA00A14F56",
+	"IDiskQuotaUserBatch":                           "7988B576-EC89-11CF-9C00-00AA00A14F56",
+	"IDispError":                                    "A6EF9861-C720-11D0-9337-00A0C90DCAA9",
+	"IDispatch":                                     "00020400-0000-0000-C000-000000000046",
+	"IDispatchEx":                                   "A6EF9860-C720-11D0-9337-00A0C90DCAA9",
+	"IDisplayItem":                                  "C6FD5997-9F6B-4888-8703-94E80E8CDE3F",
+	"IDocViewSite":                                  "87D605E0-C511-11CF-89A9-00A0C9054129",
+	"IDockProvider":                                 "159BC72C-4AD3-485E-9637-D7052EDF0146",
+	"IDockingWindow":                                "012DD920-7B26-11D0-8CA9-00A0C92DBFE8",
+	"IDockingWindowFrame":                           "47D2657A-7B27-11D0-8CA9-00A0C92DBFE8",
+	"IDockingWindowSite":                            "2A342FC2-7B26-11D0-8CA9-00A0C92DBFE8",
+	"IDragDropManagerInterop":                       "5AD8CBA7-4C01-4DAC-9074-827894292D63",
+	"IDragProvider":                                 "6AA7BBBB-7FF9-497D-904F-D20B897929D8",
+	"IDragSourceHelper":                             "DE5BF786-477A-11D2-839D-00C04FD918D0",
+	"IDragSourceHelper2":                            "83E07D0D-0C5F-4163-BF1A-60B274051E40",
+	"IDropSource":                                   "00000121-0000-0000-C000-000000000046",
+	"IDropSourceNotify":                             "0000012B-0000-0000-C000-000000000046",
+	"IDropTarget":                                   "00000122-0000-0000-C000-000000000046",
+	"IDropTargetHelper":                             "4657278B-411B-11D2-839A-00C04FD918D0",
+	"IDropTargetProvider":                           "BAE82BFD-358A-481C-85A0-D8B4D90A5D61",
+	"IDynamicConceptProviderConcept":                "95A7F7DD-602E-483F-9D06-A15C0EE13174",
+	"IDynamicHWHandler":                             "DC2601D7-059E-42FC-A09D-2AFD21B6D5F7",
+	"IDynamicKeyProviderConcept":                    "E7983FA1-80A7-498C-988F-518DDC5D4025",
+	"IEditionUpgradeBroker":                         "FF19CBCF-9455-4937-B872-6B7929A460AF",
+	"IEditionUpgradeHelper":                         "D3E9E342-5DEB-43B6-849E-6913B85D503A",
+	"IEnterpriseDropTarget":                         "390E3878-FD55-4E18-819D-4682081C0CFD",
+	"IEnumACString":                                 "8E74C210-CF9D-4EAF-A403-7356428F0A5A",
+	"IEnumAssocHandlers":                            "973810AE-9599-4B88-9E4D-6EE98C9552DA",
+	"IEnumCATEGORYINFO":                             "0002E011-0000-0000-C000-000000000046",
+	"IEnumCodePage":                                 "275C23E3-3747-11D0-9FEA-00AA003F8646",
+	"IEnumConnectionPoints":                         "B196B285-BAB4-101A-B69C-00AA00341D07",
+	"IEnumConnections":                              "B196B287-BAB4-101A-B69C-00AA00341D07",
+	"IEnumDebugApplicationNodes":                    "51973C3A-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumDebugCodeContexts":                        "51973C1D-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumDebugExpressionContexts":                  "51973C40-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumDebugExtendedPropertyInfo":                "51973C53-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumDebugPropertyInfo":                        "51973C51-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumDebugStackFrames":                         "51973C1E-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumDebugStackFrames64":                       "0DC38853-C1B0-4176-A984-B298361027AF",
+	"IEnumDiskQuotaUsers":                           "7988B577-EC89-11CF-9C00-00AA00A14F56",
+	"IEnumExplorerCommand":                          "A88826F8-186F-4987-AADE-EA0CEF8FBFE8",
+	"IEnumExtraSearch":                              "0E700BE1-9DB6-11D1-A1CE-00C04FD75D13",
+	"IEnumFORMATETC":                                "00000103-0000-0000-C000-000000000046",
+	"IEnumFullIDList":                               "D0191542-7954-4908-BC06-B2360BBE45BA",
+	"IEnumGUID":                                     "0002E000-0000-0000-C000-000000000046",
+	"IEnumHLITEM":                                   "79EAC9C6-BAF9-11CE-8C82-00AA004BA90B",
+	"IEnumIDList":                                   "000214F2-0000-0000-C000-000000000046",
+	"IEnumJsStackFrames":                            "5E7DA34B-FB51-4791-ABE7-CB5BDF419755",
+	"IEnumMoniker":                                  "00000102-0000-0000-C000-000000000046",
+	"IEnumOLEVERB":                                  "00000104-0000-0000-C000-000000000046",
+	"IEnumObjects":                                  "2C1C7E2E-2D0E-4059-831E-1E6F82335C2E",
+	"IEnumOleDocumentViews":                         "B722BCC8-4E68-101B-A2BC-00AA00404770",
+	"IEnumOleUndoUnits":                             "B3E7C340-EF97-11CE-9BC9-00AA00608E01",
+	"IEnumPublishedApps":                            "0B124F8C-91F0-11D1-B8B5-006008059382",
+	"IEnumReadyCallback":                            "61E00D45-8FFF-4E60-924E-6537B61612DD",
+	"IEnumRemoteDebugApplicationThreads":            "51973C3C-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumRemoteDebugApplications":                  "51973C3B-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IEnumResources":                                "2DD81FE3-A83C-4DA9-A330-47249D345BA1",
+	"IEnumRfc1766":                                  "3DC39D1D-C030-11D0-B81B-00C04FC9B31F",
+	"IEnumSTATDATA":                                 "00000105-0000-0000-C000-000000000046",
+	"IEnumSTATPROPSETSTG":                           "0000013B-0000-0000-C000-000000000046",
+	"IEnumSTATPROPSTG":                              "00000139-0000-0000-C000-000000000046",
+	"IEnumSTATSTG":                                  "0000000D-0000-0000-C000-000000000046",
+	"IEnumScript":                                   "AE5F1430-388B-11D2-8380-00C04F8F5DA1",
+	"IEnumShellItems":                               "70629033-E363-4A28-A567-0DB78006E6D7",
+	"IEnumSpellingError":                            "803E3BD4-2828-4410-8290-418D1D73C762",
+	"IEnumString":                                   "00000101-0000-0000-C000-000000000046",
+	"IEnumSyncMgrConflict":                          "82705914-DDA3-4893-BA99-49DE6C8C8036",
+	"IEnumSyncMgrEvents":                            "C81A1D4E-8CF7-4683-80E0-BCAE88D677B6",
+	"IEnumSyncMgrSyncItems":                         "54B3ABF3-F085-4181-B546-E29C403C726B",
+	"IEnumTravelLogEntry":                           "7EBFDD85-AD18-11D3-A4C5-00C04F72D6B8",
+	"IEnumUnknown":                                  "00000100-0000-0000-C000-000000000046",
+	"IEnumVARIANT":                                  "00020404-0000-0000-C000-000000000046",
+	"IEnumerableView":                               "8C8BF236-1AEC-495F-9894-91D57C3C686F",
+	"IEquatableConcept":                             "C52D5D3D-609D-4D5D-8A82-46B0ACDEC4F4",
+	"IErrorInfo":                                    "1CF2B120-547D-101B-8E65-08002B2BD119",
+	"IErrorLog":                                     "3127CA40-446E-11CE-8135-00AA004BB851",
+	"IExecuteCommand":                               "7F9185B0-CB92-43C5-80A9-92277A4F7B54",
+	"IExecuteCommandApplicationHostEnvironment":     "18B21AA9-E184-4FF0-9F5E-F882D03771B3",
+	"IExecuteCommandHost":                           "4B6832A2-5F04-4C9D-B89D-727A15D103E7",
+	"IExpDispSupport":                               "0D7D1D00-6FC0-11D0-A974-00C04FD705A2",
+	"IExpDispSupportXP":                             "2F0DD58C-F789-4F14-99FB-9293B3C9C212",
+	"IExpandCollapseProvider":                       "D847D3A5-CAB0-4A98-8C32-ECB45C59AD24",
+	"IExplorerBrowser":                              "DFD3B6B5-C10C-4BE9-85F6-A66969F402F6",
+	"IExplorerBrowserEvents":                        "361BBDC7-E6EE-4E13-BE58-58E2240C810F",
+	"IExplorerCommand":                              "A08CE4D0-FA25-44AB-B57C-C7B1C323E0B9",
+	"IExplorerCommandProvider":                      "64961751-0835-43C0-8FFE-D57686530E64",
+	"IExplorerCommandState":                         "BDDACB60-7657-47AE-8445-D23E1ACF82AE",
+	"IExplorerPaneVisibility":                       "E07010EC-BC17-44C0-97B0-46C7C95B9EDC",
+	"IExtensionServices":                            "79EAC9CB-BAF9-11CE-8C82-00AA004BA90B",
+	"IExternalConnection":                           "00000019-0000-0000-C000-000000000046",
+	"IExtractIconA":                                 "000214EB-0000-0000-C000-000000000046",
+	"IExtractIconW":                                 "000214FA-0000-0000-C000-000000000046",
+	"IExtractImage":                                 "BB2E617C-0920-11D1-9A0B-00C04FC2D6C1",
+	"IExtractImage2":                                "953BB1EE-93B4-11D1-98A3-00C04FB687DA",
+	"IFastRundown":                                  "00000040-0000-0000-C000-000000000046",
+	"IFileDialog":                                   "42F85136-DB7E-439C-85F1-E4075D135FC8",
+	"IFileDialog2":                                  "61744FC7-85B5-4791-A9B0-272276309B13",
+	"IFileDialogControlEvents":                      "36116642-D713-4B97-9B83-7484A9D00433",
+	"IFileDialogCustomize":                          "E6FDD21A-163F-4975-9C8C-A69F1BA37034",
+	"IFileDialogEvents":                             "973510DB-7D7F-452B-8975-74A85828D354",
+	"IFileIsInUse":                                  "64A1CBF0-3A1A-4461-9158-376969693950",
+	"IFileOpenDialog":                               "D57C7288-D4AD-4768-BE02-9D969532D960",
+	"IFileOperation":                                "947AAB5F-0A5C-4C13-B4D6-4BF7836FC9F8",
+	"IFileOperation2":                               "CD8F23C1-8F61-4916-909D-55BDD0918753",
+	"IFileOperationProgressSink":                    "04B0F1A7-9490-44BC-96E1-4296A31252E2",
+	"IFileSaveDialog":                               "84BCCD23-5FDE-4CDB-AEA4-AF64B83D78AB",
+	"IFileSearchBand":                               "2D91EEA1-9932-11D2-BE86-00A0C9A83DA1",
+	"IFileSyncMergeHandler":                         "D97B5AAC-C792-433C-975D-35C4EADC7A9D",
+	"IFileSystemBindData":                           "01E18D10-4D8B-11D2-855D-006008059367",
+	"IFileSystemBindData2":                          "3ACF075F-71DB-4AFA-81F0-3FC4FDF2A5B8",
+	"IFillLockBytes":                                "99CAF010-415E-11CF-8814-00AA00B569F5",
+	"IFolderBandPriv":                               "47C01F95-E185-412C-B5C5-4F27DF965AEA",
+	"IFolderFilter":                                 "9CC22886-DC8E-11D2-B1D0-00C04F8EEB3E",
+	"IFolderFilterSite":                             "C0A651F5-B48B-11D2-B5ED-006097C686F6",
+	"IFolderView":                                   "CDE725B0-CCC9-4519-917E-325D72FAB4CE",
+	"IFolderView2":                                  "1AF3A467-214F-4298-908E-06B03E0B39F9",
+	"IFolderViewHost":                               "1EA58F02-D55A-411D-B09E-9E65AC21605B",
+	"IFolderViewOC":                                 "9BA05970-F6A8-11CF-A442-00A0C90A8F39",
+	"IFolderViewOptions":                            "3CC974D2-B302-4D36-AD3E-06D93F695D3F",
+	"IFolderViewSettings":                           "AE8C987D-8797-4ED3-BE72-2A47DD938DB0",
+	"IFont":                                         "BEF6E002-A874-101A-8BBA-00AA00300CAB",
+	"IFontDisp":                                     "BEF6E003-A874-101A-8BBA-00AA00300CAB",
+	"IFontEventsDisp":                               "4EF6100A-AF88-11D0-9846-00C04FC29993",
+	"IForegroundTransfer":                           "00000145-0000-0000-C000-000000000046",
+	"IFrameworkInputPane":                           "5752238B-24F0-495A-82F1-2FD593056796",
+	"IFrameworkInputPaneHandler":                    "226C537B-1E76-4D9E-A760-33DB29922F18",
+	"IGetOleObject":                                 "8A701DA0-4FEB-101B-A82E-08002B2B2337",
+	"IGetServiceIds":                                "4A073526-6103-4E21-B7BC-F519D1524E5D",
+	"IGetVBAObject":                                 "91733A60-3F4C-101B-A3F6-00AA0034E4E9",
+	"IGlobalInterfaceTable":                         "00000146-0000-0000-C000-000000000046",
+	"IGlobalOptions":                                "0000015B-0000-0000-C000-000000000046",
+	"IGridItemProvider":                             "D02541F1-FB81-4D64-AE32-F520F8A6DBD1",
+	"IGridProvider":                                 "B17D6187-0907-464B-A168-0EF17A1572B1",
+	"IHWEventHandler":                               "C1FB73D0-EC3A-4BA2-B512-8CDB9187B6D1",
+	"IHWEventHandler2":                              "CFCC809F-295D-42E8-9FFC-424B33C487E6",
+	"IHandlerActivationHost":                        "35094A87-8BB1-4237-96C6-C417EEBDB078",
+	"IHandlerInfo":                                  "997706EF-F880-453B-8118-39E1A2D2655A",
+	"IHandlerInfo2":                                 "31CCA04C-04D3-4EA9-90DE-97B15E87A532",
+	"IHlink":                                        "79EAC9C3-BAF9-11CE-8C82-00AA004BA90B",
+	"IHlinkBrowseContext":                           "79EAC9C7-BAF9-11CE-8C82-00AA004BA90B",
+	"IHlinkFrame":                                   "79EAC9C5-BAF9-11CE-8C82-00AA004BA90B",
+	"IHlinkSite":                                    "79EAC9C2-BAF9-11CE-8C82-00AA004BA90B",
+	"IHlinkTarget":                                  "79EAC9C4-BAF9-11CE-8C82-00AA004BA90B",
+	"IHolographicSpaceInterop":                      "5C4EE536-6A98-4B86-A170-587013D6FD4B",
+	"IHomeGroup":                                    "7A3BD1D9-35A9-4FB3-A467-F48CAC35E2D0",
+	"IHostDataModelAccess":                          "F2BCE54E-4835-4F8A-836E-7981E29904D1",
+	"IIOCancelInformation":                          "F5B0BF81-8CB5-4B1B-9449-1A159E0C733C",
+	"IIdentityName":                                 "7D903FCA-D6F9-4810-8332-946C0177E247",
+	"IImageList":                                    "46EB5926-582E-4017-9FDF-E8998DAA0950",
+	"IImageList2":                                   "192B9D83-50FC-457B-90A0-2B82A8B5DAE1",
+	"IImageRecompress":                              "505F1513-6B3E-4892-A272-59F8889A4D3E",
+	"IIndexableConcept":                             "D1FAD99F-3F53-4457-850C-8051DF2D3FB5",
+	"IInitializeCommand":                            "85075ACF-231F-40EA-9610-D26B7B58F638",
+	"IInitializeNetworkFolder":                      "6E0F9881-42A8-4F2A-97F8-8AF4E026D92D",
+	"IInitializeObject":                             "4622AD16-FF23-11D0-8D34-00A0C90F2719",
+	"IInitializeSpy":                                "00000034-0000-0000-C000-000000000046",
+	"IInitializeWithBindCtx":                        "71C0D2BC-726D-45CC-A6C0-2E31C1DB2159",
+	"IInitializeWithFile":                           "B7D14566-0509-4CCE-A71F-0A554233BD9B",
+	"IInitializeWithItem":                           "7F73BE3F-FB79-493C-A6C7-7EE14E245841",
+	"IInitializeWithPropertyStore":                  "C3E12EB5-7D8D-44F8-B6DD-0E77B34D6DE4",
+	"IInitializeWithStream":                         "B824B49D-22AC-4161-AC8A-9916E8FA3F7F",
+	"IInitializeWithWindow":                         "3E68D4BD-7135-4D10-8018-9FB6D9F33FA1",
+	"IInputObject":                                  "68284FAA-6A48-11D0-8C78-00C04FD918B4",
+	"IInputObject2":                                 "6915C085-510B-44CD-94AF-28DFA56CF92B",
+	"IInputObjectSite":                              "F1DB8392-7331-11D0-8C99-00A0C92DBFE8",
+	"IInputPaneAnimationCoordinator":                "2AF16BA9-2DE5-4B75-82D9-01372AFBFFB4",
+	"IInputPaneInterop":                             "75CF2C57-9195-4931-8332-F0B409E916AF",
+	"IInputPanelConfiguration":                      "41C81592-514C-48BD-A22E-E6AF638521A6",
+	"IInputPanelInvocationConfiguration":            "A213F136-3B45-4362-A332-EFB6547CD432",
+	"IInsertItem":                                   "D2B57227-3D23-4B95-93C0-492BD454C356",
+	"IInspectable":                                  "AF86E2E0-B12D-4C6A-9C5A-D7AA65101E90",
+	"IInternalUnknown":                              "00000021-0000-0000-C000-000000000046",
+	"IInvokeProvider":                               "54FCB24B-E18E-47A2-B4D3-ECCBE77599A2",
+	"IItemContainerProvider":                        "E747770B-39CE-4382-AB30-D8FB3F336F24",
+	"IItemNameLimits":                               "1DF0D7F1-B267-4D28-8B10-12E23202A5C4",
+	"IIterableConcept":                              "F5D49D0C-0B02-4301-9C9B-B3A6037628F3",
+	"IJsDebug":                                      "BE0E89DA-2AC5-4C04-AC5E-59956AAE3613",
+	"IJsDebugBreakPoint":                            "DF6773E3-ED8D-488B-8A3E-5812577D1542",
+	"IJsDebugDataTarget":                            "53B28977-53A1-48E5-9000-5D0DFA893931",
+	"IJsDebugFrame":                                 "C9196637-AB9D-44B2-BAD2-13B95B3F390E",
+	"IJsDebugProcess":                               "3D587168-6A2D-4041-BD3B-0DE674502862",
+	"IJsDebugProperty":                              "F8FFCF2B-3AA4-4320-85C3-52A312BA9633",
+	"IJsDebugStackWalker":                           "DB24B094-73C4-456C-A4EC-E90EA00BDFE3",
+	"IJsEnumDebugProperty":                          "4092432F-2F0F-4FE1-B638-5B74A52CDCBE",
+	"IKeyEnumerator":                                "345FA92E-5E00-4319-9CAE-971F7601CDCF",
+	"IKeyStore":                                     "0FC7557D-401D-4FCA-9365-DA1E9850697C",
+	"IKnownFolder":                                  "3AA7AF7E-9B36-420C-A8E3-F77D4674A488",
+	"IKnownFolderManager":                           "8BE2D872-86AA-4D47-B776-32CCA40C7018",
+	"ILanguageExceptionErrorInfo":                   "04A2DBF3-DF83-116C-0946-0812ABF6E07D",
+	"ILanguageExceptionErrorInfo2":                  "5746E5C4-5B97-424C-B620-2822915734DD",
+	"ILanguageExceptionStackBackTrace":              "CBE53FB5-F967-4258-8D34-42F5E25833DE",
+	"ILanguageExceptionTransform":                   "FEB5A271-A6CD-45CE-880A-696706BADC65",
+	"ILaunchSourceAppUserModelId":                   "989191AC-28FF-4CF0-9584-E0D078BC2396",
+	"ILaunchSourceViewSizePreference":               "E5AA01F7-1FB8-4830-8720-4E6734CBD5F3",
+	"ILaunchTargetMonitor":                          "266FBC7E-490D-46ED-A96B-2274DB252003",
+	"ILaunchTargetViewSizePreference":               "2F0666C6-12F7-4360-B511-A394A0553725",
+	"ILaunchUIContext":                              "1791E8F6-21C7-4340-882A-A6A93E3FD73B",
+	"ILaunchUIContextProvider":                      "0D12C4C8-A3D9-4E24-94C1-0E20C5A956C4",
+	"ILayoutStorage":                                "0E6D4D90-6738-11CF-9608-00AA00680DB4",
+	"ILegacyIAccessibleProvider":                    "E44C3566-915D-4070-99C6-047BFF5A08F5",
+	"ILockBytes":                                    "0000000A-0000-0000-C000-000000000046",
+	"IMAGEHLP_CBA_EVENT":                            "",
+	"IMAGEHLP_CBA_EVENTW":                           "",
+	"IMAGEHLP_CBA_READ_MEMORY":                      "",
+	"IMAGEHLP_DEFERRED_SYMBOL_LOAD64":               "",
+	"IMAGEHLP_DEFERRED_SYMBOL_LOADW64":              "",
+	"IMAGEHLP_DUPLICATE_SYMBOL64":                   "",
+	"IMAGEHLP_GET_TYPE_INFO_PARAMS":                 "",
+	"IMAGEHLP_JIT_SYMBOLMAP":                        "",
+	"IMAGEHLP_LINE64":                               "",
+	"IMAGEHLP_LINEW64":                              "",
+	"IMAGEHLP_MODULE64":                             "",
+	"IMAGEHLP_MODULE64_EX":                          "",
+	"IMAGEHLP_MODULEW64":                            "",
+	"IMAGEHLP_MODULEW64_EX":                         "",
+	"IMAGEHLP_STACK_FRAME":                          "",
+	"IMAGEHLP_SYMBOL64":                             "",
+	"IMAGEHLP_SYMBOL64_PACKAGE":                     "",
+	"IMAGEHLP_SYMBOLW64":                            "",
+	"IMAGEHLP_SYMBOLW64_PACKAGE":                    "",
+	"IMAGEHLP_SYMBOL_SRC":                           "",
+	"IMAGEINFO":                                     "",
+	"IMAGELISTDRAWPARAMS":                           "",
+	"IMAGELISTSTATS":                                "",
+	"IMAGE_ALPHA64_RUNTIME_FUNCTION_ENTRY":          "",
+	"IMAGE_ALPHA_RUNTIME_FUNCTION_ENTRY":            "",
+	"IMAGE_ARCHITECTURE_ENTRY":                      "",
+	"IMAGE_ARCHITECTURE_HEADER":                     "",
+	"IMAGE_ARCHIVE_MEMBER_HEADER":                   "",
+	"IMAGE_ARM64_RUNTIME_FUNCTION_ENTRY":            "",
+	"IMAGE_ARM64_RUNTIME_FUNCTION_ENTRY_Anonymous":  "",
+	"IMAGE_ARM64_RUNTIME_FUNCTION_ENTRY_Anonymous_Anonymous": "",
+	"IMAGE_ARM64_RUNTIME_FUNCTION_ENTRY_XDATA":               "",
+	"IMAGE_ARM64_RUNTIME_FUNCTION_ENTRY_XDATA_Anonymous":     "",
+	"IMAGE_ARM_RUNTIME_FUNCTION_ENTRY":                       "",
+	"IMAGE_ARM_RUNTIME_FUNCTION_ENTRY_Anonymous":             "",
+	"IMAGE_ARM_RUNTIME_FUNCTION_ENTRY_Anonymous_Anonymous":   "",
+	"IMAGE_AUX_SYMBOL":                                    "",
+	"IMAGE_AUX_SYMBOL_CRC":                                "",
+	"IMAGE_AUX_SYMBOL_EX":                                 "",
+	"IMAGE_AUX_SYMBOL_EX_Anonymous":                       "",
+	"IMAGE_AUX_SYMBOL_EX_CRC":                             "",
+	"IMAGE_AUX_SYMBOL_EX_File":                            "",
+	"IMAGE_AUX_SYMBOL_EX_Section":                         "",
+	"IMAGE_AUX_SYMBOL_EX_Sym":                             "",
+	"IMAGE_AUX_SYMBOL_File":                               "",
+	"IMAGE_AUX_SYMBOL_Section":                            "",
+	"IMAGE_AUX_SYMBOL_Sym":                                "",
+	"IMAGE_AUX_SYMBOL_Sym_FcnAry":                         "",
+	"IMAGE_AUX_SYMBOL_Sym_FcnAry_Array":                   "",
+	"IMAGE_AUX_SYMBOL_Sym_FcnAry_Function":                "",
+	"IMAGE_AUX_SYMBOL_Sym_Misc":                           "",
+	"IMAGE_AUX_SYMBOL_Sym_Misc_LnSz":                      "",
+	"IMAGE_AUX_SYMBOL_TOKEN_DEF":                          "",
+	"IMAGE_BASE_RELOCATION":                               "",
+	"IMAGE_BOUND_FORWARDER_REF":                           "",
+	"IMAGE_BOUND_IMPORT_DESCRIPTOR":                       "",
+	"IMAGE_CE_RUNTIME_FUNCTION_ENTRY":                     "",
+	"IMAGE_COFF_SYMBOLS_HEADER":                           "",
+	"IMAGE_COR20_HEADER":                                  "",
+	"IMAGE_COR20_HEADER_Anonymous":                        "",
+	"IMAGE_DATA_DIRECTORY":                                "",
+	"IMAGE_DEBUG_DIRECTORY":                               "",
+	"IMAGE_DEBUG_MISC":                                    "",
+	"IMAGE_DELAYLOAD_DESCRIPTOR":                          "",
+	"IMAGE_DELAYLOAD_DESCRIPTOR_Attributes":               "",
+	"IMAGE_DELAYLOAD_DESCRIPTOR_Attributes_Anonymous":     "",
+	"IMAGE_DOS_HEADER":                                    "",
+	"IMAGE_DYNAMIC_RELOCATION32":                          "",
+	"IMAGE_DYNAMIC_RELOCATION32_V2":                       "",
+	"IMAGE_DYNAMIC_RELOCATION64":                          "",
+	"IMAGE_DYNAMIC_RELOCATION64_V2":                       "",
+	"IMAGE_DYNAMIC_RELOCATION_TABLE":                      "",
+	"IMAGE_EPILOGUE_DYNAMIC_RELOCATION_HEADER":            "",
+	"IMAGE_EXPORT_DIRECTORY":                              "",
+	"IMAGE_FILE_HEADER":                                   "",
+	"IMAGE_FUNCTION_ENTRY":                                "",
+	"IMAGE_FUNCTION_ENTRY64":                              "",
+	"IMAGE_FUNCTION_ENTRY64_Anonymous":                    "",
+	"IMAGE_HOT_PATCH_BASE":                                "",
+	"IMAGE_HOT_PATCH_HASHES":                              "",
+	"IMAGE_HOT_PATCH_INFO":                                "",
+	"IMAGE_IMPORT_BY_NAME":                                "",
+	"IMAGE_IMPORT_CONTROL_TRANSFER_DYNAMIC_RELOCATION":    "",
+	"IMAGE_IMPORT_DESCRIPTOR":                             "",
+	"IMAGE_IMPORT_DESCRIPTOR_Anonymous":                   "",
+	"IMAGE_INDIR_CONTROL_TRANSFER_DYNAMIC_RELOCATION":     "",
+	"IMAGE_LINENUMBER":                                    "",
+	"IMAGE_LINENUMBER_Type":                               "",
+	"IMAGE_LOAD_CONFIG_CODE_INTEGRITY":                    "",
+	"IMAGE_LOAD_CONFIG_DIRECTORY32":                       "",
+	"IMAGE_LOAD_CONFIG_DIRECTORY64":                       "",
+	"IMAGE_NT_HEADERS32":                                  "",
+	"IMAGE_NT_HEADERS64":                                  "",
+	"IMAGE_OPTIONAL_HEADER32":                             "",
+	"IMAGE_OPTIONAL_HEADER64":                             "",
+	"IMAGE_OS2_HEADER":                                    "",
+	"IMAGE_POLICY_ENTRY":                                  "",
+	"IMAGE_POLICY_ENTRY_U":                                "",
+	"IMAGE_POLICY_METADATA":                               "",
+	"IMAGE_PROLOGUE_DYNAMIC_RELOCATION_HEADER":            "",
+	"IMAGE_RELOCATION":                                    "",
+	"IMAGE_RELOCATION_Anonymous":                          "",
+	"IMAGE_RESOURCE_DATA_ENTRY":                           "",
+	"IMAGE_RESOURCE_DIRECTORY":                            "",
+	"IMAGE_RESOURCE_DIRECTORY_ENTRY":                      "",
+	"IMAGE_RESOURCE_DIRECTORY_ENTRY_Anonymous1":           "",
+	"IMAGE_RESOURCE_DIRECTORY_ENTRY_Anonymous1_Anonymous": "",
+	"IMAGE_RESOURCE_DIRECTORY_ENTRY_Anonymous2":           "",
+	"IMAGE_RESOURCE_DIRECTORY_ENTRY_Anonymous2_Anonymous": "",
+	"IMAGE_RESOURCE_DIRECTORY_STRING":                     "",
+	"IMAGE_RESOURCE_DIR_STRING_U":                         "",
+	"IMAGE_ROM_HEADERS":                                   "",
+	"IMAGE_ROM_OPTIONAL_HEADER":                           "",
+	"IMAGE_RUNTIME_FUNCTION_ENTRY":                        "",
+	"IMAGE_RUNTIME_FUNCTION_ENTRY_Anonymous":              "",
+	"IMAGE_SECTION_HEADER":                                "",
+	"IMAGE_SECTION_HEADER_Misc":                           "",
+	"IMAGE_SEPARATE_DEBUG_HEADER":                         "",
+	"IMAGE_SWITCHTABLE_BRANCH_DYNAMIC_RELOCATION":         "",
+	"IMAGE_SYMBOL":                                        "",
+	"IMAGE_SYMBOL_EX":                                     "",
+	"IMAGE_SYMBOL_EX_N":                                   "",
+	"IMAGE_SYMBOL_EX_N_Name":                              "",
+	"IMAGE_SYMBOL_N":                                      "",
+	"IMAGE_SYMBOL_N_Name":                                 "",
+	"IMAGE_THUNK_DATA32":                                  "",
+	"IMAGE_THUNK_DATA32_U1":                               "",
+	"IMAGE_THUNK_DATA64":                                  "",
+	"IMAGE_THUNK_DATA64_U1":                               "",
+	"IMAGE_TLS_DIRECTORY32":                               "",
+	"IMAGE_TLS_DIRECTORY32_Anonymous":                     "",
+	"IMAGE_TLS_DIRECTORY32_Anonymous_Anonymous":           "",
+	"IMAGE_TLS_DIRECTORY64":                               "",
+	"IMAGE_TLS_DIRECTORY64_Anonymous":                     "",
+	"IMAGE_TLS_DIRECTORY64_Anonymous_Anonymous":           "",
+	"IMAGE_VXD_HEADER":                                    "",
+	"IMECOMPTEXT":                                         "",
+	"IMEPROA":                                             "",
+	"IMEPROW":                                             "",
+	"IMESTRUCT":                                           "",
+	"IMLangCodePages":                                     "359F3443-BD4A-11D0-B188-00AA0038C969",
+	"IMLangConvertCharset":                                "D66D6F98-CDAA-11D0-B822-00C04FC9B31F",
+	"IMLangFontLink":                                      "359F3441-BD4A-11D0-B188-00AA0038C969",
+	"IMLangFontLink2":                                     "DCCFC162-2B38-11D2-B7EC-00C04F8F5D9A",
+	"IMLangLineBreakConsole":                              "F5BE2EE1-BFD7-11D0-B188-00AA0038C969",
+	"IMLangString":                                        "C04D65CE-B70D-11D0-B188-00AA0038C969",
+	"IMLangStringAStr":                                    "C04D65D2-B70D-11D0-B188-00AA0038C969",
+	"IMLangStringBufA":                                    "D24ACD23-BA72-11D0-B188-00AA0038C969",
+	"IMLangStringBufW":                                    "D24ACD21-BA72-11D0-B188-00AA0038C969",
+	"IMLangStringWStr":                                    "C04D65D0-B70D-11D0-B188-00AA0038C969",
+	"IMPORT_OBJECT_HEADER":                                "",
+	"IMPORT_OBJECT_HEADER_Anonymous":                      "",
+	"IMachineDebugManager":                                "51973C2C-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IMachineDebugManagerCookie":                          "51973C2D-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IMachineDebugManagerEvents":                          "51973C2E-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IMachineGlobalObjectTable":                           "26D709AC-F70B-4421-A96F-D2878FAFB00D",
+	"IMalloc":                                             "00000002-0000-0000-C000-000000000046",
+	"IMallocSpy":                                          "0000001D-0000-0000-C000-000000000046",
+	"IMemoryBufferByteAccess":                             "5B0D3235-4DBA-4D44-865E-8F1D0E4FD04D",
+	"IMenuBand":                                           "568804CD-CBD7-11D0-9816-00C04FD91972",
+	"IMenuPopup":                                          "D1E7AFEB-6A2E-11D0-8C78-00C04FD918B4",
+	"IMessageDispatcher":                                  "F5F84C8F-CFD0-4CD6-B66B-C5D26FF1689D",
+	"IModalWindow":                                        "B4DB1657-70D7-485E-8E3E-6FCB5A5C1802",
+	"IModelIterator":                                      "E4622136-927D-4490-874F-581F3E4E3688",
+	"IModelKeyReference":                                  "5253DCF8-5AFF-4C62-B302-56A289E00998",
+	"IModelKeyReference2":                                 "80E2F7C5-7159-4E92-887E-7E0347E88406",
+	"IModelMethod":                                        "80600C1F-B90B-4896-82AD-1C00207909E8",
+	"IModelObject":                                        "E28C7893-3F4B-4B96-BACA-293CDC55F45D",
+	"IModelPropertyAccessor":                              "5A0C63D9-0526-42B8-960C-9516A3254C85",
+	"IMoniker":                                            "0000000F-0000-0000-C000-000000000046",
+	"IMultiLanguage":                                      "275C23E1-3747-11D0-9FEA-00AA003F8646",
+	"IMultiLanguage2":                                     "DCCFC164-2B38-11D2-B7EC-00C04F8F5D9A",
+	"IMultiLanguage3":                                     "4E5868AB-B157-4623-9ACC-6A1D9CAEBE04",
+	"IMultiQI":                                            "00000020-0000-0000-C000-000000000046",
+	"IMultipleViewProvider":                               "6278CAB1-B556-4A1A-B4E0-418ACC523201",
+	"INITCOMMONCONTROLSEX":                                "",
+	"INLINE_FRAME_CONTEXT":                                "",
+	"INLINE_FRAME_CONTEXT_Anonymous":                      "",
+	"INPUT":                                               "",
+	"INPUT_Anonymous":                                     "",
+	"INPUT_MESSAGE_SOURCE":                                "",
+	"INPUT_RECORD":                                        "",
+	"INPUT_RECORD_Event":                                  "",
+	"INTERFACEDATA":                                       "",
+	"INTERFACEINFO":                                       "",
+	"INTLIST":                                             "",
+	"INameSpaceTreeAccessible":                            "71F312DE-43ED-4190-8477-E9536B82350B",
+	"INameSpaceTreeControl":                               "028212A3-B627-47E9-8856-C14265554E4F",
+	"INameSpaceTreeControl2":                              "7CC7AED8-290E-49BC-8945-C1401CC9306C",
+	"INameSpaceTreeControlCustomDraw":                     "2D3BA758-33EE-42D5-BB7B-5F3431D86C78",
+	"INameSpaceTreeControlDropHandler":                    "F9C665D6-C2F2-4C19-BF33-8322D7352F51",
+	"INameSpaceTreeControlEvents":                         "93D77985-B3D8-4484-8318-672CDDA002CE",
+	"INameSpaceTreeControlFolderCapabilities":             "E9701183-E6B3-4FF2-8568-813615FEC7BE",
+	"INamedPropertyBag":                                   "FB700430-952C-11D1-946F-000000000000",
+	"INamedPropertyStore":                                 "71604B0F-97B0-4764-8577-2F13E98A1422",
+	"INamespaceWalk":                                      "57CED8A7-3F4A-432C-9350-30F24483F74F",
+	"INamespaceWalkCB":                                    "D92995F8-CF5E-4A76-BF59-EAD39EA2B97E",
+	"INamespaceWalkCB2":                                   "7AC7492B-C38E-438A-87DB-68737844FF70",
+	"INetworkFolderInternal":                              "CEB38218-C971-47BB-A703-F0BC99CCDB81",
+	"INewMenuClient":                                      "DCB07FDC-3BB5-451C-90BE-966644FED7B0",
+	"INewShortcutHookA":                                   "000214E1-0000-0000-C000-000000000046",
+	"INewShortcutHookW":                                   "000214F7-0000-0000-C000-000000000046",
+	"INewWDEvents":                                        "0751C551-7568-41C9-8E5B-E22E38919236",
+	"INewWindowManager":                                   "D2BC4C84-3F72-4A52-A604-7BCBF3982CBB",
+	"INoMarshal":                                          "ECC8691B-C1DB-4DC0-855E-65F6C551AF49",
+	"INotifyReplica":                                      "99180163-DA16-101A-935C-444553540000",
+	"IORING_BUFFER_INFO":                                  "",
+	"IORING_BUFFER_REF":                                   "",
+	"IORING_BUFFER_REF_BufferUnion":                       "",
+	"IORING_CAPABILITIES":                                 "",
+	"IORING_CQE":                                          "",
+	"IORING_CREATE_FLAGS":                                 "",
+	"IORING_HANDLE_REF":                                   "",
+	"IORING_HANDLE_REF_HandleUnion":                       "",
+	"IORING_INFO":                                         "",
+	"IORING_REGISTERED_BUFFER":                            "",
+	"IOSPACE":                                             "",
+	"IOSPACE32":                                           "",
+	"IOSPACE64":                                           "",
+	"IOSPACE_EX":                                          "",
+	"IOSPACE_EX32":                                        "",
+	"IOSPACE_EX64":                                        "",
+	"IO_COUNTERS":                                         "",
+	"IO_STATUS_BLOCK":                                     "",
+	"IO_STATUS_BLOCK_Anonymous":                           "",
+	"IObjMgr":                                             "00BB2761-6A77-11D0-A535-00C04FD7D062",
+	"IObjectArray":                                        "92CA9DCD-5622-4BBA-A805-5E9F541BD8C9",
+	"IObjectCollection":                                   "5632B1A4-E38A-400A-928A-D4CD63230295",
+	"IObjectIdentity":                                     "CA04B7E6-0D21-11D1-8CC5-00C04FC2B085",
+	"IObjectModelProvider":                                "3AD86EBD-F5EF-483D-BB18-B1042A475D64",
+	"IObjectProvider":                                     "A6087428-3BE3-4D73-B308-7C04A540BF1A",
+	"IObjectSafety":                                       "CB5BDC81-93C1-11CF-8F20-00805F2CD064",
+	"IObjectWithAppUserModelID":                           "36DB0196-9665-46D1-9BA7-D3709EECF9ED",
+	"IObjectWithBackReferences":                           "321A6A6A-D61F-4BF3-97AE-14BE2986BB36",
+	"IObjectWithCancelEvent":                              "F279B885-0AE9-4B85-AC06-DDECF9408941",
+	"IObjectWithFolderEnumMode":                           "6A9D9026-0E6E-464C-B000-42ECC07DE673",
+	"IObjectWithProgID":                                   "71E806FB-8DEE-46FC-BF8C-7748A8A1AE13",
+	"IObjectWithPropertyKey":                              "FC0CA0A7-C316-4FD2-9031-3E628E6D4F23",
+	"IObjectWithSelection":                                "1C9CD5BB-98E9-4491-A60F-31AACC72B83C",
+	"IObjectWithSite":                                     "FC4801A3-2BA9-11CF-A229-00AA003D7352",
+	"IOleAdviseHolder":                                    "00000111-0000-0000-C000-000000000046",
+	"IOleCache":                                           "0000011E-0000-0000-C000-000000000046",
+	"IOleCache2":                                          "00000128-0000-0000-C000-000000000046",
+	"IOleCacheControl":                                    "00000129-0000-0000-C000-000000000046",
+	"IOleClientSite":                                      "00000118-0000-0000-C000-000000000046",
+	"IOleCommandTarget":                                   "B722BCCB-4E68-101B-A2BC-00AA00404770",
+	"IOleContainer":                                       "0000011B-0000-0000-C000-000000000046",
+	"IOleControl":                                         "B196B288-BAB4-101A-B69C-00AA00341D07",
+	"IOleControlSite":                                     "B196B289-BAB4-101A-B69C-00AA00341D07",
+	"IOleDocument":                                        "B722BCC5-4E68-101B-A2BC-00AA00404770",
+	"IOleDocumentSite":                                    "B722BCC7-4E68-101B-A2BC-00AA00404770",
+	"IOleDocumentView":                                    "B722BCC6-4E68-101B-A2BC-00AA00404770",
+	"IOleInPlaceActiveObject":                             "00000117-0000-0000-C000-000000000046",
+	"IOleInPlaceFrame":                                    "00000116-0000-0000-C000-000000000046",
+	"IOleInPlaceObject":                                   "00000113-0000-0000-C000-000000000046",
+	"IOleInPlaceObjectWindowless":                         "1C2056CC-5EF4-101B-8BC8-00AA003E3B29",
+	"IOleInPlaceSite":                                     "00000119-0000-0000-C000-000000000046",
+	"IOleInPlaceSiteEx":                                   "9C2CAD80-3424-11CF-B670-00AA004CD6D8",
+	"IOleInPlaceSiteWindowless":                           "922EADA0-3424-11CF-B670-00AA004CD6D8",
+	"IOleInPlaceUIWindow":                                 "00000115-0000-0000-C000-000000000046",
+	"IOleItemContainer":                                   "0000011C-0000-0000-C000-000000000046",
+	"IOleLink":                                            "0000011D-0000-0000-C000-000000000046",
+	"IOleObject":                                          "00000112-0000-0000-C000-000000000046",
+	"IOleParentUndoUnit":                                  "A1FAF330-EF97-11CE-9BC9-00AA00608E01",
+	"IOleUILinkContainerA":                                "00000000-0000-0000-0000-000000000000",
+	"IOleUILinkContainerW":                                "00000000-0000-0000-0000-000000000000",
+	"IOleUILinkInfoA":                                     "00000000-0000-0000-0000-000000000000",
+	"IOleUILinkInfoW":                                     "00000000-0000-0000-0000-000000000000",
+	"IOleUIObjInfoA":                                      "00000000-0000-0000-0000-000000000000",
+	"IOleUIObjInfoW":                                      "00000000-0000-0000-0000-000000000000",
+	"IOleUndoManager":                                     "D001F200-EF97-11CE-9BC9-00AA00608E01",
+	"IOleUndoUnit":                                        "894AD3B0-EF97-11CE-9BC9-00AA00608E01",
+	"IOleWindow":                                          "00000114-0000-0000-C000-000000000046",
+	"IOpenControlPanel":                                   "D11AD862-66DE-4DF4-BF6C-1F5621996AF1",
+	"IOpenSearchSource":                                   "F0EE7333-E6FC-479B-9F25-A860C234A38E",
+	"IOperationsProgressDialog":                           "0C9FB851-E5C9-43EB-A370-F0677B13874C",
+	"IOplockStorage":                                      "8D19C834-8879-11D1-83E9-00C04FC2C6D4",
+	"IOptionDescription":                                  "432E5F85-35CF-4606-A801-6F70277E1D7A",
+	"IPMI_OS_SEL_RECORD":                                  "",
+	"IPSFactoryBuffer":                                    "D5F569D0-593B-101A-B569-08002B2DBF7A",
+	"IPackageDebugSettings":                               "F27C3930-8029-4AD1-94E3-3DBA417810C1",
+	"IPackageDebugSettings2":                              "6E3194BB-AB82-4D22-93F5-FABDA40E7B16",
+	"IPackageExecutionStateChangeNotification":            "1BB12A62-2AD8-432B-8CCF-0C2C52AFCD5B",
+	"IParentAndItem":                                      "B3A4B685-B685-4805-99D9-5DEAD2873236",
+	"IParseAndCreateItem":                                 "67EFED0E-E827-4408-B493-78F3982B685C",
+	"IParseDisplayName":                                   "0000011A-0000-0000-C000-000000000046",
+	"IPerPropertyBrowsing":                                "376BD3AA-3845-101B-84ED-08002B2EC713",
+	"IPerPropertyBrowsing2":                               "51973C54-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IPersist":                                            "0000010C-0000-0000-C000-000000000046",
+	"IPersistFile":                                        "0000010B-0000-0000-C000-000000000046",
+	"IPersistFolder":                                      "000214EA-0000-0000-C000-000000000046",
+	"IPersistFolder2":                                     "1AC3D9F0-175C-11D1-95BE-00609797EA4F",
+	"IPersistFolder3":                                     "CEF04FDF-FE72-11D2-87A5-00C04F6837CF",
+	"IPersistIDList":                                      "1079ACFC-29BD-11D3-8E0D-00C04F6837D5",
+	"IPersistMemory":                                      "BD1AE5E0-A6AE-11CE-BD37-504200C10000",
+	"IPersistPropertyBag":                                 "37D84F60-42CB-11CE-8135-00AA004BB851",
+	"IPersistPropertyBag2":                                "22F55881-280B-11D0-A8A9-00A0C90C2004",
+	"IPersistSerializedPropStorage":                       "E318AD57-0AA0-450F-ACA5-6FAB7103D917",
+	"IPersistSerializedPropStorage2":                      "77EFFA68-4F98-4366-BA72-573B3D880571",
+	"IPersistStorage":                                     "0000010A-0000-0000-C000-000000000046",
+	"IPersistStream":                                      "00000109-0000-0000-C000-000000000046",
+	"IPersistStreamInit":                                  "7FD52380-4E07-101B-AE2D-08002B2EC713",
+	"IPicture":                                            "7BF80980-BF32-101A-8BBB-00AA00300CAB",
+	"IPicture2":                                           "F5185DD8-2012-4B0B-AAD9-F052C6BD482B",
+	"IPictureDisp":                                        "7BF80981-BF32-101A-8BBB-00AA00300CAB",
+	"IPipeByte":                                           "DB2F3ACA-2F86-11D1-8E04-00C04FB9989A",
+	"IPipeDouble":                                         "DB2F3ACE-2F86-11D1-8E04-00C04FB9989A",
+	"IPipeLong":                                           "DB2F3ACC-2F86-11D1-8E04-00C04FB9989A",
+	"IPlayToManagerInterop":                               "24394699-1F2C-4EB3-8CD7-0EC1DA42A540",
+	"IPointerInactive":                                    "55980BA0-35AA-11CF-B671-00AA004CD6D8",
+	"IPreferredRuntimeTypeConcept":                        "9D6C1D7B-A76F-4618-8068-5F76BD9A4E8A",
+	"IPreviewHandler":                                     "8895B1C6-B41F-4C1C-A562-0D564250836F",
+	"IPreviewHandlerFrame":                                "FEC87AAF-35F9-447A-ADB7-20234491401A",
+	"IPreviewHandlerVisuals":                              "196BF9A5-B346-4EF0-AA1E-5DCDB76768B1",
+	"IPreviewItem":                                        "36149969-0A8F-49C8-8B00-4AECB20222FB",
+	"IPreviousVersionsInfo":                               "76E54780-AD74-48E3-A695-3BA9A0AFF10D",
+	"IPrint":                                              "B722BCC9-4E68-101B-A2BC-00AA00404770",
+	"IPrintDialogCallback":                                "5852A2C3-6530-11D1-B6A3-0000F8757BF9",
+	"IPrintDialogServices":                                "509AAEDA-5639-11D1-B6A1-0000F8757BF9",
+	"IProcessDebugManager32":                              "51973C2F-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IProcessDebugManager64":                              "56B9FC1C-63A9-4CC1-AC21-087D69A17FAB",
+	"IProcessInitControl":                                 "72380D55-8D2B-43A3-8513-2B6EF31434E9",
+	"IProcessLock":                                        "000001D5-0000-0000-C000-000000000046",
+	"IProfferService":                                     "CB728B20-F786-11CE-92AD-00AA00A74CD0",
+	"IProgressDialog":                                     "EBBC7C04-315E-11D2-B62F-006097DF5BD4",
+	"IProgressNotify":                                     "A9D758A0-4617-11CF-95FC-00AA00680DB4",
+	"IPropertyBag":                                        "55272A00-42CB-11CE-8135-00AA004BB851",
+	"IPropertyBag2":                                       "22F55882-280B-11D0-A8A9-00A0C90C2004",
+	"IPropertyChange":                                     "F917BC8A-1BBA-4478-A245-1BDE03EB9431",
+	"IPropertyChangeArray":                                "380F5CAD-1B5E-42F2-805D-637FD392D31E",
+	"IPropertyDescription":                                "6F79D558-3E96-4549-A1D1-7D75D2288814",
+	"IPropertyDescription2":                               "57D2EDED-5062-400E-B107-5DAE79FE57A6",
+	"IPropertyDescriptionAliasInfo":                       "F67104FC-2AF9-46FD-B32D-243C1404F3D1",
+	"IPropertyDescriptionList":                            "1F9FC1D0-C39B-4B26-817F-011967D3440E",
+	"IPropertyDescriptionRelatedPropertyInfo":             "507393F4-2A3D-4A60-B59E-D9C75716C2DD",
+	"IPropertyDescriptionSearchInfo":                      "078F91BD-29A2-440F-924E-46A291524520",
+	"IPropertyEnumType":                                   "11E1FBF9-2D56-4A6B-8DB3-7CD193A471F2",
+	"IPropertyEnumType2":                                  "9B6E051C-5DDD-4321-9070-FE2ACB55E794",
+	"IPropertyEnumTypeList":                               "A99400F4-3D84-4557-94BA-1242FB2CC9A6",
+	"IPropertyKeyStore":                                   "75BD59AA-F23B-4963-ABA4-0B355752A91B",
+	"IPropertyNotifySink":                                 "9BFBBC02-EFF1-101A-84ED-00AA00341D07",
+	"IPropertyPage":                                       "B196B28D-BAB4-101A-B69C-00AA00341D07",
+	"IPropertyPage2":                                      "01E44665-24AC-101B-84ED-08002B2EC713",
+	"IPropertyPageSite":                                   "B196B28C-BAB4-101A-B69C-00AA00341D07",
+	"IPropertySetStorage":                                 "0000013A-0000-0000-C000-000000000046",
+	"IPropertyStorage":                                    "00000138-0000-0000-C000-000000000046",
+	"IPropertyStore":                                      "886D8EEB-8CF2-4446-8D02-CDBA1DBDCF99",
+	"IPropertyStoreCache":                                 "3017056D-9A91-4E90-937D-746C72ABBF4F",
+	"IPropertyStoreCapabilities":                          "C8E2D566-186E-4D49-BF41-6909EAD56ACC",
+	"IPropertyStoreFactory":                               "BC110B6D-57E8-4148-A9C6-91015AB2F3A5",
+	"IPropertySystem":                                     "CA724E8A-C3E6-442B-88A4-6FB0DB8035A3",
+	"IPropertySystemChangeNotify":                         "FA955FD9-38BE-4879-A6CE-824CF52D609F",
+	"IPropertyUI":                                         "757A7D9F-919A-4118-99D7-DBB208C8CC66",
+	"IProtectFocus":                                       "D81F90A3-8156-44F7-AD28-5ABB87003274",
+	"IProtectedModeMenuServices":                          "73C105EE-9DFF-4A07-B83C-7EFF290C266E",
+	"IProvideClassInfo":                                   "B196B283-BAB4-101A-B69C-00AA00341D07",
+	"IProvideClassInfo2":                                  "A6BC3AC0-DBAA-11CE-9DE3-00AA004BB851",
+	"IProvideExpressionContexts":                          "51973C41-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IProvideMultipleClassInfo":                           "A7ABA9C1-8983-11CF-8F20-00805F2CD064",
+	"IProvideRuntimeContext":                              "10E2414A-EC59-49D2-BC51-5ADD2C36FEBC",
+	"IProxyProviderWinEventHandler":                       "89592AD4-F4E0-43D5-A3B6-BAD7E111B435",
+	"IProxyProviderWinEventSink":                          "4FD82B78-A43E-46AC-9803-0A6969C7C183",
+	"IPublishedApp":                                       "1BC752E0-9046-11D1-B8B3-006008059382",
+	"IPublishedApp2":                                      "12B81347-1B3A-4A04-AA61-3F768B67FD7E",
+	"IPublishingWizard":                                   "AA9198BB-CCEC-472D-BEED-19A4F6733F7A",
+	"IQueryAssociations":                                  "C46CA590-3C3F-11D2-BEE6-0000F805CA57",
+	"IQueryCancelAutoPlay":                                "DDEFE873-6997-4E68-BE26-39B633ADBE12",
+	"IQueryCodePage":                                      "C7B236CE-EE80-11D0-985F-006008059382",
+	"IQueryContinue":                                      "7307055C-B24A-486B-9F25-163E597A28A9",
+	"IQueryContinueWithStatus":                            "9090BE5B-502B-41FB-BCCC-0049A6C7254B",
+	"IQueryInfo":                                          "00021500-0000-0000-C000-000000000046",
+	"IQuickActivate":                                      "CF51ED10-62FE-11CF-BF86-00A0C9034836",
+	"IROTData":                                            "F29F6BC0-5021-11CE-AA15-00006901293F",
+	"IRangeValueProvider":                                 "36DC7AEF-33E6-4691-AFE1-2BE7274B3D33",
+	"IRawElementProviderAdviseEvents":                     "A407B27B-0F6D-4427-9292-473C7BF93258",
+	"IRawElementProviderFragment":                         "F7063DA8-8359-439C-9297-BBC5299A7D87",
+	"IRawElementProviderFragmentRoot":                     "620CE2A5-AB8F-40A9-86CB-DE3C75599B58",
+	"IRawElementProviderHostingAccessibles":               "24BE0B07-D37D-487A-98CF-A13ED465E9B3",
+	"IRawElementProviderHwndOverride":                     "1D5DF27C-8947-4425-B8D9-79787BB460B8",
+	"IRawElementProviderSimple":                           "D6DD68D1-86FD-4332-8666-9ABEDEA2D24C",
+	"IRawElementProviderSimple2":                          "A0A839A9-8DA1-4A82-806A-8E0D44E79F56",
+	"IRawElementProviderSimple3":                          "FCF5D820-D7EC-4613-BDF6-42A84CE7DAAF",
+	"IRawElementProviderWindowlessSite":                   "0A2A93CC-BFAD-42AC-9B2E-0991FB0D3EA0",
+	"IRawEnumerator":                                      "E13613F9-3A3C-40B5-8F48-1E5EBFB9B21B",
+	"IRecordInfo":                                         "0000002F-0000-0000-C000-000000000046",
+	"IRegTreeItem":                                        "A9521922-0812-4D44-9EC3-7FD38C726F3D",
+	"IRelatedItem":                                        "A73CE67A-8AB1-44F1-8D43-D2FCBF6B1CD0",
+	"IReleaseMarshalBuffers":                              "EB0CB9E8-7996-11D2-872E-0000F8080859",
+	"IRemoteComputer":                                     "000214FE-0000-0000-C000-000000000046",
+	"IRemoteDebugApplication":                             "51973C30-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IRemoteDebugApplication110":                          "D5FE005B-2836-485E-B1F9-89D91AA24FD4",
+	"IRemoteDebugApplicationEvents":                       "51973C33-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IRemoteDebugApplicationThread":                       "51973C37-CB0C-11D0-B5C9-00A0244A0E7A",
+	"IRemoteDebugCriticalErrorEvent110":                   "2F69C611-6B14-47E8-9260-4BB7C52F504B",
+	"IRemoteDebugInfoEvent110":                            "9FF56BB6-EB89-4C0F-8823-CC2A4C0B7F26",
+	"IResolveShellLink":                                   "5CD52983-9449-11D2-963A-00C04F79ADF0",
+	"IRestrictedErrorInfo":                                "82BA7092-4C88-427D-A7BC-16DD93FEB67E",
+	"IResultsFolder":                                      "96E5AE6D-6AE1-4B1C-900C-C6480EAA8828",
+	"IRichEditOle":                                        "00020D00-0000-0000-C000-000000000046",
+	"IRichEditOleCallback":                                "00020D03-0000-0000-C000-000000000046",
+	"IRichEditUiaInformation":                             "00000000-0000-0000-0000-000000000000",
+	"IRicheditUiaOverrides":                               "00000000-0000-0000-0000-000000000000",
+	"IRicheditWindowlessAccessibility":                    "00000000-0000-0000-0000-000000000000",
+	"IRoMetaDataLocator":                                  "00000000-0000-0000-0000-000000000000",
+	"IRoSimpleMetaDataBuilder":                            "00000000-0000-0000-0000-000000000000",
+	"IRootStorage":                                        "00000012-0000-0000-C000-000000000046",
+	"IRpcChannelBuffer":                                   "D5F56B60-593B-101A-B569-08002B2DBF7A",
+	"IRpcChannelBuffer2":                                  "594F31D0-7F19-11D0-B194-00A0C90DC8BF",
+	"IRpcChannelBuffer3":                                  "25B15600-0115-11D0-BF0D-00AA00B8DFD2",
+	"IRpcHelper":                                          "00000149-0000-0000-C000-000000000046",
+	"IRpcOptions":                                         "00000144-0000-0000-C000-000000000046",
+	"IRpcProxyBuffer":                                     "D5F56A34-593B-101A-B569-08002B2DBF7A",
+	"IRpcStubBuffer":                                      "D5F56AFC-593B-101A-B569-08002B2DBF7A",
+	"IRpcSyntaxNegotiate":                                 "58A08519-24C8-4935-B482-3FD823333A4F",
+	"IRunnableObject":                                     "00000126-0000-0000-C000-000000000046",
+	"IRunnableTask":                                       "85788D00-6807-11D0-B810-00C04FD706EC",
+	"IRunningObjectTable":                                 "00000010-0000-0000-C000-000000000046",
+	"IScriptEntry":                                        "0AEE2A95-BCBB-11D0-8C72-00C04FC2B085",
+	"IScriptErrorList":                                    "F3470F24-15FD-11D2-BB2E-00805FF7EFCA",
+	"IScriptInvocationContext":                            "5D7741B7-AF7E-4A2A-85E5-C77F4D0659FB",
+	"IScriptNode":                                         "0AEE2A94-BCBB-11D0-8C72-00C04FC2B085",
+	"IScriptScriptlet":                                    "0AEE2A96-BCBB-11D0-8C72-00C04FC2B085",
+	"IScrollItemProvider":                                 "2360C714-4BF1-4B26-BA65-9B21316127EB",
+	"IScrollProvider":                                     "B38B8077-1FC3-42A5-8CAE-D40C2215055A",
+	"ISearchBoxInfo":                                      "6AF6E03F-D664-4EF4-9626-F7E0ED36755E",
+	"ISearchContext":                                      "09F656A2-41AF-480C-88F7-16CC0D164615",
+	"ISearchFolderItemFactory":                            "A0FFBC28-5482-4366-BE27-3E81E78E06C2",
+	"ISelectionItemProvider":                              "2ACAD808-B2D4-452D-A407-91FF1AD167B2",
+	"ISelectionProvider":                                  "FB8B03AF-3BDF-48D4-BD36-1A65793BE168",
+	"ISelectionProvider2":                                 "14F68475-EE1C-44F6-A869-D239381F0FE7",
+	"ISequentialStream":                                   "0C733A30-2A1C-11CE-ADE5-00AA0044773D",
+	"IServerSecurity":                                     "0000013E-0000-0000-C000-000000000046",
+	"IServiceProvider":                                    "6D5140C1-7436-11CE-8034-00AA006009FA",
+	"IShareWindowCommandEventArgsInterop":                 "6571A721-643D-43D4-ACA4-6B6F5F30F1AD",
+	"IShareWindowCommandSourceInterop":                    "461A191F-8424-43A6-A0FA-3451A22F56AB",
+	"ISharedBitmap":                                       "091162A4-BC96-411F-AAE8-C5122CD03363",
+	"ISharingConfigurationManager":                        "B4CD448A-9C86-4466-9201-2E62105B87AE",
+	"IShellApp":                                           "A3E14960-935F-11D1-B8B8-006008059382",
+	"IShellBrowser":                                       "000214E2-0000-0000-C000-000000000046",
+	"IShellChangeNotify":                                  "D82BE2B1-5764-11D0-A96E-00C04FD705A2",
+	"IShellDetails":                                       "000214EC-0000-0000-C000-000000000046",
+	"IShellDispatch":                                      "D8F015C0-C278-11CE-A49E-444553540000",
+	"IShellDispatch2":                                     "A4C6892C-3BA9-11D2-9DEA-00C04FB16162",
+	"IShellDispatch3":                                     "177160CA-BB5A-411C-841D-BD38FACDEAA0",
+	"IShellDispatch4":                                     "EFD84B2D-4BCF-4298-BE25-EB542A59FBDA",
+	"IShellDispatch5":                                     "866738B9-6CF2-4DE8-8767-F794EBE74F4E",
+	"IShellDispatch6":                                     "286E6F1B-7113-4355-9562-96B7E9D64C54",
+	"IShellExtInit":                                       "000214E8-0000-0000-C000-000000000046",
+	"IShellFavoritesNameSpace":                            "55136804-B2DE-11D1-B9F2-00A0C98BC547",
+	"IShellFolder":                                        "000214E6-0000-0000-C000-000000000046",
+	"IShellFolder2":                                       "93F2F68C-1D1B-11D3-A30E-00C04F79ABD1",
+	"IShellFolderBand":                                    "7FE80CC8-C247-11D0-B93A-00A0C90312E1",
+	"IShellFolderView":                                    "37A378C0-F82D-11CE-AE65-08002B2E1262",
+	"IShellFolderViewCB":                                  "2047E320-F2A9-11CE-AE65-08002B2E1262",
+	"IShellFolderViewDual":                                "E7A1AF80-4D96-11CF-960C-0080C7F4EE85",
+	"IShellFolderViewDual2":                               "31C147B6-0ADE-4A3C-B514-DDF932EF6D17",
+	"IShellFolderViewDual3":                               "29EC8E6C-46D3-411F-BAAA-611A6C9CAC66",
+	"IShellIcon":                                          "000214E5-0000-0000-C000-000000000046",
+	"IShellIconOverlay":                                   "7D688A70-C613-11D0-999B-00C04FD655E1",
+	"IShellIconOverlayIdentifier":                         "0C6C4200-C589-11D0-999A-00C04FD655E1",
+	"IShellIconOverlayManager":                            "F10B5E34-DD3B-42A7-AA7D-2F4EC54BB09B",
+	"IShellImageData":                                     "BFDEEC12-8040-4403-A5EA-9E07DAFCF530",
+	"IShellImageDataAbort":                                "53FB8E58-50C0-4003-B4AA-0C8DF28E7F3A",
+	"IShellImageDataFactory":                              "9BE8ED5C-EDAB-4D75-90F3-BD5BDBB21C82",
+	"IShellItem":                                          "43826D1E-E718-42EE-BC55-A1E261C37BFE",
+	"IShellItem2":                                         "7E9FB0D3-919F-4307-AB2E-9B1860310C93",
+	"IShellItemArray":                                     "B63EA76D-1F85-456F-A19C-48159EFA858B",
+	"IShellItemFilter":                                    "2659B475-EEB8-48B7-8F07-B378810F48CF",
+	"IShellItemImageFactory":                              "BCC18B79-BA16-442F-80C4-8A59C30C463B",
+	"IShellItemResources":                                 "FF5693BE-2CE0-4D48-B5C5-40817D1ACDB9",
+	"IShellLibrary":                                       "11A66EFA-382E-451A-9234-1E0E12EF3085",
+	"IShellLinkA":                                         "000214EE-0000-0000-C000-000000000046",
+	"IShellLinkDataList":                                  "45E2B4AE-B1C3-11D0-B92F-00A0C90312E1",
+	"IShellLinkDual":                                      "88A05C00-F000-11CE-8350-444553540000",
+	"IShellLinkDual2":                                     "317EE249-F12E-11D2-B1E4-00C04F8EEB3E",
+	"IShellLinkW":                                         "000214F9-0000-0000-C000-000000000046",
+	"IShellMenu":                                          "EE1F7637-E138-11D1-8379-00C04FD918D0",
+	"IShellMenuCallback":                                  "4CA300A1-9B8D-11D1-8B22-00C04FD918D0",
+	"IShellNameSpace":                                     "E572D3C9-37BE-4AE2-825D-D521763E3108",
+	"IShellPropSheetExt":                                  "000214E9-0000-0000-C000-000000000046",
+	"IShellRunDll":                                        "FCE4BDE0-4B68-4B80-8E9C-7426315A7388",
+	"IShellService":                                       "5836FB00-8187-11CF-A12B-00AA004AE837",
+	"IShellTaskScheduler":                                 "6CCB7BE0-6807-11D0-B810-00C04FD706EC",
+	"IShellUIHelper":                                      "729FE2F8-1EA8-11D1-8F85-00C04FC2FBE1",
+	"IShellUIHelper2":                                     "A7FE6EDA-1932-4281-B881-87B31B8BC52C",
+	"IShellUIHelper3":                                     "528DF2EC-D419-40BC-9B6D-DCDBF9C1B25D",
+	"IShellUIHelper4":                                     "B36E6A53-8073-499E-824C-D776330A333E",
+	"IShellUIHelper5":                                     "A2A08B09-103D-4D3F-B91C-EA455CA82EFA",
+	"IShellUIHelper6":                                     "987A573E-46EE-4E89-96AB-DDF7F8FDC98C",
+	"IShellUIHelper7":                                     "60E567C8-9573-4AB2-A264-637C6C161CB1",
+	"IShellUIHelper8":                                     "66DEBCF2-05B0-4F07-B49B-B96241A65DB2",
+	"IShellUIHelper9":                                     "6CDF73B0-7F2F-451F-BC0F-63E0F3284E54",
+	"IShellView":                                          "000214E3-0000-0000-C000-000000000046",
+	"IShellView2":                                         "88E39E80-3578-11CF-AE69-08002B2E1262",
+	"IShellView3":                                         "EC39FA88-F8AF-41C5-8421-38BED28F4673",
+	"IShellWindows":                                       "85CB6900-4D95-11CF-960C-0080C7F4EE85",
+	"ISimpleConnectionPoint":                              "51973C3E-CB0C-11D0-B5C9-00A0244A0E7A",
+	"ISimpleFrameSite":                                    "742B0E01-14E6-101B-914E-00AA00300CAB",
+	"ISortColumnArray":                                    "6DFC60FB-F2E9-459B-BEB5-288F1A7C7D54",
+	"ISpatialInteractionManagerInterop":                   "5C4EE536-6A98-4B86-A170-587013D6FD4B",
+	"ISpecifyPropertyPages":                               "B196B28B-BAB4-101A-B69C-00AA00341D07",
+	"ISpellCheckProvider":                                 "73E976E0-8ED4-4EB1-80D7-1BE0A16B0C38",
+	"ISpellCheckProviderFactory":                          "9F671E11-77D6-4C92-AEFB-615215E3A4BE",
+	"ISpellChecker":                                       "B6FD0B71-E2BC-4653-8D05-F197E412770B",
+	"ISpellChecker2":                                      "E7ED1C71-87F7-4378-A840-C9200DACEE47",
+	"ISpellCheckerChangedEventHandler":                    "0B83A5B0-792F-4EAB-9799-ACF52C5ED08A",
+	"ISpellCheckerFactory":                                "8E018A9D-2415-4677-BF08-794EA61F94BB",
+	"ISpellingError":                                      "B7C82D61-FBE8-4B47-9B27-6C0D2E0DE0A3",
+	"ISpreadsheetItemProvider":                            "EAED4660-7B3D-4879-A2E6-365CE603F3D0",
+	"ISpreadsheetProvider":                                "6F6B5D35-5525-4F80-B758-85473832FFC7",
+	"IStartMenuPinnedList":                                "4CD19ADA-25A5-4A32-B3B7-347BEE5BE36B",
+	"IStdMarshalInfo":                                     "00000018-0000-0000-C000-000000000046",
+	"IStorage":                                            "0000000B-0000-0000-C000-000000000046",
+	"IStorageProviderBanners":                             "5EFB46D7-47C0-4B68-ACDA-DED47C90EC91",
+	"IStorageProviderCopyHook":                            "7BF992A9-AF7A-4DBA-B2E5-4D080B1ECBC6",
+	"IStorageProviderHandler":                             "162C6FB5-44D3-435B-903D-E613FA093FB5",
+	"IStorageProviderPropertyHandler":                     "301DFBE5-524C-4B0F-8B2D-21C40B3A2988",
+	"IStream":                                             "0000000C-0000-0000-C000-000000000046",
+	"IStreamAsync":                                        "FE0B6665-E0CA-49B9-A178-2B5CB48D92A5",
+	"IStreamUnbufferedInfo":                               "8A68FDDA-1FDC-4C20-8CEB-416643B5A625",
+	"IStringDisplayableConcept":                           "D28E8D70-6C00-4205-940D-501016601EA3",
+	"IStylesProvider":                                     "19B6B649-F5D7-4A6D-BDCB-129252BE588A",
+	"ISupportErrorInfo":                                   "DF0B3D60-548F-101B-8E65-08002B2BD119",
+	"ISurrogate":                                          "00000022-0000-0000-C000-000000000046",
+	"ISurrogateService":                                   "000001D4-0000-0000-C000-000000000046",
+	"ISuspensionDependencyManager":                        "52B83A42-2543-416A-81D9-C0DE7969C8B3",
+	"ISyncMgrConflict":                                    "9C204249-C443-4BA4-85ED-C972681DB137",
+	"ISyncMgrConflictFolder":                              "59287F5E-BC81-4FCA-A7F1-E5A8ECDB1D69",
+	"ISyncMgrConflictItems":                               "9C7EAD52-8023-4936-A4DB-D2A9A99E436A",
+	"ISyncMgrConflictPresenter":                           "0B4F5353-FD2B-42CD-8763-4779F2D508A3",
+	"ISyncMgrConflictResolutionItems":                     "458725B9-129D-4135-A998-9CEAFEC27007",
+	"ISyncMgrConflictResolveInfo":                         "C405A219-25A2-442E-8743-B845A2CEE93F",
+	"ISyncMgrConflictStore":                               "CF8FC579-C396-4774-85F1-D908A831156E",
+	"ISyncMgrControl":                                     "9B63616C-36B2-46BC-959F-C1593952D19B",
+	"ISyncMgrEnumItems":                                   "6295DF2A-35EE-11D1-8707-00C04FD93327",
+	"ISyncMgrEvent":                                       "FEE0EF8B-46BD-4DB4-B7E6-FF2C687313BC",
+	"ISyncMgrEventLinkUIOperation":                        "64522E52-848B-4015-89CE-5A36F00B94FF",
+	"ISyncMgrEventStore":                                  "37E412F9-016E-44C2-81FF-DB3ADD774266",
+	"ISyncMgrHandler":                                     "04EC2E43-AC77-49F9-9B98-0307EF7A72A2",
+	"ISyncMgrHandlerCollection":                           "A7F337A3-D20B-45CB-9ED7-87D094CA5045",
+	"ISyncMgrHandlerInfo":                                 "4FF1D798-ECF7-4524-AA81-1E362A0AEF3A",
+	"ISyncMgrRegister":                                    "6295DF42-35EE-11D1-8707-00C04FD93327",
+	"ISyncMgrResolutionHandler":                           "40A3D052-8BFF-4C4B-A338-D4A395700DE9",
+	"ISyncMgrScheduleWizardUIOperation":                   "459A6C84-21D2-4DDC-8A53-F023A46066F2",
+	"ISyncMgrSessionCreator":                              "17F48517-F305-4321-A08D-B25A834918FD",
+	"ISyncMgrSyncCallback":                                "884CCD87-B139-4937-A4BA-4F8E19513FBE",
+	"ISyncMgrSyncItem":                                    "B20B24CE-2593-4F04-BD8B-7AD6C45051CD",
+	"ISyncMgrSyncItemContainer":                           "90701133-BE32-4129-A65C-99E616CAFFF4",
+	"ISyncMgrSyncItemInfo":                                "E7FD9502-BE0C-4464-90A1-2B5277031232",
+	"ISyncMgrSyncResult":                                  "2B90F17E-5A3E-4B33-BB7F-1BC48056B94D",
+	"ISyncMgrSynchronize":                                 "6295DF40-35EE-11D1-8707-00C04FD93327",
+	"ISyncMgrSynchronizeCallback":                         "6295DF41-35EE-11D1-8707-00C04FD93327",
+	"ISyncMgrSynchronizeInvoke":                           "6295DF2C-35EE-11D1-8707-00C04FD93327",
+	"ISyncMgrUIOperation":                                 "FC7CFA47-DFE1-45B5-A049-8CFD82BEC271",
+	"ISynchronize":                                        "00000030-0000-0000-C000-000000000046",
+	"ISynchronizeContainer":                               "00000033-0000-0000-C000-000000000046",
+	"ISynchronizeEvent":                                   "00000032-0000-0000-C000-000000000046",
+	"ISynchronizeHandle":                                  "00000031-0000-0000-C000-000000000046",
+	"ISynchronizeMutex":                                   "00000025-0000-0000-C000-000000000046",
+	"ISynchronizedInputProvider":                          "29DB1A06-02CE-4CF7-9B42-565D4FAB20EE",
+	"ISystemMediaTransportControlsInterop":                "DDB0472D-C911-4A1F-86D9-DC3D71A95F5A",
+	"ITEMIDLIST":                                          "",
+	"ITEMSPACING":                                         "",
+	"ITableItemProvider":                                  "B9734FA6-771F-4D78-9C90-2517999349CD",
+	"ITableProvider":                                      "9C860395-97B3-490A-B52A-858CC22AF166",
+	"ITaskbarList":                                        "56FDF342-FD6D-11D0-958A-006097C9A090",
+	"ITaskbarList2":                                       "602D4995-B13A-429B-A66E-1935E44F4317",
+	"ITaskbarList3":                                       "EA1AFB91-9E28-4B86-90E9-9E9F8A5EEFAF",
+	"ITaskbarList4":                                       "C43DC798-95D1-4BEA-9030-BB99E2983A1A",
+	"ITextChildProvider":                                  "4C2DE2B9-C88F-4F88-A111-F1D336B7D1A9",
+	"ITextDisplays":                                       "C241F5F2-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextDocument":                                       "8CC497C0-A1DF-11CE-8098-00AA0047BE5D",
+	"ITextDocument2":                                      "C241F5E0-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextDocument2Old":                                   "01C25500-4268-11D1-883A-3C8B00C10000",
+	"ITextEditProvider":                                   "EA3605B4-3A05-400E-B5F9-4E91B40F6176",
+	"ITextFont":                                           "8CC497C3-A1DF-11CE-8098-00AA0047BE5D",
+	"ITextFont2":                                          "C241F5E3-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextHost":                                           "00000000-0000-0000-0000-000000000000",
+	"ITextHost2":                                          "00000000-0000-0000-0000-000000000000",
+	"ITextPara":                                           "8CC497C4-A1DF-11CE-8098-00AA0047BE5D",
+	"ITextPara2":                                          "C241F5E4-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextProvider":                                       "3589C92C-63F3-4367-99BB-ADA653B77CF2",
+	"ITextProvider2":                                      "0DC5E6ED-3E16-4BF1-8F9A-A979878BC195",
+	"ITextRange":                                          "8CC497C2-A1DF-11CE-8098-00AA0047BE5D",
+	"ITextRange2":                                         "C241F5E2-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextRangeProvider":                                  "5347AD7B-C355-46F8-AFF5-909033582F63",
+	"ITextRangeProvider2":                                 "9BBCE42C-1921-4F18-89CA-DBA1910A0386",
+	"ITextRow":                                            "C241F5EF-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextSelection":                                      "8CC497C1-A1DF-11CE-8098-00AA0047BE5D",
+	"ITextSelection2":                                     "C241F5E1-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextServices":                                       "00000000-0000-0000-0000-000000000000",
+	"ITextServices2":                                      "00000000-0000-0000-0000-000000000000",
+	"ITextStory":                                          "C241F5F3-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextStoryRanges":                                    "8CC497C5-A1DF-11CE-8098-00AA0047BE5D",
+	"ITextStoryRanges2":                                   "C241F5E5-7206-11D8-A2C7-00A0D1D6C6B3",
+	"ITextStrings":                                        "C241F5E7-7206-11D8-A2C7-00A0D1D6C6B3",
+	"IThumbnailCache":                                     "F676C15D-596A-4CE2-8234-33996F445DB1",
+	"IThumbnailCachePrimer":                               "0F03F8FE-2B26-46F0-965A-212AA8D66B76",
+	"IThumbnailCapture":                                   "4EA39266-7211-409F-B622-F63DBD16C533",
+	"IThumbnailHandlerFactory":                            "E35B4B2E-00DA-4BC1-9F13-38BC11F5D417",
+	"IThumbnailProvider":                                  "E357FCCD-A995-4576-B01F-234630154E96",
+	"IThumbnailSettings":                                  "F4376F00-BEF5-4D45-80F3-1E023BBF1209",
+	"IThumbnailStreamCache":                               "90E11430-9569-41D8-AE75-6D4D2AE7CCA0",
+	"ITimeAndNoticeControl":                               "BC0BF6AE-8878-11D1-83E9-00C04FC2C6D4",
+	"IToggleProvider":                                     "56D00BD0-C4F4-433C-A836-1A52A57E0892",
+	"ITrackShellMenu":                                     "8278F932-2A3E-11D2-838F-00C04FD918D0",
+	"ITranscodeImage":                                     "BAE86DDD-DC11-421C-B7AB-CC55D1D65C44",
+	"ITransferAdviseSink":                                 "D594D0D8-8DA7-457B-B3B4-CE5DBAAC0B88",
+	"ITransferDestination":                                "48ADDD32-3CA5-4124-ABE3-B5A72531B207",
+	"ITransferMediumItem":                                 "77F295D5-2D6F-4E19-B8AE-322F3E721AB5",
+	"ITransferSource":                                     "00ADB003-BDE9-45C6-8E29-D09F9353E108",
+	"ITransformProvider":                                  "6829DDC4-4F91-4FFA-B86F-BD3E2987CB4C",
+	"ITransformProvider2":                                 "4758742F-7AC2-460C-BC48-09FC09308A93",
+	"ITravelEntry":                                        "F46EDB3B-BC2F-11D0-9412-00AA00A3EBD3",
+	"ITravelLog":                                          "66A9CB08-4802-11D2-A561-00A0C92DBFE8",
+	"ITravelLogClient":                                    "241C033E-E659-43DA-AA4D-4086DBC4758D",
+	"ITravelLogEntry":                                     "7EBFDD87-AD18-11D3-A4C5-00C04F72D6B8",
+	"ITravelLogStg":                                       "7EBFDD80-AD18-11D3-A4C5-00C04F72D6B8",
+	"ITrayDeskBand":                                       "6D67E846-5B9C-4DB8-9CBC-DDE12F4254F1",
+	"ITridentEventSink":                                   "1DC9CA50-06EF-11D2-8415-006008C3FBFC",
+	"ITypeChangeEvents":                                   "00020410-0000-0000-C000-000000000046",
+	"ITypeComp":                                           "00020403-0000-0000-C000-000000000046",
+	"ITypeFactory":                                        "0000002E-0000-0000-C000-000000000046",
+	"ITypeInfo":                                           "00020401-0000-0000-C000-000000000046",
+	"ITypeInfo2":                                          "00020412-0000-0000-C000-000000000046",
+	"ITypeLib":                                            "00020402-0000-0000-C000-000000000046",
+	"ITypeLib2":                                           "00020411-0000-0000-C000-000000000046",
+	"ITypeLibRegistration":                                "76A3E735-02DF-4A12-98EB-043AD3600AF3",
+	"ITypeLibRegistrationReader":                          "ED6A8A2A-B160-4E77-8F73-AA7435CD5C27",
+	"ITypeMarshal":                                        "0000002D-0000-0000-C000-000000000046",
+	"IUIAutomation":                                       "30CBE57D-D9D0-452A-AB13-7AC5AC4825EE",
+	"IUIAutomation2":                                      "34723AFF-0C9D-49D0-9896-7AB52DF8CD8A",
+	"IUIAutomation3":                                      "73D768DA-9B51-4B89-936E-C209290973E7",
+	"IUIAutomation4":                                      "1189C02A-05F8-4319-8E21-E817E3DB2860",
+	"IUIAutomation5":                                      "25F700C8-D816-4057-A9DC-3CBDEE77E256",
+	"IUIAutomation6":                                      "AAE072DA-29E3-413D-87A7-192DBF81ED10",
+	"IUIAutomationActiveTextPositionChangedEventHandler": "F97933B0-8DAE-4496-8997-5BA015FE0D82",
+	"IUIAutomationAndCondition":                          "A7D0AF36-B912-45FE-9855-091DDC174AEC",
+	"IUIAutomationAnnotationPattern":                     "9A175B21-339E-41B1-8E8B-623F6B681098",
+	"IUIAutomationBoolCondition":                         "1B4E1F2E-75EB-4D0B-8952-5A69988E2307",
+	"IUIAutomationCacheRequest":                          "B32A92B5-BC25-4078-9C08-D7EE95C48E03",
+	"IUIAutomationChangesEventHandler":                   "58EDCA55-2C3E-4980-B1B9-56C17F27A2A0",
+	"IUIAutomationCondition":                             "352FFBA8-0973-437C-A61F-F64CAFD81DF9",
+	"IUIAutomationCustomNavigationPattern":               "01EA217A-1766-47ED-A6CC-ACF492854B1F",
+	"IUIAutomationDockPattern":                           "FDE5EF97-1464-48F6-90BF-43D0948E86EC",
+	"IUIAutomationDragPattern":                           "1DC7B570-1F54-4BAD-BCDA-D36A722FB7BD",
+	"IUIAutomationDropTargetPattern":                     "69A095F7-EEE4-430E-A46B-FB73B1AE39A5",
+	"IUIAutomationElement":                               "D22108AA-8AC5-49A5-837B-37BBB3D7591E",
+	"IUIAutomationElement2":                              "6749C683-F70D-4487-A698-5F79D55290D6",
+	"IUIAutomationElement3":                              "8471DF34-AEE0-4A01-A7DE-7DB9AF12C296",
+	"IUIAutomationElement4":                              "3B6E233C-52FB-4063-A4C9-77C075C2A06B",
+	"IUIAutomationElement5":                              "98141C1D-0D0E-4175-BBE2-6BFF455842A7",
+	"IUIAutomationElement6":                              "4780D450-8BCA-4977-AFA5-A4A517F555E3",
+	"IUIAutomationElement7":                              "204E8572-CFC3-4C11-B0C8-7DA7420750B7",
+	"IUIAutomationElement8":                              "8C60217D-5411-4CDE-BCC0-1CEDA223830C",
+	"IUIAutomationElement9":                              "39325FAC-039D-440E-A3A3-5EB81A5CECC3",
+	"IUIAutomationElementArray":                          "14314595-B4BC-4055-95F2-58F2E42C9855",
+	"IUIAutomationEventHandler":                          "146C3C17-F12E-4E22-8C27-F894B9B79C69",
+	"IUIAutomationEventHandlerGroup":                     "C9EE12F2-C13B-4408-997C-639914377F4E",
+	"IUIAutomationExpandCollapsePattern":                 "619BE086-1F4E-4EE4-BAFA-210128738730",
+	"IUIAutomationFocusChangedEventHandler":              "C270F6B5-5C69-4290-9745-7A7F97169468",
+	"IUIAutomationGridItemPattern":                       "78F8EF57-66C3-4E09-BD7C-E79B2004894D",
+	"IUIAutomationGridPattern":                           "414C3CDC-856B-4F5B-8538-3131C6302550",
+	"IUIAutomationInvokePattern":                         "FB377FBE-8EA6-46D5-9C73-6499642D3059",
+	"IUIAutomationItemContainerPattern":                  "C690FDB2-27A8-423C-812D-429773C9084E",
+	"IUIAutomationLegacyIAccessiblePattern":              "828055AD-355B-4435-86D5-3B51C14A9B1B",
+	"IUIAutomationMultipleViewPattern":                   "8D253C91-1DC5-4BB5-B18F-ADE16FA495E8",
+	"IUIAutomationNotCondition":                          "F528B657-847B-498C-8896-D52B565407A1",
+	"IUIAutomationNotificationEventHandler":              "C7CB2637-E6C2-4D0C-85DE-4948C02175C7",
+	"IUIAutomationObjectModelPattern":                    "71C284B3-C14D-4D14-981E-19751B0D756D",
+	"IUIAutomationOrCondition":                           "8753F032-3DB1-47B5-A1FC-6E34A266C712",
+	"IUIAutomationPatternHandler":                        "D97022F3-A947-465E-8B2A-AC4315FA54E8",
+	"IUIAutomationPatternInstance":                       "C03A7FE4-9431-409F-BED8-AE7C2299BC8D",
+	"IUIAutomationPropertyChangedEventHandler":           "40CD37D4-C756-4B0C-8C6F-BDDFEEB13B50",
+	"IUIAutomationPropertyCondition":                     "99EBF2CB-5578-4267-9AD4-AFD6EA77E94B",
+	"IUIAutomationProxyFactory":                          "85B94ECD-849D-42B6-B94D-D6DB23FDF5A4",
+	"IUIAutomationProxyFactoryEntry":                     "D50E472E-B64B-490C-BCA1-D30696F9F289",
+	"IUIAutomationProxyFactoryMapping":                   "09E31E18-872D-4873-93D1-1E541EC133FD",
+	"IUIAutomationRangeValuePattern":                     "59213F4F-7346-49E5-B120-80555987A148",
+	"IUIAutomationRegistrar":                             "8609C4EC-4A1A-4D88-A357-5A66E060E1CF",
+	"IUIAutomationScrollItemPattern":                     "B488300F-D015-4F19-9C29-BB595E3645EF",
+	"IUIAutomationScrollPattern":                         "88F4D42A-E881-459D-A77C-73BBBB7E02DC",
+	"IUIAutomationSelectionItemPattern":                  "A8EFA66A-0FDA-421A-9194-38021F3578EA",
+	"IUIAutomationSelectionPattern":                      "5ED5202E-B2AC-47A6-B638-4B0BF140D78E",
+	"IUIAutomationSelectionPattern2":                     "0532BFAE-C011-4E32-A343-6D642D798555",
+	"IUIAutomationSpreadsheetItemPattern":                "7D4FB86C-8D34-40E1-8E83-62C15204E335",
+	"IUIAutomationSpreadsheetPattern":                    "7517A7C8-FAAE-4DE9-9F08-29B91E8595C1",
+	"IUIAutomationStructureChangedEventHandler":          "E81D1B4E-11C5-42F8-9754-E7036C79F054",
+	"IUIAutomationStylesPattern":                         "85B5F0A2-BD79-484A-AD2B-388C9838D5FB",
+	"IUIAutomationSynchronizedInputPattern":              "2233BE0B-AFB7-448B-9FDA-3B378AA5EAE1",
+	"IUIAutomationTableItemPattern":                      "0B964EB3-EF2E-4464-9C79-61D61737A27E",
+	"IUIAutomationTablePattern":                          "620E691C-EA96-4710-A850-754B24CE2417",
+	"IUIAutomationTextChildPattern":                      "6552B038-AE05-40C8-ABFD-AA08352AAB86",
+	"IUIAutomationTextEditPattern":                       "17E21576-996C-4870-99D9-BFF323380C06",
+	"IUIAutomationTextEditTextChangedEventHandler":       "92FAA680-E704-4156-931A-E32D5BB38F3F",
+	"IUIAutomationTextPattern":                           "32EBA289-3583-42C9-9C59-3B6D9A1E9B6A",
+	"IUIAutomationTextPattern2":                          "506A921A-FCC9-409F-B23B-37EB74106872",
+	"IUIAutomationTextRange":                             "A543CC6A-F4AE-494B-8239-C814481187A8",
+	"IUIAutomationTextRange2":                            "BB9B40E0-5E04-46BD-9BE0-4B601B9AFAD4",
+	"IUIAutomationTextRange3":                            "6A315D69-5512-4C2E-85F0-53FCE6DD4BC2",
+	"IUIAutomationTextRangeArray":                        "CE4AE76A-E717-4C98-81EA-47371D028EB6",
+	"IUIAutomationTogglePattern":                         "94CF8058-9B8D-4AB9-8BFD-4CD0A33C8C70",
+	"IUIAutomationTransformPattern":                      "A9B55844-A55D-4EF0-926D-569C16FF89BB",
+	"IUIAutomationTransformPattern2":                     "6D74D017-6ECB-4381-B38B-3C17A48FF1C2",
+	"IUIAutomationTreeWalker":                            "4042C624-389C-4AFC-A630-9DF854A541FC",
+	"IUIAutomationValuePattern":                          "A94CD8B1-0844-4CD6-9D2D-640537AB39E9",
+	"IUIAutomationVirtualizedItemPattern":                "6BA3D7A6-04CF-4F11-8793-A8D1CDE9969F",
+	"IUIAutomationWindowPattern":                         "0FAEF453-9208-43EF-BBB2-3B485177864F",
+	"IUIViewSettingsInterop":                             "3694DBF9-8F68-44BE-8FF5-195C98EDE8A6",
+	"IURLSearchHook":                                     "AC60F6A0-0FD9-11D0-99CB-00C04FD64497",
+	"IURLSearchHook2":                                    "5EE44DA4-6D32-46E3-86BC-07540DEDD0E0",
+	"IUniformResourceLocatorA":                           "FBF23B80-E3F0-101B-8488-00AA003E56F8",
+	"IUniformResourceLocatorW":                           "CABB0DA0-DA57-11CF-9974-0020AFD79762",
+	"IUnknown":                                           "00000000-0000-0000-C000-000000000046",
+	"IUpdateIDList":                                      "6589B6D2-5F8D-4B9E-B7E0-23CDD9717D8C",
+	"IUri":                                               "A39EE748-6A27-4817-A6F2-13914BEF5890",
+	"IUriBuilder":                                        "4221B2E1-8955-46C0-BD5B-DE9897565DE7",
+	"IUrlMon":                                            "00000026-0000-0000-C000-000000000046",
+	"IUseToBrowseItem":                                   "05EDDA5C-98A3-4717-8ADB-C5E7DA991EB1",
+	"IUserAccountChangeCallback":                         "A561E69A-B4B8-4113-91A5-64C6BCCA3430",
+	"IUserActivityInterop":                               "1ADE314D-0E0A-40D9-824C-9A088A50059F",
+	"IUserActivityRequestManagerInterop":                 "DD69F876-9699-4715-9095-E37EA30DFA1B",
+	"IUserActivitySourceHostInterop":                     "C15DF8BC-8844-487A-B85B-7578E0F61419",
+	"IUserConsentVerifierInterop":                        "39E050C3-4E74-441A-8DC0-B81104DF949C",
+	"IUserDictionariesRegistrar":                         "AA176B85-0E12-4844-8E1A-EEF1DA77F586",
+	"IUserNotification":                                  "BA9711BA-5893-4787-A7E1-41277151550B",
+	"IUserNotification2":                                 "215913CC-57EB-4FAB-AB5A-E5FA7BEA2A6C",
+	"IUserNotificationCallback":                          "19108294-0441-4AFF-8013-FA0A730B0BEA",
+	"IVBFormat":                                          "9849FD60-3768-101B-8D72-AE6164FFE3CF",
+	"IVBGetControl":                                      "40A050A0-3C31-101B-A82E-08002B2B2337",
+	"IValueProvider":                                     "C7935180-6FB3-4201-B174-7DF73ADBF64A",
+	"IVariantChangeType":                                 "A6EF9862-C720-11D0-9337-00A0C90DCAA9",
+	"IViewObject":                                        "0000010D-0000-0000-C000-000000000046",
+	"IViewObject2":                                       "00000127-0000-0000-C000-000000000046",
+	"IViewObjectEx":                                      "3AF24292-0C96-11CE-A0CF-00AA00600AB8",
+	"IViewStateIdentityItem":                             "9D264146-A94F-4195-9F9F-3BB12CE0C955",
+	"IVirtualDesktopManager":                             "A5CD92FF-29BE-454C-8D04-D82879FB3F1B",
+	"IVirtualizedItemProvider":                           "CB98B665-2D35-4FAC-AD35-F3C60D0C0B8B",
+	"IVisualProperties":                                  "E693CF68-D967-4112-8763-99172AEE5E5A",
+	"IWaitMultiple":                                      "0000002B-0000-0000-C000-000000000046",
+	"IWeakReference":                                     "00000037-0000-0000-C000-000000000046",
+	"IWeakReferenceSource":                               "00000038-0000-0000-C000-000000000046",
+	"IWebAppDiagnosticsObjectInitialization":             "16FF3A42-A5F5-432B-B625-8E8E16F57E15",
+	"IWebAppDiagnosticsSetup":                            "379BFBE1-C6C9-432A-93E1-6D17656C538C",
+	"IWebAuthenticationCoreManagerInterop":               "F4B8E804-811E-4436-B69C-44CB67B72084",
+	"IWebBrowser":                                        "EAB22AC1-30C1-11CF-A7EB-0000C05BAE0B",
+	"IWebBrowser2":                                       "D30C1661-CDAF-11D0-8A3E-00C04FC9E26E",
+	"IWebBrowserApp":                                     "0002DF05-0000-0000-C000-000000000046",
+	"IWebWizardExtension":                                "0E6B3F66-98D1-48C0-A222-FBDE74E2FBC5",
+	"IWebWizardHost":                                     "18BCC359-4990-4BFB-B951-3C83702BE5F9",
+	"IWebWizardHost2":                                    "F9C013DC-3C23-4041-8E39-CFB402F7EA59",
+	"IWindowProvider":                                    "987DF77B-DB06-4D77-8F8A-86A9C3BB90B9",
+	"IWindowsLockModeHelper":                             "F342D19E-CC22-4648-BB5D-03CCF75B47C5",
+	"IWizardExtension":                                   "C02EA696-86CC-491E-9B23-74394A0444A8",
+	"IWizardSite":                                        "88960F5B-422F-4E7B-8013-73415381C3C3",
+	"IZoomEvents":                                        "41B68150-904C-4E17-A0BA-A438182E359D",
+	"IndexedResourceQualifier":                           "",
+	"JAVA_TRUST":                                         "",
+	"JIT_DEBUG_INFO":                                     "",
+	"JOBOBJECT_IO_RATE_CONTROL_INFORMATION_NATIVE_V1":    "",
+	"JS_NATIVE_FRAME":                                    "",
+	"JsDebugPropertyInfo":                                "",
+	"KBDLLHOOKSTRUCT":                                    "",
+	"KBDNLSTABLES":                                       "",
+	"KBDTABLES":                                          "",
+	"KBDTABLE_DESC":                                      "",
+	"KBDTABLE_MULTI":                                     "",
+	"KBD_TYPE_INFO":                                      "",
+	"KCRM_MARSHAL_HEADER":                                "",
+	"KCRM_PROTOCOL_BLOB":                                 "",
+	"KCRM_TRANSACTION_BLOB":                              "",
+	"KDDEBUGGER_DATA32":                                  "",
+	"KDDEBUGGER_DATA64":                                  "",
+	"KDHELP64":                                           "",
+	"KERNEL_CET_CONTEXT":                                 "",
+	"KERNEL_CET_CONTEXT_Anonymous":                       "",
+	"KERNEL_CET_CONTEXT_Anonymous_Anonymous":             "",
+	"KERNINGPAIR":                                        "",
+	"KEYBDINPUT":                                         "",
+	"KEY_EVENT_RECORD":                                   "",
+	"KEY_EVENT_RECORD_UChar":                             "",
+	"KEY_VALUE_ENTRY":                                    "",
+	"KNONVOLATILE_CONTEXT_POINTERS":                      "",
+	"KNONVOLATILE_CONTEXT_POINTERS_Anonymous1":           "",
+	"KNONVOLATILE_CONTEXT_POINTERS_Anonymous1_Anonymous": "",
+	"KNONVOLATILE_CONTEXT_POINTERS_Anonymous2":           "",
+	"KNONVOLATILE_CONTEXT_POINTERS_Anonymous2_Anonymous": "",
+	"KNOWNFOLDER_DEFINITION":                             "",
+	"KTMOBJECT_CURSOR":                                   "",
+	"LARGE_INTEGER":                                      "",
+	"LARGE_INTEGER_Anonymous":                            "",
+	"LARGE_INTEGER_U":                                    "",
+	"LASTINPUTINFO":                                      "",
+	"LDR_DATA_TABLE_ENTRY":                               "",
+	"LDR_DATA_TABLE_ENTRY_Anonymous":                     "",
+	"LDT_ENTRY":                                          "",
+	"LDT_ENTRY_HighWord":                                 "",
+	"LDT_ENTRY_HighWord_Bits":                            "",
+	"LDT_ENTRY_HighWord_Bytes":                           "",
+	"LHITTESTINFO":                                       "",
+	"LICINFO":                                            "",
+	"LIGATURE1":                                          "",
+	"LIGATURE2":                                          "",
+	"LIGATURE3":                                          "",
+	"LIGATURE4":                                          "",
+	"LIGATURE5":                                          "",
+	"LIST_ENTRY":                                         "",
+	"LIST_ENTRY32":                                       "",
+	"LIST_ENTRY64":                                       "",
+	"LITEM":                                              "",
+	"LLFILETIME":                                         "",
+	"LLFILETIME_Anonymous":                               "",
+	"LOADED_IMAGE":                                       "",
+	"LOAD_DLL_DEBUG_INFO":                                "",
+	"LOCALESIGNATURE":                                    "",
+	"LOGBRUSH":                                           "",
+	"LOGBRUSH32":                                         "",
+	"LOGFONTA":                                           "",
+	"LOGFONTW":                                           "",
+	"LOGPALETTE":                                         "",
+	"LOGPEN":                                             "",
+	"LOG_MANAGEMENT_CALLBACKS":                           "",
+	"LUID":                                               "",
+	"LUID_AND_ATTRIBUTES":                                "",
+	"LVBKIMAGEA":                                         "",
+	"LVBKIMAGEW":                                         "",
+	"LVCOLUMNA":                                          "",
+	"LVCOLUMNW":                                          "",
+	"LVFINDINFOA":                                        "",
+	"LVFINDINFOW":                                        "",
+	"LVFOOTERINFO":                                       "",
+	"LVFOOTERITEM":                                       "",
+	"LVGROUP":                                            "",
+	"LVGROUPMETRICS":                                     "",
+	"LVHITTESTINFO":                                      "",
+	"LVINSERTGROUPSORTED":                                "",
+	"LVINSERTMARK":                                       "",
+	"LVITEMA":                                            "",
+	"LVITEMINDEX":                                        "",
+	"LVITEMW":                                            "",
+	"LVSETINFOTIP":                                       "",
+	"LVTILEINFO":                                         "",
+	"LVTILEVIEWINFO":                                     "",
+	"Location":                                           "",
+	"M128A":                                              "",
+	"MACHINE_POWER_POLICY":                               "",
+	"MACHINE_PROCESSOR_POWER_POLICY":                     "",
+	"MAPPING_DATA_RANGE":                                 "",
+	"MAPPING_ENUM_OPTIONS":                               "",
+	"MAPPING_OPTIONS":                                    "",
+	"MAPPING_PROPERTY_BAG":                               "",
+	"MAPPING_SERVICE_INFO":                               "",
+	"MARGINS":                                            "",
+	"MAT2":                                               "",
+	"MAXVERSIONTESTED_INFO":                              "",
+	"MCGRIDINFO":                                         "",
+	"MCHITTESTINFO":                                      "",
+	"MDICREATESTRUCTA":                                   "",
+	"MDICREATESTRUCTW":                                   "",
+	"MDINEXTMENU":                                        "",
+	"MEASUREITEMSTRUCT":                                  "",
+	"MEMORYSTATUS":                                       "",
+	"MEMORYSTATUSEX":                                     "",
+	"MEMORY_BASIC_INFORMATION":                           "",
+	"MEMORY_BASIC_INFORMATION32":                         "",
+	"MEMORY_BASIC_INFORMATION64":                         "",
+	"MEMORY_PARTITION_DEDICATED_MEMORY_ATTRIBUTE":        "",
+	"MEMORY_PARTITION_DEDICATED_MEMORY_INFORMATION":      "",
+	"MEMORY_PRIORITY_INFORMATION":                        "",
+	"MEM_ADDRESS_REQUIREMENTS":                           "",
+	"MEM_EXTENDED_PARAMETER":                             "",
+	"MEM_EXTENDED_PARAMETER_Anonymous1":                  "",
+	"MEM_EXTENDED_PARAMETER_Anonymous2":                  "",
+	"MENUBARINFO":                                        "",
+	"MENUGETOBJECTINFO":                                  "",
+	"MENUINFO":                                           "",
+	"MENUITEMINFOA":                                      "",
+	"MENUITEMINFOW":                                      "",
+	"MENUITEMTEMPLATE":                                   "",
+	"MENUITEMTEMPLATEHEADER":                             "",
+	"MENU_EVENT_RECORD":                                  "",
+	"MESSAGE_RESOURCE_BLOCK":                             "",
+	"MESSAGE_RESOURCE_DATA":                              "",
+	"MESSAGE_RESOURCE_ENTRY":                             "",
+	"METAFILEPICT":                                       "",
+	"METAHEADER":                                         "",
+	"METARECORD":                                         "",
+	"METHODDATA":                                         "",
+	"MIMECPINFO":                                         "",
+	"MIMECSETINFO":                                       "",
+	"MINIDUMP_CALLBACK_INFORMATION":                      "",
+	"MINIDUMP_CALLBACK_INPUT":                            "",
+	"MINIDUMP_CALLBACK_INPUT_Anonymous":                  "",
+	"MINIDUMP_CALLBACK_OUTPUT":                           "",
+	"MINIDUMP_CALLBACK_OUTPUT_Anonymous":                 "",
+	"MINIDUMP_CALLBACK_OUTPUT_Anonymous_Anonymous1":      "",
+	"MINIDUMP_CALLBACK_OUTPUT_Anonymous_Anonymous2":      "",
+	"MINIDUMP_CALLBACK_OUTPUT_Anonymous_Anonymous3":      "",
+	"MINIDUMP_CALLBACK_OUTPUT_Anonymous_Anonymous4":      "",
+	"MINIDUMP_CALLBACK_OUTPUT_Anonymous_Anonymous5":      "",
+	"MINIDUMP_DIRECTORY":                                 "",
+	"MINIDUMP_EXCEPTION":                                 "",
+	"MINIDUMP_EXCEPTION_INFORMATION":                     "",
+	"MINIDUMP_EXCEPTION_INFORMATION64":                   "",
+	"MINIDUMP_EXCEPTION_STREAM":                          "",
+	"MINIDUMP_FUNCTION_TABLE_DESCRIPTOR":                 "",
+	"MINIDUMP_FUNCTION_TABLE_STREAM":                     "",
+	"MINIDUMP_HANDLE_DATA_STREAM":                        "",
+	"MINIDUMP_HANDLE_DESCRIPTOR":                         "",
+	"MINIDUMP_HANDLE_DESCRIPTOR_2":                       "",
+	"MINIDUMP_HANDLE_OBJECT_INFORMATION":                 "",
+	"MINIDUMP_HANDLE_OPERATION_LIST":                     "",
+	"MINIDUMP_HEADER":                                    "",
+	"MINIDUMP_HEADER_Anonymous":                          "",
+	"MINIDUMP_INCLUDE_MODULE_CALLBACK":                   "",
+	"MINIDUMP_INCLUDE_THREAD_CALLBACK":                   "",
+	"MINIDUMP_IO_CALLBACK":                               "",
+	"MINIDUMP_LOCATION_DESCRIPTOR":                       "",
+	"MINIDUMP_LOCATION_DESCRIPTOR64":                     "",
+	"MINIDUMP_MEMORY64_LIST":                             "",
+	"MINIDUMP_MEMORY_DESCRIPTOR":                         "",
+	"MINIDUMP_MEMORY_DESCRIPTOR64":                       "",
+	"MINIDUMP_MEMORY_INFO":                               "",
+	"MINIDUMP_MEMORY_INFO_LIST":                          "",
+	"MINIDUMP_MEMORY_LIST":                               "",
+	"MINIDUMP_MISC_INFO":                                 "",
+	"MINIDUMP_MISC_INFO_2":                               "",
+	"MINIDUMP_MISC_INFO_3":                               "",
+	"MINIDUMP_MISC_INFO_4":                               "",
+	"MINIDUMP_MISC_INFO_5":                               "",
+	"MINIDUMP_MODULE":                                    "",
+	"MINIDUMP_MODULE_CALLBACK":                           "",
+	"MINIDUMP_MODULE_LIST":                               "",
+	"MINIDUMP_PROCESS_VM_COUNTERS_1":                     "",
+	"MINIDUMP_PROCESS_VM_COUNTERS_2":                     "",
+	"MINIDUMP_READ_MEMORY_FAILURE_CALLBACK":              "",
+	"MINIDUMP_STRING":                                    "",
+	"MINIDUMP_SYSTEM_BASIC_INFORMATION":                  "",
+	"MINIDUMP_SYSTEM_BASIC_PERFORMANCE_INFORMATION":      "",
+	"MINIDUMP_SYSTEM_FILECACHE_INFORMATION":              "",
+	"MINIDUMP_SYSTEM_INFO":                               "",
+	"MINIDUMP_SYSTEM_INFO_Anonymous1":                    "",
+	"MINIDUMP_SYSTEM_INFO_Anonymous1_Anonymous":          "",
+	"MINIDUMP_SYSTEM_INFO_Anonymous2":                    "",
+	"MINIDUMP_SYSTEM_INFO_Anonymous2_Anonymous":          "",
+	"MINIDUMP_SYSTEM_MEMORY_INFO_1":                      "",
+	"MINIDUMP_SYSTEM_PERFORMANCE_INFORMATION":            "",
+	"MINIDUMP_THREAD":                                    "",
+	"MINIDUMP_THREAD_CALLBACK":                           "",
+	"MINIDUMP_THREAD_EX":                                 "",
+	"MINIDUMP_THREAD_EX_CALLBACK":                        "",
+	"MINIDUMP_THREAD_EX_LIST":                            "",
+	"MINIDUMP_THREAD_INFO":                               "",
+	"MINIDUMP_THREAD_INFO_LIST":                          "",
+	"MINIDUMP_THREAD_LIST":                               "",
+	"MINIDUMP_THREAD_NAME":                               "",
+	"MINIDUMP_THREAD_NAME_LIST":                          "",
+	"MINIDUMP_TOKEN_INFO_HEADER":                         "",
+	"MINIDUMP_TOKEN_INFO_LIST":                           "",
+	"MINIDUMP_UNLOADED_MODULE":                           "",
+	"MINIDUMP_UNLOADED_MODULE_LIST":                      "",
+	"MINIDUMP_USER_RECORD":                               "",
+	"MINIDUMP_USER_STREAM":                               "",
+	"MINIDUMP_USER_STREAM_INFORMATION":                   "",
+	"MINIDUMP_VM_POST_READ_CALLBACK":                     "",
+	"MINIDUMP_VM_PRE_READ_CALLBACK":                      "",
+	"MINIDUMP_VM_QUERY_CALLBACK":                         "",
+	"MINIMIZEDMETRICS":                                   "",
+	"MINMAXINFO":                                         "",
+	"MODIFIERS":                                          "",
+	"MODLOAD_CVMISC":                                     "",
+	"MODLOAD_DATA":                                       "",
+	"MODLOAD_PDBGUID_PDBAGE":                             "",
+	"MODULEENTRY32":                                      "",
+	"MODULEENTRY32W":                                     "",
+	"MODULE_TYPE_INFO":                                   "",
+	"MONCBSTRUCT":                                        "",
+	"MONCONVSTRUCT":                                      "",
+	"MONERRSTRUCT":                                       "",
+	"MONHSZSTRUCTA":                                      "",
+	"MONHSZSTRUCTW":                                      "",
+	"MONITORINFO":                                        "",
+	"MONITORINFOEXA":                                     "",
+	"MONITORINFOEXW":                                     "",
+	"MONLINKSTRUCT":                                      "",
+	"MONMSGSTRUCT":                                       "",
+	"MOUSEHOOKSTRUCT":                                    "",
+	"MOUSEHOOKSTRUCTEX":                                  "",
+	"MOUSEINPUT":                                         "",
+	"MOUSEKEYS":                                          "",
+	"MOUSEMOVEPOINT":                                     "",
+	"MOUSE_EVENT_RECORD":                                 "",
+	"MSAAMENUINFO":                                       "",
+	"MSG":                                                "",
+	"MSGBOXPARAMSA":                                      "",
+	"MSGBOXPARAMSW":                                      "",
+	"MSGFILTER":                                          "",
+	"MSLLHOOKSTRUCT":                                     "",
+	"MULTIKEYHELPA":                                      "",
+	"MULTIKEYHELPW":                                      "",
+	"MULTI_QI":                                           "",
+	"MachineGlobalObjectTableRegistrationToken__":        "",
+	"MediaLabelInfo":                                     "",
+	"MrmResourceIndexerHandle":                           "",
+	"MrmResourceIndexerMessage":                          "",
+	"NAME_CACHE_CONTEXT":                                 "",
+	"NCCALCSIZE_PARAMS":                                  "",
+	"NC_ADDRESS":                                         "",
+	"NETWORK_APP_INSTANCE_EA":                            "",
+	"NEWCPLINFOA":                                        "",
+	"NEWCPLINFOW":                                        "",
+	"NEWTEXTMETRICA":                                     "",
+	"NEWTEXTMETRICEXA":                                   "",
+	"NEWTEXTMETRICEXW":                                   "",
+	"NEWTEXTMETRICW":                                     "",
+	"NLSVERSIONINFO":                                     "",
+	"NLSVERSIONINFOEX":                                   "",
+	"NMBCDROPDOWN":                                       "",
+	"NMBCHOTITEM":                                        "",
+	"NMCBEDRAGBEGINA":                                    "",
+	"NMCBEDRAGBEGINW":                                    "",
+	"NMCBEENDEDITA":                                      "",
+	"NMCBEENDEDITW":                                      "",
+	"NMCHAR":                                             "",
+	"NMCOMBOBOXEXA":                                      "",
+	"NMCOMBOBOXEXW":                                      "",
+	"NMCUSTOMDRAW":                                       "",
+	"NMCUSTOMSPLITRECTINFO":                              "",
+	"NMCUSTOMTEXT":                                       "",
+	"NMDATETIMECHANGE":                                   "",
+	"NMDATETIMEFORMATA":                                  "",
+	"NMDATETIMEFORMATQUERYA":                             "",
+	"NMDATETIMEFORMATQUERYW":                             "",
+	"NMDATETIMEFORMATW":                                  "",
+	"NMDATETIMESTRINGA":                                  "",
+	"NMDATETIMESTRINGW":                                  "",
+	"NMDATETIMEWMKEYDOWNA":                               "",
+	"NMDATETIMEWMKEYDOWNW":                               "",
+	"NMDAYSTATE":                                         "",
+	"NMHDDISPINFOA":                                      "",
+	"NMHDDISPINFOW":                                      "",
+	"NMHDFILTERBTNCLICK":                                 "",
+	"NMHDR":                                              "",
+	"NMHEADERA":                                          "",
+	"NMHEADERW":                                          "",
+	"NMIPADDRESS":                                        "",
+	"NMITEMACTIVATE":                                     "",
+	"NMKEY":                                              "",
+	"NMLINK":                                             "",
+	"NMLISTVIEW":                                         "",
+	"NMLVCACHEHINT":                                      "",
+	"NMLVCUSTOMDRAW":                                     "",
+	"NMLVDISPINFOA":                                      "",
+	"NMLVDISPINFOW":                                      "",
+	"NMLVEMPTYMARKUP":                                    "",
+	"NMLVFINDITEMA":                                      "",
+	"NMLVFINDITEMW":                                      "",
+	"NMLVGETINFOTIPA":                                    "",
+	"NMLVGETINFOTIPW":                                    "",
+	"NMLVKEYDOWN":                                        "",
+	"NMLVLINK":                                           "",
+	"NMLVODSTATECHANGE":                                  "",
+	"NMLVSCROLL":                                         "",
+	"NMMOUSE":                                            "",
+	"NMOBJECTNOTIFY":                                     "",
+	"NMPGCALCSIZE":                                       "",
+	"NMPGHOTITEM":                                        "",
+	"NMPGSCROLL":                                         "",
+	"NMRBAUTOSIZE":                                       "",
+	"NMREBAR":                                            "",
+	"NMREBARAUTOBREAK":                                   "",
+	"NMREBARCHEVRON":                                     "",
+	"NMREBARCHILDSIZE":                                   "",
+	"NMREBARSPLITTER":                                    "",
+	"NMSEARCHWEB":                                        "",
+	"NMSELCHANGE":                                        "",
+	"NMTBCUSTOMDRAW":                                     "",
+	"NMTBDISPINFOA":                                      "",
+	"NMTBDISPINFOW":                                      "",
+	"NMTBGETINFOTIPA":                                    "",
+	"NMTBGETINFOTIPW":                                    "",
+	"NMTBHOTITEM":                                        "",
+	"NMTBRESTORE":                                        "",
+	"NMTBSAVE":                                           "",
+	"NMTCKEYDOWN":                                        "",
+	"NMTOOLBARA":                                         "",
+	"NMTOOLBARW":                                         "",
+	"NMTOOLTIPSCREATED":                                  "",
+	"NMTRBTHUMBPOSCHANGING":                              "",
+	"NMTREEVIEWA":                                        "",
+	"NMTREEVIEWW":                                        "",
+	"NMTTCUSTOMDRAW":                                     "",
+	"NMTTDISPINFOA":                                      "",
+	"NMTTDISPINFOW":                                      "",
+	"NMTVASYNCDRAW":                                      "",
+	"NMTVCUSTOMDRAW":                                     "",
+	"NMTVDISPINFOA":                                      "",
+	"NMTVDISPINFOEXA":                                    "",
+	"NMTVDISPINFOEXW":                                    "",
+	"NMTVDISPINFOW":                                      "",
+	"NMTVGETINFOTIPA":                                    "",
+	"NMTVGETINFOTIPW":                                    "",
+	"NMTVITEMCHANGE":                                     "",
+	"NMTVKEYDOWN":                                        "",
+	"NMTVSTATEIMAGECHANGING":                             "",
+	"NMUPDOWN":                                           "",
+	"NMVIEWCHANGE":                                       "",
+	"NONCLIENTMETRICSA":                                  "",
+	"NONCLIENTMETRICSW":                                  "",
+	"NON_PAGED_DEBUG_INFO":                               "",
+	"NOTIFYICONDATAA":                                    "",
+	"NOTIFYICONDATAA_Anonymous":                          "",
+	"NOTIFYICONDATAW":                                    "",
+	"NOTIFYICONDATAW_Anonymous":                          "",
+	"NOTIFYICONIDENTIFIER":                               "",
+	"NOTIFY_USER_POWER_SETTING":                          "",
+	"NRESARRAY":                                          "",
+	"NSTCCUSTOMDRAW":                                     "",
+	"NTMS_ALLOCATION_INFORMATION":                        "",
+	"NTMS_ASYNC_IO":                                      "",
+	"NTMS_CHANGERINFORMATIONA":                           "",
+	"NTMS_CHANGERINFORMATIONW":                           "",
+	"NTMS_CHANGERTYPEINFORMATIONA":                       "",
+	"NTMS_CHANGERTYPEINFORMATIONW":                       "",
+	"NTMS_COMPUTERINFORMATION":                           "",
+	"NTMS_DRIVEINFORMATIONA":                             "",
+	"NTMS_DRIVEINFORMATIONW":                             "",
+	"NTMS_DRIVETYPEINFORMATIONA":                         "",
+	"NTMS_DRIVETYPEINFORMATIONW":                         "",
+	"NTMS_FILESYSTEM_INFO":                               "",
+	"NTMS_I1_LIBRARYINFORMATION":                         "",
+	"NTMS_I1_LIBREQUESTINFORMATIONA":                     "",
+	"NTMS_I1_LIBREQUESTINFORMATIONW":                     "",
+	"NTMS_I1_OBJECTINFORMATIONA":                         "",
+	"NTMS_I1_OBJECTINFORMATIONA_Info":                    "",
+	"NTMS_I1_OBJECTINFORMATIONW":                         "",
+	"NTMS_I1_OBJECTINFORMATIONW_Info":                    "",
+	"NTMS_I1_OPREQUESTINFORMATIONA":                      "",
+	"NTMS_I1_OPREQUESTINFORMATIONW":                      "",
+	"NTMS_I1_PARTITIONINFORMATIONA":                      "",
+	"NTMS_I1_PARTITIONINFORMATIONW":                      "",
+	"NTMS_I1_PMIDINFORMATIONA":                           "",
+	"NTMS_I1_PMIDINFORMATIONW":                           "",
+	"NTMS_IEDOORINFORMATION":                             "",
+	"NTMS_IEPORTINFORMATION":                             "",
+	"NTMS_LIBRARYINFORMATION":                            "",
+	"NTMS_LIBREQUESTINFORMATIONA":                        "",
+	"NTMS_LIBREQUESTINFORMATIONW":                        "",
+	"NTMS_LMIDINFORMATION":                               "",
+	"NTMS_MEDIAPOOLINFORMATION":                          "",
+	"NTMS_MEDIATYPEINFORMATION":                          "",
+	"NTMS_MOUNT_INFORMATION":                             "",
+	"NTMS_NOTIFICATIONINFORMATION":                       "",
+	"NTMS_OBJECTINFORMATIONA":                            "",
+	"NTMS_OBJECTINFORMATIONA_Info":                       "",
+	"NTMS_OBJECTINFORMATIONW":                            "",
+	"NTMS_OBJECTINFORMATIONW_Info":                       "",
+	"NTMS_OPREQUESTINFORMATIONA":                         "",
+	"NTMS_OPREQUESTINFORMATIONW":                         "",
+	"NTMS_PARTITIONINFORMATIONA":                         "",
+	"NTMS_PARTITIONINFORMATIONW":                         "",
+	"NTMS_PMIDINFORMATIONA":                              "",
+	"NTMS_PMIDINFORMATIONW":                              "",
+	"NTMS_STORAGESLOTINFORMATION":                        "",
+	"NT_CONSOLE_PROPS":                                   "",
+	"NT_FE_CONSOLE_PROPS":                                "",
+	"NT_TIB":                                             "",
+	"NT_TIB32":                                           "",
+	"NT_TIB32_Anonymous":                                 "",
+	"NT_TIB64":                                           "",
+	"NT_TIB64_Anonymous":                                 "",
+	"NT_TIB_Anonymous":                                   "",
+	"NUMA_NODE_RELATIONSHIP":                             "",
+	"NUMA_NODE_RELATIONSHIP_Anonymous":                   "",
+	"NUMBERFMTA":                                         "",
+	"NUMBERFMTW":                                         "",
+	"NUMPARSE":                                           "",
+	"OBJECTDESCRIPTOR":                                   "",
+	"OBJECTID":                                           "",
+	"OBJECTPOSITIONS":                                    "",
+	"OBJECT_ATTRIBUTES":                                  "",
+	"OBJECT_ATTRIBUTES32":                                "",
+	"OBJECT_ATTRIBUTES64":                                "",
+	"OBJECT_TYPE_LIST":                                   "",
+	"OCPFIPARAMS":                                        "",
+	"OFNOTIFYA":                                          "",
+	"OFNOTIFYEXA":                                        "",
+	"OFNOTIFYEXW":                                        "",
+	"OFNOTIFYW":                                          "",
+	"OFSTRUCT":                                           "",
+	"OLECMD":                                             "",
+	"OLECMDTEXT":                                         "",
+	"OLEINPLACEFRAMEINFO":                                "",
+	"OLEMENUGROUPWIDTHS":                                 "",
+	"OLESTREAM":                                          "",
+	"OLESTREAMVTBL":                                      "",
+	"OLEUIBUSYA":                                         "",
+	"OLEUIBUSYW":                                         "",
+	"OLEUICHANGEICONA":                                   "",
+	"OLEUICHANGEICONW":                                   "",
+	"OLEUICHANGESOURCEA":                                 "",
+	"OLEUICHANGESOURCEW":                                 "",
+	"OLEUICONVERTA":                                      "",
+	"OLEUICONVERTW":                                      "",
+	"OLEUIEDITLINKSA":                                    "",
+	"OLEUIEDITLINKSW":                                    "",
+	"OLEUIGNRLPROPSA":                                    "",
+	"OLEUIGNRLPROPSW":                                    "",
+	"OLEUIINSERTOBJECTA":                                 "",
+	"OLEUIINSERTOBJECTW":                                 "",
+	"OLEUILINKPROPSA":                                    "",
+	"OLEUILINKPROPSW":                                    "",
+	"OLEUIOBJECTPROPSA":                                  "",
+	"OLEUIOBJECTPROPSW":                                  "",
+	"OLEUIPASTEENTRYA":                                   "",
+	"OLEUIPASTEENTRYW":                                   "",
+	"OLEUIPASTESPECIALA":                                 "",
+	"OLEUIPASTESPECIALW":                                 "",
+	"OLEUIVIEWPROPSA":                                    "",
+	"OLEUIVIEWPROPSW":                                    "",
+	"OLEVERB":                                            "",
+	"OMAP":                                               "",
+	"OPENASINFO":                                         "",
+	"OPENFILENAMEA":                                      "",
+	"OPENFILENAMEW":                                      "",
+	"OPENFILENAME_NT4A":                                  "",
+	"OPENFILENAME_NT4W":                                  "",
+	"OPENTYPE_FEATURE_RECORD":                            "",
+	"OPEN_PRINTER_PROPS_INFOA":                           "",
+	"OPEN_PRINTER_PROPS_INFOW":                           "",
+	"OSVERSIONINFOA":                                     "",
+	"OSVERSIONINFOEXA":                                   "",
+	"OSVERSIONINFOEXW":                                   "",
+	"OSVERSIONINFOW":                                     "",
+	"OUTLINETEXTMETRICA":                                 "",
+	"OUTLINETEXTMETRICW":                                 "",
+	"OUTPUT_DEBUG_STRING_INFO":                           "",
+	"OVERLAPPED":                                         "",
+	"OVERLAPPED_Anonymous":                               "",
+	"OVERLAPPED_Anonymous_Anonymous":                     "",
+	"OVERLAPPED_ENTRY":                                   "",
+	"PACKEDEVENTINFO":                                    "",
+	"PAGERANGE":                                          "",
+	"PAGESET":                                            "",
+	"PAGESETUPDLGA":                                      "",
+	"PAGESETUPDLGW":                                      "",
+	"PAINTSTRUCT":                                        "",
+	"PALETTEENTRY":                                       "",
+	"PANOSE":                                             "",
+	"PARAFORMAT":                                         "",
+	"PARAFORMAT2":                                        "",
+	"PARAFORMAT_Anonymous":                               "",
+	"PARAMDATA":                                          "",
+	"PARAMDESC":                                          "",
+	"PARAMDESCEX":                                        "",
+	"PARSEDURLA":                                         "",
+	"PARSEDURLW":                                         "",
+	"PBRANGE":                                            "",
+	"PEB":                                                "",
+	"PEB_LDR_DATA":                                       "",
+	"PELARRAY":                                           "",
+	"PERSIST_FOLDER_TARGET_INFO":                         "",
+	"PERUSERSECTIONA":                                    "",
+	"PERUSERSECTIONW":                                    "",
+	"PHYSICAL":                                           "",
+	"PHYSICAL_MEMORY_DESCRIPTOR32":                       "",
+	"PHYSICAL_MEMORY_DESCRIPTOR64":                       "",
+	"PHYSICAL_MEMORY_RUN32":                              "",
+	"PHYSICAL_MEMORY_RUN64":                              "",
+	"PHYSICAL_TO_VIRTUAL":                                "",
+	"PHYSICAL_WITH_FLAGS":                                "",
+	"PICTDESC":                                           "",
+	"PICTDESC_Anonymous":                                 "",
+	"PICTDESC_Anonymous_Bmp":                             "",
+	"PICTDESC_Anonymous_Emf":                             "",
+	"PICTDESC_Anonymous_Icon":                            "",
+	"PICTDESC_Anonymous_Wmf":                             "",
+	"POINT":                                              "",
+	"POINTER_DEVICE_CURSOR_INFO":                         "",
+	"POINTER_DEVICE_INFO":                                "",
+	"POINTER_DEVICE_PROPERTY":                            "",
+	"POINTER_PEN_INFO":                                   "",
+	"POINTER_SEARCH_PHYSICAL":                            "",
+	"POINTER_TOUCH_INFO":                                 "",
+	"POINTER_TYPE_INFO":                                  "",
+	"POINTER_TYPE_INFO_Anonymous":                        "",
+	"POINTF":                                             "",
+	"POINTFX":                                            "",
+	"POINTL":                                             "",
+	"POINTS":                                             "",
+	"POLYTEXTA":                                          "",
+	"POLYTEXTW":                                          "",
+	"POWERBROADCAST_SETTING":                             "",
+	"POWER_ACTION_POLICY":                                "",
+	"POWER_IDLE_RESILIENCY":                              "",
+	"POWER_MONITOR_INVOCATION":                           "",
+	"POWER_PLATFORM_INFORMATION":                         "",
+	"POWER_POLICY":                                       "",
+	"POWER_SESSION_ALLOW_EXTERNAL_DMA_DEVICES":           "",
+	"POWER_SESSION_CONNECT":                              "",
+	"POWER_SESSION_RIT_STATE":                            "",
+	"POWER_SESSION_TIMEOUTS":                             "",
+	"POWER_SESSION_WINLOGON":                             "",
+	"POWER_USER_PRESENCE":                                "",
+	"PPM_IDLESTATE_EVENT":                                "",
+	"PPM_IDLE_ACCOUNTING":                                "",
+	"PPM_IDLE_ACCOUNTING_EX":                             "",
+	"PPM_IDLE_STATE_ACCOUNTING":                          "",
+	"PPM_IDLE_STATE_ACCOUNTING_EX":                       "",
+	"PPM_IDLE_STATE_BUCKET_EX":                           "",
+	"PPM_PERFSTATE_DOMAIN_EVENT":                         "",
+	"PPM_PERFSTATE_EVENT":                                "",
+	"PPM_THERMALCHANGE_EVENT":                            "",
+	"PPM_THERMAL_POLICY_EVENT":                           "",
+	"PPM_WMI_IDLE_STATE":                                 "",
+	"PPM_WMI_IDLE_STATES":                                "",
+	"PPM_WMI_IDLE_STATES_EX":                             "",
+	"PPM_WMI_LEGACY_PERFSTATE":                           "",
+	"PPM_WMI_PERF_STATE":                                 "",
+	"PPM_WMI_PERF_STATES":                                "",
+	"PPM_WMI_PERF_STATES_EX":                             "",
+	"PREVIEWHANDLERFRAMEINFO":                            "",
+	"PRINTDLGA":                                          "",
+	"PRINTDLGEXA":                                        "",
+	"PRINTDLGEXW":                                        "",
+	"PRINTDLGW":                                          "",
+	"PRINTPAGERANGE":                                     "",
+	"PRIVILEGE_SET":                                      "",
+	"PROCESSENTRY32":                                     "",
+	"PROCESSENTRY32W":                                    "",
+	"PROCESSORINFO":                                      "",
+	"PROCESSOR_GROUP_INFO":                               "",
+	"PROCESSOR_IDLESTATE_INFO":                           "",
+	"PROCESSOR_IDLESTATE_POLICY":                         "",
+	"PROCESSOR_IDLESTATE_POLICY_Flags":                   "",
+	"PROCESSOR_IDLESTATE_POLICY_Flags_Anonymous":         "",
+	"PROCESSOR_NUMBER":                                   "",
+	"PROCESSOR_OBJECT_INFO":                              "",
+	"PROCESSOR_OBJECT_INFO_EX":                           "",
+	"PROCESSOR_PERFSTATE_POLICY":                         "",
+	"PROCESSOR_PERFSTATE_POLICY_Anonymous":               "",
+	"PROCESSOR_PERFSTATE_POLICY_Anonymous_Flags":         "",
+	"PROCESSOR_PERFSTATE_POLICY_Anonymous_Flags_Anonymous":                  "",
+	"PROCESSOR_POWER_INFORMATION":                                           "",
+	"PROCESSOR_POWER_POLICY":                                                "",
+	"PROCESSOR_POWER_POLICY_INFO":                                           "",
+	"PROCESSOR_RELATIONSHIP":                                                "",
+	"PROCESS_BASIC_INFORMATION":                                             "",
+	"PROCESS_DYNAMIC_EH_CONTINUATION_TARGET":                                "",
+	"PROCESS_DYNAMIC_EH_CONTINUATION_TARGETS_INFORMATION":                   "",
+	"PROCESS_DYNAMIC_ENFORCED_ADDRESS_RANGE":                                "",
+	"PROCESS_DYNAMIC_ENFORCED_ADDRESS_RANGES_INFORMATION":                   "",
+	"PROCESS_HEAP_ENTRY":                                                    "",
+	"PROCESS_HEAP_ENTRY_Anonymous":                                          "",
+	"PROCESS_HEAP_ENTRY_Anonymous_Block":                                    "",
+	"PROCESS_HEAP_ENTRY_Anonymous_Region":                                   "",
+	"PROCESS_INFORMATION":                                                   "",
+	"PROCESS_LEAP_SECOND_INFO":                                              "",
+	"PROCESS_MACHINE_INFORMATION":                                           "",
+	"PROCESS_MEMORY_EXHAUSTION_INFO":                                        "",
+	"PROCESS_MITIGATION_ASLR_POLICY":                                        "",
+	"PROCESS_MITIGATION_ASLR_POLICY_Anonymous":                              "",
+	"PROCESS_MITIGATION_ASLR_POLICY_Anonymous_Anonymous":                    "",
+	"PROCESS_MITIGATION_BINARY_SIGNATURE_POLICY":                            "",
+	"PROCESS_MITIGATION_BINARY_SIGNATURE_POLICY_Anonymous":                  "",
+	"PROCESS_MITIGATION_BINARY_SIGNATURE_POLICY_Anonymous_Anonymous":        "",
+	"PROCESS_MITIGATION_CHILD_PROCESS_POLICY":                               "",
+	"PROCESS_MITIGATION_CHILD_PROCESS_POLICY_Anonymous":                     "",
+	"PROCESS_MITIGATION_CHILD_PROCESS_POLICY_Anonymous_Anonymous":           "",
+	"PROCESS_MITIGATION_CONTROL_FLOW_GUARD_POLICY":                          "",
+	"PROCESS_MITIGATION_CONTROL_FLOW_GUARD_POLICY_Anonymous":                "",
+	"PROCESS_MITIGATION_CONTROL_FLOW_GUARD_POLICY_Anonymous_Anonymous":      "",
+	"PROCESS_MITIGATION_DEP_POLICY":                                         "",
+	"PROCESS_MITIGATION_DEP_POLICY_Anonymous":                               "",
+	"PROCESS_MITIGATION_DEP_POLICY_Anonymous_Anonymous":                     "",
+	"PROCESS_MITIGATION_DYNAMIC_CODE_POLICY":                                "",
+	"PROCESS_MITIGATION_DYNAMIC_CODE_POLICY_Anonymous":                      "",
+	"PROCESS_MITIGATION_DYNAMIC_CODE_POLICY_Anonymous_Anonymous":            "",
+	"PROCESS_MITIGATION_EXTENSION_POINT_DISABLE_POLICY":                     "",
+	"PROCESS_MITIGATION_EXTENSION_POINT_DISABLE_POLICY_Anonymous":           "",
+	"PROCESS_MITIGATION_EXTENSION_POINT_DISABLE_POLICY_Anonymous_Anonymous": "",
+	"PROCESS_MITIGATION_FONT_DISABLE_POLICY":                                "",
+	"PROCESS_MITIGATION_FONT_DISABLE_POLICY_Anonymous":                      "",
+	"PROCESS_MITIGATION_FONT_DISABLE_POLICY_Anonymous_Anonymous":            "",
+	"PROCESS_MITIGATION_IMAGE_LOAD_POLICY":                                  "",
+	"PROCESS_MITIGATION_IMAGE_LOAD_POLICY_Anonymous":                        "",
+	"PROCESS_MITIGATION_IMAGE_LOAD_POLICY_Anonymous_Anonymous":              "",
+	"PROCESS_MITIGATION_PAYLOAD_RESTRICTION_POLICY":                         "",
+	"PROCESS_MITIGATION_PAYLOAD_RESTRICTION_POLICY_Anonymous":               "",
+	"PROCESS_MITIGATION_PAYLOAD_RESTRICTION_POLICY_Anonymous_Anonymous":     "",
+	"PROCESS_MITIGATION_REDIRECTION_TRUST_POLICY":                           "",
+	"PROCESS_MITIGATION_REDIRECTION_TRUST_POLICY_Anonymous":                 "",
+	"PROCESS_MITIGATION_REDIRECTION_TRUST_POLICY_Anonymous_Anonymous":       "",
+	"PROCESS_MITIGATION_SIDE_CHANNEL_ISOLATION_POLICY":                      "",
+	"PROCESS_MITIGATION_SIDE_CHANNEL_ISOLATION_POLICY_Anonymous":            "",
+	"PROCESS_MITIGATION_SIDE_CHANNEL_ISOLATION_POLICY_Anonymous_Anonymous":  "",
+	"PROCESS_MITIGATION_STRICT_HANDLE_CHECK_POLICY":                         "",
+	"PROCESS_MITIGATION_STRICT_HANDLE_CHECK_POLICY_Anonymous":               "",
+	"PROCESS_MITIGATION_STRICT_HANDLE_CHECK_POLICY_Anonymous_Anonymous":     "",
+	"PROCESS_MITIGATION_SYSTEM_CALL_DISABLE_POLICY":                         "",
+	"PROCESS_MITIGATION_SYSTEM_CALL_DISABLE_POLICY_Anonymous":               "",
+	"PROCESS_MITIGATION_SYSTEM_CALL_DISABLE_POLICY_Anonymous_Anonymous":     "",
+	"PROCESS_MITIGATION_SYSTEM_CALL_FILTER_POLICY":                          "",
+	"PROCESS_MITIGATION_SYSTEM_CALL_FILTER_POLICY_Anonymous":                "",
+	"PROCESS_MITIGATION_SYSTEM_CALL_FILTER_POLICY_Anonymous_Anonymous":      "",
+	"PROCESS_MITIGATION_USER_SHADOW_STACK_POLICY":                           "",
+	"PROCESS_MITIGATION_USER_SHADOW_STACK_POLICY_Anonymous":                 "",
+	"PROCESS_MITIGATION_USER_SHADOW_STACK_POLICY_Anonymous_Anonymous":       "",
+	"PROCESS_NAME_ENTRY":                                                    "",
+	"PROCESS_POWER_THROTTLING_STATE":                                        "",
+	"PROCESS_PROTECTION_LEVEL_INFORMATION":                                  "",
+	"PROFILEINFOA":                                                          "",
+	"PROFILEINFOW":                                                          "",
+	"PROFILER_HEAP_OBJECT":                                                  "",
+	"PROFILER_HEAP_OBJECT_Anonymous":                                        "",
+	"PROFILER_HEAP_OBJECT_OPTIONAL_INFO":                                    "",
+	"PROFILER_HEAP_OBJECT_OPTIONAL_INFO_Anonymous":                          "",
+	"PROFILER_HEAP_OBJECT_RELATIONSHIP":                                     "",
+	"PROFILER_HEAP_OBJECT_RELATIONSHIP_Anonymous":                           "",
+	"PROFILER_HEAP_OBJECT_RELATIONSHIP_LIST":                                "",
+	"PROFILER_HEAP_OBJECT_SCOPE_LIST":                                       "",
+	"PROFILER_HEAP_SUMMARY":                                                 "",
+	"PROFILER_PROPERTY_TYPE_SUBSTRING_INFO":                                 "",
+	"PROPBAG2":                                                              "",
+	"PROPERTYKEY":                                                           "",
+	"PROPPAGEINFO":                                                          "",
+	"PROPPRG":                                                               "",
+	"PROPSHEETHEADERA_V1":                                                   "",
+	"PROPSHEETHEADERA_V1_Anonymous1":                                        "",
+	"PROPSHEETHEADERA_V1_Anonymous2":                                        "",
+	"PROPSHEETHEADERA_V1_Anonymous3":                                        "",
+	"PROPSHEETHEADERA_V2":                                                   "",
+	"PROPSHEETHEADERA_V2_Anonymous1":                                        "",
+	"PROPSHEETHEADERA_V2_Anonymous2":                                        "",
+	"PROPSHEETHEADERA_V2_Anonymous3":                                        "",
+	"PROPSHEETHEADERA_V2_Anonymous4":                                        "",
+	"PROPSHEETHEADERA_V2_Anonymous5":                                        "",
+	"PROPSHEETHEADERW_V1":                                                   "",
+	"PROPSHEETHEADERW_V1_Anonymous1":                                        "",
+	"PROPSHEETHEADERW_V1_Anonymous2":                                        "",
+	"PROPSHEETHEADERW_V1_Anonymous3":                                        "",
+	"PROPSHEETHEADERW_V2":                                                   "",
+	"PROPSHEETHEADERW_V2_Anonymous1":                                        "",
+	"PROPSHEETHEADERW_V2_Anonymous2":                                        "",
+	"PROPSHEETHEADERW_V2_Anonymous3":                                        "",
+	"PROPSHEETHEADERW_V2_Anonymous4":                                        "",
+	"PROPSHEETHEADERW_V2_Anonymous5":                                        "",
+	"PROPSHEETPAGEA":                                                        "",
+	"PROPSHEETPAGEA_Anonymous1":                                             "",
+	"PROPSHEETPAGEA_Anonymous2":                                             "",
+	"PROPSHEETPAGEA_Anonymous3":                                             "",
+	"PROPSHEETPAGEA_V1":                                                     "",
+	"PROPSHEETPAGEA_V1_Anonymous1":                                          "",
+	"PROPSHEETPAGEA_V1_Anonymous2":                                          "",
+	"PROPSHEETPAGEA_V2":                                                     "",
+	"PROPSHEETPAGEA_V2_Anonymous1":                                          "",
+	"PROPSHEETPAGEA_V2_Anonymous2":                                          "",
+	"PROPSHEETPAGEA_V3":                                                     "",
+	"PROPSHEETPAGEA_V3_Anonymous1":                                          "",
+	"PROPSHEETPAGEA_V3_Anonymous2":                                          "",
+	"PROPSHEETPAGEW":                                                        "",
+	"PROPSHEETPAGEW_Anonymous1":                                             "",
+	"PROPSHEETPAGEW_Anonymous2":                                             "",
+	"PROPSHEETPAGEW_Anonymous3":                                             "",
+	"PROPSHEETPAGEW_V1":                                                     "",
+	"PROPSHEETPAGEW_V1_Anonymous1":                                          "",
+	"PROPSHEETPAGEW_V1_Anonymous2":                                          "",
+	"PROPSHEETPAGEW_V2":                                                     "",
+	"PROPSHEETPAGEW_V2_Anonymous1":                                          "",
+	"PROPSHEETPAGEW_V2_Anonymous2":                                          "",
+	"PROPSHEETPAGEW_V3":                                                     "",
+	"PROPSHEETPAGEW_V3_Anonymous1":                                          "",
+	"PROPSHEETPAGEW_V3_Anonymous2":                                          "",
+	"PROPSPEC":                                                              "",
+	"PROPSPEC_Anonymous":                                                    "",
+	"PROPVARIANT":                                                           "",
+	"PROPVARIANT_Anonymous":                                                 "",
+	"PROPVARIANT_Anonymous_Anonymous":                                       "",
+	"PROPVARIANT_Anonymous_Anonymous_Anonymous":                             "",
+	"PSFEATURE_CUSTPAPER":                                                   "",
+	"PSFEATURE_OUTPUT":                                                      "",
+	"PSHNOTIFY":                                                             "",
+	"PSS_ALLOCATOR":                                                         "",
+	"PSS_AUXILIARY_PAGES_INFORMATION":                                       "",
+	"PSS_AUXILIARY_PAGE_ENTRY":                                              "",
+	"PSS_HANDLE_ENTRY":                                                      "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation":                              "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation_Event":                        "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation_Mutant":                       "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation_Process":                      "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation_Section":                      "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation_Semaphore":                    "",
+	"PSS_HANDLE_ENTRY_TypeSpecificInformation_Thread":                       "",
+	"PSS_HANDLE_INFORMATION":                                                "",
+	"PSS_HANDLE_TRACE_INFORMATION":                                          "",
+	"PSS_PERFORMANCE_COUNTERS":                                              "",
+	"PSS_PROCESS_INFORMATION":                                               "",
+	"PSS_THREAD_ENTRY":                                                      "",
+	"PSS_THREAD_INFORMATION":                                                "",
+	"PSS_VA_CLONE_INFORMATION":                                              "",
+	"PSS_VA_SPACE_ENTRY":                                                    "",
+	"PSS_VA_SPACE_INFORMATION":                                              "",
+	"PUBAPPINFO":                                                            "",
+	"PUBLIC_OBJECT_BASIC_INFORMATION":                                       "",
+	"PUBLIC_OBJECT_TYPE_INFORMATION":                                        "",
+	"PUNCTUATION":                                                           "",
+	"PVALUEA":                                                               "",
+	"PVALUEW":                                                               "",
+	"QACONTAINER":                                                           "",
+	"QACONTROL":                                                             "",
+	"QCMINFO":                                                               "",
+	"QCMINFO_IDMAP":                                                         "",
+	"QCMINFO_IDMAP_PLACEMENT":                                               "",
+	"QITAB":                                                                 "",
+	"QUAD":                                                                  "",
+	"QUAD_Anonymous":                                                        "",
+	"QUERYCONTEXT":                                                          "",
+	"QUERY_SERVICE_CONFIGA":                                                 "",
+	"QUERY_SERVICE_CONFIGW":                                                 "",
+	"QUERY_SERVICE_LOCK_STATUSA":                                            "",
+	"QUERY_SERVICE_LOCK_STATUSW":                                            "",
+	"QUOTA_LIMITS":                                                          "",
+	"QUOTA_LIMITS_EX":                                                       "",
+	"RASTERIZER_STATUS":                                                     "",
+	"RATE_QUOTA_LIMIT":                                                      "",
+	"RATE_QUOTA_LIMIT_Anonymous":                                            "",
+	"RAWHID":                                                                "",
+	"RAWINPUT":                                                              "",
+	"RAWINPUTDEVICE":                                                        "",
+	"RAWINPUTDEVICELIST":                                                    "",
+	"RAWINPUTHEADER":                                                        "",
+	"RAWINPUT_Data":                                                         "",
+	"RAWKEYBOARD":                                                           "",
+	"RAWMOUSE":                                                              "",
+	"RAWMOUSE_Anonymous":                                                    "",
+	"RAWMOUSE_Anonymous_Anonymous":                                          "",
+	"RBHITTESTINFO":                                                         "",
+	"READCONTROLSPACE":                                                      "",
+	"READCONTROLSPACE32":                                                    "",
+	"READCONTROLSPACE64":                                                    "",
+	"READ_WRITE_MSR":                                                        "",
+	"REARRANGE_FILE_DATA":                                                   "",
+	"REARRANGE_FILE_DATA32":                                                 "",
+	"REASON_CONTEXT":                                                        "",
+	"REASON_CONTEXT_Reason":                                                 "",
+	"REASON_CONTEXT_Reason_Detailed":                                        "",
+	"REBARBANDINFOA":                                                        "",
+	"REBARBANDINFOW":                                                        "",
+	"REBARINFO":                                                             "",
+	"RECT":                                                                  "",
+	"RECTL":                                                                 "",
+	"REDBOOK_DIGITAL_AUDIO_EXTRACTION_INFO":                                 "",
+	"REDIRECTION_DESCRIPTOR":                                                "",
+	"REDIRECTION_FUNCTION_DESCRIPTOR":                                       "",
+	"REG_PROVIDER":                                                          "",
+	"REOBJECT":                                                              "",
+	"REPARSE_GUID_DATA_BUFFER":                                              "",
+	"REPARSE_GUID_DATA_BUFFER_GenericReparseBuffer":                         "",
+	"REPASTESPECIAL":                                                        "",
+	"REQRESIZE":                                                             "",
+	"RESOURCEMANAGER_BASIC_INFORMATION":                                     "",
+	"RESOURCEMANAGER_COMPLETION_INFORMATION":                                "",
+	"RESUME_PERFORMANCE":                                                    "",
+	"RFC1766INFO":                                                           "",
+	"RGBQUAD":                                                               "",
+	"RGBTRIPLE":                                                             "",
+	"RGNDATA":                                                               "",
+	"RGNDATAHEADER":                                                         "",
+	"RICHEDIT_IMAGE_PARAMETERS":                                             "",
+	"RID_DEVICE_INFO":                                                       "",
+	"RID_DEVICE_INFO_Anonymous":                                             "",
+	"RID_DEVICE_INFO_HID":                                                   "",
+	"RID_DEVICE_INFO_KEYBOARD":                                              "",
+	"RID_DEVICE_INFO_MOUSE":                                                 "",
+	"RIP_INFO":                                                              "",
+	"RPCOLEMESSAGE":                                                         "",
+	"RTL_BALANCED_NODE":                                                     "",
+	"RTL_BALANCED_NODE_Anonymous1":                                          "",
+	"RTL_BALANCED_NODE_Anonymous1_Anonymous":                                "",
+	"RTL_BALANCED_NODE_Anonymous2":                                          "",
+	"RTL_BARRIER":                                                           "",
+	"RTL_CONDITION_VARIABLE":                                                "",
+	"RTL_CRITICAL_SECTION":                                                  "",
+	"RTL_CRITICAL_SECTION_DEBUG":                                            "",
+	"RTL_RUN_ONCE":                                                          "",
+	"RTL_SRWLOCK":                                                           "",
+	"RTL_USER_PROCESS_PARAMETERS":                                           "",
+	"RemHBITMAP":                                                            "",
+	"RemHBRUSH":                                                             "",
+	"RemHENHMETAFILE":                                                       "",
+	"RemHGLOBAL":                                                            "",
+	"RemHMETAFILEPICT":                                                      "",
+	"RemHPALETTE":                                                           "",
+	"RemSNB":                                                                "",
+	"RemSTGMEDIUM":                                                          "",
+	"RemotableHandle":                                                       "",
+	"RemotableHandle_U":                                                     "",
+	"RemoteMETAFILEPICT":                                                    "",
+	"SAFEARRAY":                                                             "",
+	"SAFEARRAYBOUND":                                                        "",
+	"SAFEARRAYUNION":                                                        "",
+	"SAFEARRAYUNION_U":                                                      "",
+	"SAFEARR_BRECORD":                                                       "",
+	"SAFEARR_BSTR":                                                          "",
+	"SAFEARR_DISPATCH":                                                      "",
+	"SAFEARR_HAVEIID":                                                       "",
+	"SAFEARR_UNKNOWN":                                                       "",
+	"SAFEARR_VARIANT":                                                       "",
+	"SAFER_CODE_PROPERTIES_V1":                                              "",
+	"SAFER_CODE_PROPERTIES_V2":                                              "",
+	"SAFER_HASH_IDENTIFICATION":                                             "",
+	"SAFER_HASH_IDENTIFICATION2":                                            "",
+	"SAFER_IDENTIFICATION_HEADER":                                           "",
+	"SAFER_PATHNAME_IDENTIFICATION":                                         "",
+	"SAFER_URLZONE_IDENTIFICATION":                                          "",
+	"SCOPE_TABLE_AMD64":                                                     "",
+	"SCOPE_TABLE_AMD64_Anonymous":                                           "",
+	"SCOPE_TABLE_ARM":                                                       "",
+	"SCOPE_TABLE_ARM64":                                                     "",
+	"SCOPE_TABLE_ARM64_Anonymous":                                           "",
+	"SCOPE_TABLE_ARM_Anonymous":                                             "",
+	"SCRIPTFONTINFO":                                                        "",
+	"SCRIPTINFO":                                                            "",
+	"SCRIPT_ANALYSIS":                                                       "",
+	"SCRIPT_CHARPROP":                                                       "",
+	"SCRIPT_CONTROL":                                                        "",
+	"SCRIPT_DIGITSUBSTITUTE":                                                "",
+	"SCRIPT_FONTPROPERTIES":                                                 "",
+	"SCRIPT_GLYPHPROP":                                                      "",
+	"SCRIPT_ITEM":                                                           "",
+	"SCRIPT_LOGATTR":                                                        "",
+	"SCRIPT_PROPERTIES":                                                     "",
+	"SCRIPT_STATE":                                                          "",
+	"SCRIPT_TABDEF":                                                         "",
+	"SCRIPT_VISATTR":                                                        "",
+	"SCROLLBARINFO":                                                         "",
+	"SCROLLINFO":                                                            "",
+	"SCRUB_DATA_INPUT":                                                      "",
+	"SCRUB_DATA_OUTPUT":                                                     "",
+	"SCRUB_PARITY_EXTENT":                                                   "",
+	"SCRUB_PARITY_EXTENT_DATA":                                              "",
+	"SC_ACTION":                                                             "",
+	"SChannelHookCallInfo":                                                  "",
+	"SEARCHMEMORY":                                                          "",
+	"SECURITY_ATTRIBUTES":                                                   "",
+	"SECURITY_CAPABILITIES":                                                 "",
+	"SECURITY_DESCRIPTOR":                                                   "",
+	"SECURITY_DESCRIPTOR_RELATIVE":                                          "",
+	"SECURITY_OBJECT_AI_PARAMS":                                             "",
+	"SECURITY_QUALITY_OF_SERVICE":                                           "",
+	"SELCHANGE":                                                             "",
+	"SERIALIZEDPROPERTYVALUE":                                               "",
+	"SERIALKEYSA":                                                           "",
+	"SERIALKEYSW":                                                           "",
+	"SERVERSILO_BASIC_INFORMATION":                                          "",
+	"SERVER_ALIAS_INFO_0":                                                   "",
+	"SERVER_CERTIFICATE_INFO_0":                                             "",
+	"SERVICE_CONTROL_STATUS_REASON_PARAMSA":                                 "",
+	"SERVICE_CONTROL_STATUS_REASON_PARAMSW":                                 "",
+	"SERVICE_CUSTOM_SYSTEM_STATE_CHANGE_DATA_ITEM":                          "",
+	"SERVICE_CUSTOM_SYSTEM_STATE_CHANGE_DATA_ITEM_U":                        "",
+	"SERVICE_CUSTOM_SYSTEM_STATE_CHANGE_DATA_ITEM_U_S":                      "",
+	"SERVICE_DELAYED_AUTO_START_INFO":                                       "",
+	"SERVICE_DESCRIPTIONA":                                                  "",
+	"SERVICE_DESCRIPTIONW":                                                  "",
+	"SERVICE_FAILURE_ACTIONSA":                                              "",
+	"SERVICE_FAILURE_ACTIONSW":                                              "",
+	"SERVICE_FAILURE_ACTIONS_FLAG":                                          "",
+	"SERVICE_LAUNCH_PROTECTED_INFO":                                         "",
+	"SERVICE_NOTIFY_1":                                                      "",
+	"SERVICE_NOTIFY_2A":                                                     "",
+	"SERVICE_NOTIFY_2W":                                                     "",
+	"SERVICE_PREFERRED_NODE_INFO":                                           "",
+	"SERVICE_PRESHUTDOWN_INFO":                                              "",
+	"SERVICE_REQUIRED_PRIVILEGES_INFOA":                                     "",
+	"SERVICE_REQUIRED_PRIVILEGES_INFOW":                                     "",
+	"SERVICE_SID_INFO":                                                      "",
+	"SERVICE_START_REASON":                                                  "",
+	"SERVICE_STATUS":                                                        "",
+	"SERVICE_STATUS_PROCESS":                                                "",
+	"SERVICE_TABLE_ENTRYA":                                                  "",
+	"SERVICE_TABLE_ENTRYW":                                                  "",
+	"SERVICE_TIMECHANGE_INFO":                                               "",
+	"SERVICE_TRIGGER":                                                       "",
+	"SERVICE_TRIGGER_CUSTOM_STATE_ID":                                       "",
+	"SERVICE_TRIGGER_INFO":                                                  "",
+	"SERVICE_TRIGGER_SPECIFIC_DATA_ITEM":                                    "",
+	"SESSION_INFO_0":                                                        "",
+	"SESSION_INFO_1":                                                        "",
+	"SESSION_INFO_10":                                                       "",
+	"SESSION_INFO_2":                                                        "",
+	"SESSION_INFO_502":                                                      "",
+	"SETTEXTEX":                                                             "",
+	"SET_POWER_SETTING_VALUE":                                               "",
+	"SE_ACCESS_REPLY":                                                       "",
+	"SE_ACCESS_REQUEST":                                                     "",
+	"SE_IMPERSONATION_STATE":                                                "",
+	"SE_SECURITY_DESCRIPTOR":                                                "",
+	"SE_SID":                                                                "",
+	"SE_TOKEN_USER":                                                         "",
+	"SE_TOKEN_USER_Anonymous1":                                              "",
+	"SE_TOKEN_USER_Anonymous2":                                              "",
+	"SFVM_HELPTOPIC_DATA":                                                   "",
+	"SFVM_PROPPAGE_DATA":                                                    "",
+	"SFV_CREATE":                                                            "",
+	"SFV_SETITEMPOS":                                                        "",
+	"SHARDAPPIDINFO":                                                        "",
+	"SHARDAPPIDINFOIDLIST":                                                  "",
+	"SHARDAPPIDINFOLINK":                                                    "",
+	"SHARED_VIRTUAL_DISK_SUPPORT":                                           "",
+	"SHARE_INFO_0":                                                          "",
+	"SHARE_INFO_1":                                                          "",
+	"SHARE_INFO_1004":                                                       "",
+	"SHARE_INFO_1005":                                                       "",
+	"SHARE_INFO_1006":                                                       "",
+	"SHARE_INFO_1501":                                                       "",
+	"SHARE_INFO_1503":                                                       "",
+	"SHARE_INFO_2":                                                          "",
+	"SHARE_INFO_501":                                                        "",
+	"SHARE_INFO_502":                                                        "",
+	"SHARE_INFO_503":                                                        "",
+	"SHCOLUMNDATA":                                                          "",
+	"SHCOLUMNINFO":                                                          "",
+	"SHCOLUMNINIT":                                                          "",
+	"SHCREATEPROCESSINFOW":                                                  "",
+	"SHChangeDWORDAsIDList":                                                 "",
+	"SHChangeNotifyEntry":                                                   "",
+	"SHChangeProductKeyAsIDList":                                            "",
+	"SHChangeUpdateImageIDList":                                             "",
+	"SHDESCRIPTIONID":                                                       "",
+	"SHDRAGIMAGE":                                                           "",
+	"SHELLDETAILS":                                                          "",
+	"SHELLEXECUTEINFOA":                                                     "",
+	"SHELLEXECUTEINFOA_Anonymous":                                           "",
+	"SHELLEXECUTEINFOW":                                                     "",
+	"SHELLEXECUTEINFOW_Anonymous":                                           "",
+	"SHELLFLAGSTATE":                                                        "",
+	"SHELLHOOKINFO":                                                         "",
+	"SHELLSTATEA":                                                           "",
+	"SHELLSTATEW":                                                           "",
+	"SHELL_ITEM_RESOURCE":                                                   "",
+	"SHFILEINFOA":                                                           "",
+	"SHFILEINFOW":                                                           "",
+	"SHFILEOPSTRUCTA":                                                       "",
+	"SHFILEOPSTRUCTW":                                                       "",
+	"SHFOLDERCUSTOMSETTINGS":                                                "",
+	"SHITEMID":                                                              "",
+	"SHNAMEMAPPINGA":                                                        "",
+	"SHNAMEMAPPINGW":                                                        "",
+	"SHQUERYRBINFO":                                                         "",
+	"SHSTOCKICONINFO":                                                       "",
+	"SHUFFLE_FILE_DATA":                                                     "",
+	"SID":                                                                   "",
+	"SID_AND_ATTRIBUTES":                                                    "",
+	"SID_AND_ATTRIBUTES_HASH":                                               "",
+	"SID_IDENTIFIER_AUTHORITY":                                              "",
+	"SILOOBJECT_BASIC_INFORMATION":                                          "",
+	"SINGLE_LIST_ENTRY":                                                     "",
+	"SINGLE_LIST_ENTRY32":                                                   "",
+	"SIZE":                                                                  "",
+	"SLIST_ENTRY":                                                           "",
+	"SLIST_HEADER":                                                          "",
+	"SLIST_HEADER_Anonymous":                                                "",
+	"SLIST_HEADER_HeaderX64":                                                "",
+	"SLOWAPPINFO":                                                           "",
+	"SMALL_RECT":                                                            "",
+	"SMCSHCHANGENOTIFYSTRUCT":                                               "",
+	"SMDATA":                                                                "",
+	"SMINFO":                                                                "",
+	"SOLE_AUTHENTICATION_INFO":                                              "",
+	"SOLE_AUTHENTICATION_LIST":                                              "",
+	"SOLE_AUTHENTICATION_SERVICE":                                           "",
+	"SORTCOLUMN":                                                            "",
+	"SOUNDSENTRYA":                                                          "",
+	"SOUNDSENTRYW":                                                          "",
+	"SOURCEFILE":                                                            "",
+	"SOURCEFILEW":                                                           "",
+	"SRCCODEINFO":                                                           "",
+	"SRCCODEINFOW":                                                          "",
+	"STACKFRAME64":                                                          "",
+	"STACKFRAME_EX":                                                         "",
+	"STACK_SRC_INFO":                                                        "",
+	"STACK_SYM_FRAME_INFO":                                                  "",
+	"STARTUPINFOA":                                                          "",
+	"STARTUPINFOEXA":                                                        "",
+	"STARTUPINFOEXW":                                                        "",
+	"STARTUPINFOW":                                                          "",
+	"STATDATA":                                                              "",
+	"STATPROPSETSTG":                                                        "",
+	"STATPROPSTG":                                                           "",
+	"STATSTG":                                                               "",
+	"STAT_SERVER_0":                                                         "",
+	"STAT_WORKSTATION_0":                                                    "",
+	"STGMEDIUM":                                                             "",
+	"STGMEDIUM_Anonymous":                                                   "",
+	"STGOPTIONS":                                                            "",
+	"STICKYKEYS":                                                            "",
+	"STRENTRYA":                                                             "",
+	"STRENTRYW":                                                             "",
+	"STRING":                                                                "",
+	"STRING32":                                                              "",
+	"STRING64":                                                              "",
+	"STRINGEXSTRUCT":                                                        "",
+	"STRRET":                                                                "",
+	"STRRET_Anonymous":                                                      "",
+	"STRTABLEA":                                                             "",
+	"STRTABLEW":                                                             "",
+	"STYLESTRUCT":                                                           "",
+	"SUPPORTED_OS_INFO":                                                     "",
+	"SV2CVW2_PARAMS":                                                        "",
+	"SYMBOL_INFO":                                                           "",
+	"SYMBOL_INFOW":                                                          "",
+	"SYMBOL_INFO_EX":                                                        "",
+	"SYMBOL_INFO_PACKAGE":                                                   "",
+	"SYMBOL_INFO_PACKAGEW":                                                  "",
+	"SYMSRV_EXTENDED_OUTPUT_DATA":                                           "",
+	"SYMSRV_INDEX_INFO":                                                     "",
+	"SYMSRV_INDEX_INFOW":                                                    "",
+	"SYM_DUMP_PARAM":                                                        "",
+	"SYM_DUMP_PARAM_Anonymous":                                              "",
+	"SYNCMGRHANDLERINFO":                                                    "",
+	"SYNCMGRITEM":                                                           "",
+	"SYNCMGRLOGERRORINFO":                                                   "",
+	"SYNCMGRPROGRESSITEM":                                                   "",
+	"SYNCMGR_CONFLICT_ID_INFO":                                              "",
+	"SYSTEMTIME":                                                            "",
+	"SYSTEM_ACCESS_FILTER_ACE":                                              "",
+	"SYSTEM_ALARM_ACE":                                                      "",
+	"SYSTEM_ALARM_CALLBACK_ACE":                                             "",
+	"SYSTEM_ALARM_CALLBACK_OBJECT_ACE":                                      "",
+	"SYSTEM_ALARM_OBJECT_ACE":                                               "",
+	"SYSTEM_AUDIT_ACE":                                                      "",
+	"SYSTEM_AUDIT_CALLBACK_ACE":                                             "",
+	"SYSTEM_AUDIT_CALLBACK_OBJECT_ACE":                                      "",
+	"SYSTEM_AUDIT_OBJECT_ACE":                                               "",
+	"SYSTEM_BASIC_INFORMATION":                                              "",
+	"SYSTEM_BATTERY_STATE":                                                  "",
+	"SYSTEM_CODEINTEGRITY_INFORMATION":                                      "",
+	"SYSTEM_CPU_SET_INFORMATION":                                            "",
+	"SYSTEM_CPU_SET_INFORMATION_Anonymous":                                  "",
+	"SYSTEM_CPU_SET_INFORMATION_Anonymous_CpuSet":                           "",
+	"SYSTEM_CPU_SET_INFORMATION_Anonymous_CpuSet_Anonymous1":           "",
+	"SYSTEM_CPU_SET_INFORMATION_Anonymous_CpuSet_Anonymous1_Anonymous": "",
+	"SYSTEM_CPU_SET_INFORMATION_Anonymous_CpuSet_Anonymous2":           "",
+	"SYSTEM_EXCEPTION_INFORMATION":                                     "",
+	"SYSTEM_INFO":                                                      "",
+	"SYSTEM_INFO_Anonymous":                                            "",
+	"SYSTEM_INFO_Anonymous_Anonymous":                                  "",
+	"SYSTEM_INTERRUPT_INFORMATION":                                     "",
+	"SYSTEM_LOGICAL_PROCESSOR_INFORMATION":                             "",
+	"SYSTEM_LOGICAL_PROCESSOR_INFORMATION_Anonymous":                   "",
+	"SYSTEM_LOGICAL_PROCESSOR_INFORMATION_Anonymous_NumaNode":          "",
+	"SYSTEM_LOGICAL_PROCESSOR_INFORMATION_Anonymous_ProcessorCore":     "",
+	"SYSTEM_LOGICAL_PROCESSOR_INFORMATION_EX":                          "",
+	"SYSTEM_LOGICAL_PROCESSOR_INFORMATION_EX_Anonymous":                "",
+	"SYSTEM_LOOKASIDE_INFORMATION":                                     "",
+	"SYSTEM_MANDATORY_LABEL_ACE":                                       "",
+	"SYSTEM_PERFORMANCE_INFORMATION":                                   "",
+	"SYSTEM_POLICY_INFORMATION":                                        "",
+	"SYSTEM_POOL_ZEROING_INFORMATION":                                  "",
+	"SYSTEM_POWER_CAPABILITIES":                                        "",
+	"SYSTEM_POWER_INFORMATION":                                         "",
+	"SYSTEM_POWER_LEVEL":                                               "",
+	"SYSTEM_POWER_POLICY":                                              "",
+	"SYSTEM_POWER_STATUS":                                              "",
+	"SYSTEM_PROCESSOR_CYCLE_TIME_INFORMATION":                          "",
+	"SYSTEM_PROCESSOR_PERFORMANCE_INFORMATION":                         "",
+	"SYSTEM_PROCESS_INFORMATION":                                       "",
+	"SYSTEM_PROCESS_TRUST_LABEL_ACE":                                   "",
+	"SYSTEM_REGISTRY_QUOTA_INFORMATION":                                "",
+	"SYSTEM_RESOURCE_ATTRIBUTE_ACE":                                    "",
+	"SYSTEM_SCOPED_POLICY_ID_ACE":                                      "",
+	"SYSTEM_SUPPORTED_PROCESSOR_ARCHITECTURES_INFORMATION":             "",
+	"SYSTEM_THREAD_INFORMATION":                                        "",
+	"SYSTEM_TIMEOFDAY_INFORMATION":                                     "",
+	"ScriptDebugEventInformation":                                      "",
+	"ScriptDebugEventInformation_U":                                    "",
+	"ScriptDebugEventInformation_U_BreakpointInformation":              "",
+	"ScriptDebugEventInformation_U_ExceptionInformation":               "",
+	"ScriptDebugPosition":                                              "",
+	"ServerInformation":                                                "",
+	"StorageLayout":                                                    "",
+	"TABLECELLPARMS":                                                   "",
+	"TABLEROWPARMS":                                                    "",
+	"TAPE_CREATE_PARTITION":                                            "",
+	"TAPE_ERASE":                                                       "",
+	"TAPE_GET_DRIVE_PARAMETERS":                                        "",
+	"TAPE_GET_MEDIA_PARAMETERS":                                        "",
+	"TAPE_GET_POSITION":                                                "",
+	"TAPE_PREPARE":                                                     "",
+	"TAPE_SET_DRIVE_PARAMETERS":                                        "",
+	"TAPE_SET_MEDIA_PARAMETERS":                                        "",
+	"TAPE_SET_POSITION":                                                "",
+	"TAPE_WMI_OPERATIONS":                                              "",
+	"TAPE_WRITE_MARKS":                                                 "",
+	"TASKDIALOGCONFIG":                                                 "",
+	"TASKDIALOGCONFIG_Anonymous1":                                      "",
+	"TASKDIALOGCONFIG_Anonymous2":                                      "",
+	"TASKDIALOG_BUTTON":                                                "",
+	"TA_CUBIC_BEZIER":                                                  "",
+	"TA_TIMINGFUNCTION":                                                "",
+	"TA_TRANSFORM":                                                     "",
+	"TA_TRANSFORM_2D":                                                  "",
+	"TA_TRANSFORM_CLIP":                                                "",
+	"TA_TRANSFORM_OPACITY":                                             "",
+	"TBADDBITMAP":                                                      "",
+	"TBBUTTON":                                                         "",
+	"TBBUTTONINFOA":                                                    "",
+	"TBBUTTONINFOW":                                                    "",
+	"TBINFO":                                                           "",
+	"TBINSERTMARK":                                                     "",
+	"TBMETRICS":                                                        "",
+	"TBREPLACEBITMAP":                                                  "",
+	"TBSAVEPARAMSA":                                                    "",
+	"TBSAVEPARAMSW":                                                    "",
+	"TCHITTESTINFO":                                                    "",
+	"TCITEMA":                                                          "",
+	"TCITEMHEADERA":                                                    "",
+	"TCITEMHEADERW":                                                    "",
+	"TCITEMW":                                                          "",
+	"TCP_REQUEST_QUERY_INFORMATION_EX32_XP":                            "",
+	"TCP_REQUEST_QUERY_INFORMATION_EX_W2K":                             "",
+	"TCP_REQUEST_QUERY_INFORMATION_EX_XP":                              "",
+	"TCP_REQUEST_SET_INFORMATION_EX":                                   "",
+	"TDIEntityID":                                                      "",
+	"TDIObjectID":                                                      "",
+	"TDI_TL_IO_CONTROL_ENDPOINT":                                       "",
+	"TDI_TL_IO_CONTROL_ENDPOINT_Anonymous":                             "",
+	"TEXTMETRICA":                                                      "",
+	"TEXTMETRICW":                                                      "",
+	"TEXTRANGEA":                                                       "",
+	"TEXTRANGEW":                                                       "",
+	"TEXTRANGE_PROPERTIES":                                             "",
+	"TEXT_DOCUMENT_ARRAY":                                              "",
+	"THERMAL_EVENT":                                                    "",
+	"THERMAL_INFORMATION":                                              "",
+	"THERMAL_POLICY":                                                   "",
+	"THERMAL_WAIT_READ":                                                "",
+	"THREADENTRY32":                                                    "",
+	"THREAD_NAME_INFORMATION":                                          "",
+	"THREAD_POWER_THROTTLING_STATE":                                    "",
+	"THUMBBUTTON":                                                      "",
+	"TIME_ZONE_INFORMATION":                                            "",
+	"TITLEBARINFO":                                                     "",
+	"TITLEBARINFOEX":                                                   "",
+	"TI_FINDCHILDREN_PARAMS":                                           "",
+	"TLIBATTR":                                                         "",
+	"TOGGLEKEYS":                                                       "",
+	"TOKEN_ACCESS_INFORMATION":                                         "",
+	"TOKEN_APPCONTAINER_INFORMATION":                                   "",
+	"TOKEN_AUDIT_POLICY":                                               "",
+	"TOKEN_BNO_ISOLATION_INFORMATION":                                  "",
+	"TOKEN_CONTROL":                                                    "",
+	"TOKEN_DEFAULT_DACL":                                               "",
+	"TOKEN_DEVICE_CLAIMS":                                              "",
+	"TOKEN_ELEVATION":                                                  "",
+	"TOKEN_GROUPS":                                                     "",
+	"TOKEN_GROUPS_AND_PRIVILEGES":                                      "",
+	"TOKEN_LINKED_TOKEN":                                               "",
+	"TOKEN_MANDATORY_LABEL":                                            "",
+	"TOKEN_MANDATORY_POLICY":                                           "",
+	"TOKEN_ORIGIN":                                                     "",
+	"TOKEN_OWNER":                                                      "",
+	"TOKEN_PRIMARY_GROUP":                                              "",
+	"TOKEN_PRIVILEGES":                                                 "",
+	"TOKEN_SID_INFORMATION":                                            "",
+	"TOKEN_SOURCE":                                                     "",
+	"TOKEN_STATISTICS":                                                 "",
+	"TOKEN_USER":                                                       "",
+	"TOKEN_USER_CLAIMS":                                                "",
+	"TOOLBARITEM":                                                      "",
+	"TOOLINFO":                                                         "",
+	"TOUCHPREDICTIONPARAMETERS":                                        "",
+	"TOUCH_HIT_TESTING_INPUT":                                          "",
+	"TOUCH_HIT_TESTING_PROXIMITY_EVALUATION":                           "",
+	"TPMPARAMS":                                                        "",
+	"TP_CALLBACK_ENVIRON_V3":                                           "",
+	"TP_CALLBACK_ENVIRON_V3_U":                                         "",
+	"TP_CALLBACK_ENVIRON_V3_U_S":                                       "",
+	"TP_POOL_STACK_INFORMATION":                                        "",
+	"TRACKMOUSEEVENT":                                                  "",
+	"TRANSACTIONMANAGER_BASIC_INFORMATION":                             "",
+	"TRANSACTIONMANAGER_LOGPATH_INFORMATION":                           "",
+	"TRANSACTIONMANAGER_LOG_INFORMATION":                               "",
+	"TRANSACTIONMANAGER_OLDEST_INFORMATION":                            "",
+	"TRANSACTIONMANAGER_RECOVERY_INFORMATION":                          "",
+	"TRANSACTION_BASIC_INFORMATION":                                    "",
+	"TRANSACTION_BIND_INFORMATION":                                     "",
+	"TRANSACTION_ENLISTMENTS_INFORMATION":                              "",
+	"TRANSACTION_ENLISTMENT_PAIR":                                      "",
+	"TRANSACTION_LIST_ENTRY":                                           "",
+	"TRANSACTION_LIST_INFORMATION":                                     "",
+	"TRANSACTION_NOTIFICATION":                                         "",
+	"TRANSACTION_NOTIFICATION_MARSHAL_ARGUMENT":                        "",
+	"TRANSACTION_NOTIFICATION_PROPAGATE_ARGUMENT":                      "",
+	"TRANSACTION_NOTIFICATION_RECOVERY_ARGUMENT":                       "",
+	"TRANSACTION_NOTIFICATION_SAVEPOINT_ARGUMENT":                      "",
+	"TRANSACTION_NOTIFICATION_TM_ONLINE_ARGUMENT":                      "",
+	"TRANSACTION_PROPERTIES_INFORMATION":                               "",
+	"TRANSACTION_SUPERIOR_ENLISTMENT_INFORMATION":                      "",
+	"TRANSLATE_VIRTUAL_TO_PHYSICAL":                                    "",
+	"TRIVERTEX":                                                        "",
+	"TTEMBEDINFO":                                                      "",
+	"TTGETTITLE":                                                       "",
+	"TTHITTESTINFOA":                                                   "",
+	"TTHITTESTINFOW":                                                   "",
+	"TTLOADINFO":                                                       "",
+	"TTPOLYCURVE":                                                      "",
+	"TTPOLYGONHEADER":                                                  "",
+	"TTTOOLINFOA":                                                      "",
+	"TTTOOLINFOW":                                                      "",
+	"TTVALIDATIONTESTSPARAMS":                                          "",
+	"TTVALIDATIONTESTSPARAMSEX":                                        "",
+	"TVGETITEMPARTRECTINFO":                                            "",
+	"TVHITTESTINFO":                                                    "",
+	"TVINSERTSTRUCTA":                                                  "",
+	"TVINSERTSTRUCTA_Anonymous":                                        "",
+	"TVINSERTSTRUCTW":                                                  "",
+	"TVINSERTSTRUCTW_Anonymous":                                        "",
+	"TVITEMA":                                                          "",
+	"TVITEMEXA":                                                        "",
+	"TVITEMEXW":                                                        "",
+	"TVITEMW":                                                          "",
+	"TVSORTCB":                                                         "",
+	"TXF_ID":                                                           "",
+	"TXF_ID_Anonymous":                                                 "",
+	"TXF_LOG_RECORD_AFFECTED_FILE":                                     "",
+	"TXF_LOG_RECORD_BASE":                                              "",
+	"TXF_LOG_RECORD_TRUNCATE":                                          "",
+	"TXF_LOG_RECORD_WRITE":                                             "",
+	"TYPEATTR":                                                         "",
+	"TYPEDESC":                                                         "",
+	"TYPEDESC_Anonymous":                                               "",
+	"UCLSSPEC":                                                         "",
+	"UCLSSPEC_Tagged_union":                                            "",
+	"UCLSSPEC_Tagged_union_ByName":                                     "",
+	"UCLSSPEC_Tagged_union_ByObjectId":                                 "",
+	"UCPTrie":                                                          "",
+	"UCPTrieData":                                                      "",
+	"UCharIterator":                                                    "",
+	"UConverterFromUnicodeArgs":                                        "",
+	"UConverterToUnicodeArgs":                                          "",
+	"UDACCEL":                                                          "",
+	"UDATE":                                                            "",
+	"UFieldPosition":                                                   "",
+	"UIAutomationEventInfo":                                            "",
+	"UIAutomationMethodInfo":                                           "",
+	"UIAutomationParameter":                                            "",
+	"UIAutomationPatternInfo":                                          "",
+	"UIAutomationPropertyInfo":                                         "",
+	"UIDNAInfo":                                                        "",
+	"ULARGE_INTEGER":                                                   "",
+	"ULARGE_INTEGER_Anonymous":                                         "",
+	"ULARGE_INTEGER_U":                                                 "",
+	"UMS_CREATE_THREAD_ATTRIBUTES":                                     "",
+	"UMS_SCHEDULER_STARTUP_INFO":                                       "",
+	"UMS_SYSTEM_THREAD_INFORMATION":                                    "",
+	"UMS_SYSTEM_THREAD_INFORMATION_Anonymous":                          "",
+	"UMS_SYSTEM_THREAD_INFORMATION_Anonymous_Anonymous":                "",
+	"UNDETERMINESTRUCT":                                                "",
+	"UNICODERANGE":                                                     "",
+	"UNICODE_STRING":                                                   "",
+	"UNLOAD_DLL_DEBUG_INFO":                                            "",
+	"UNWIND_HISTORY_TABLE":                                             "",
+	"UNWIND_HISTORY_TABLE_ENTRY":                                       "",
+	"UPDATELAYEREDWINDOWINFO":                                          "",
+	"UParseError":                                                      "",
+	"URLINVOKECOMMANDINFOA":                                            "",
+	"URLINVOKECOMMANDINFOW":                                            "",
+	"UReplaceableCallbacks":                                            "",
+	"USAGE_PROPERTIES":                                                 "",
+	"USEROBJECTFLAGS":                                                  "",
+	"USER_POWER_POLICY":                                                "",
+	"USerializedSet":                                                   "",
+	"UText":                                                            "",
+	"UTextFuncs":                                                       "",
+	"UTransPosition":                                                   "",
+	"UiaAndOrCondition":                                                "",
+	"UiaAsyncContentLoadedEventArgs":                                   "",
+	"UiaCacheRequest":                                                  "",
+	"UiaChangeInfo":                                                    "",
+	"UiaChangesEventArgs":                                              "",
+	"UiaCondition":                                                     "",
+	"UiaEventArgs":                                                     "",
+	"UiaFindParams":                                                    "",
+	"UiaNotCondition":                                                  "",
+	"UiaPoint":                                                         "",
+	"UiaPropertyChangedEventArgs":                                      "",
+	"UiaPropertyCondition":                                             "",
+	"UiaRect":                                                          "",
+	"UiaStructureChangedEventArgs":                                     "",
+	"UiaTextEditTextChangedEventArgs":                                  "",
+	"UiaWindowClosedEventArgs":                                         "",
+	"UserBITMAP":                                                       "",
+	"UserCLIPFORMAT":                                                   "",
+	"UserCLIPFORMAT_U":                                                 "",
+	"UserFLAG_STGMEDIUM":                                               "",
+	"UserHBITMAP":                                                      "",
+	"UserHBITMAP_U":                                                    "",
+	"UserHENHMETAFILE":                                                 "",
+	"UserHENHMETAFILE_U":                                               "",
+	"UserHGLOBAL":                                                      "",
+	"UserHGLOBAL_U":                                                    "",
+	"UserHMETAFILE":                                                    "",
+	"UserHMETAFILEPICT":                                                "",
+	"UserHMETAFILEPICT_U":                                              "",
+	"UserHMETAFILE_U":                                                  "",
+	"UserHPALETTE":                                                     "",
+	"UserHPALETTE_U":                                                   "",
+	"UserSTGMEDIUM":                                                    "",
+	"UserSTGMEDIUM_STGMEDIUM_UNION":                                    "",
+	"UserSTGMEDIUM_STGMEDIUM_UNION_U":                                  "",
+	"VALENTA":                                                          "",
+	"VALENTW":                                                          "",
+	"VARDESC":                                                          "",
+	"VARDESC_Anonymous":                                                "",
+	"VARIANT":                                                          "",
+	"VARIANT_Anonymous":                                                "",
+	"VARIANT_Anonymous_Anonymous":                                      "",
+	"VARIANT_Anonymous_Anonymous_Anonymous":                            "",
+	"VARIANT_Anonymous_Anonymous_Anonymous_Anonymous":                  "",
+	"VBS_BASIC_ENCLAVE_EXCEPTION_AMD64":                                "",
+	"VBS_BASIC_ENCLAVE_SYSCALL_PAGE":                                   "",
+	"VBS_BASIC_ENCLAVE_THREAD_DESCRIPTOR32":                            "",
+	"VBS_BASIC_ENCLAVE_THREAD_DESCRIPTOR64":                            "",
+	"VBS_ENCLAVE_REPORT":                                               "",
+	"VBS_ENCLAVE_REPORT_MODULE":                                        "",
+	"VBS_ENCLAVE_REPORT_PKG_HEADER":                                    "",
+	"VBS_ENCLAVE_REPORT_VARDATA_HEADER":                                "",
+	"VERSIONEDSTREAM":                                                  "",
+	"VIRTUAL_TO_PHYSICAL":                                              "",
+	"VK_FPARAM":                                                        "",
+	"VK_F_":                                                            "",
+	"VK_TO_BIT":                                                        "",
+	"VK_TO_WCHARS1":                                                    "",
+	"VK_TO_WCHARS10":                                                   "",
+	"VK_TO_WCHARS2":                                                    "",
+	"VK_TO_WCHARS3":                                                    "",
+	"VK_TO_WCHARS4":                                                    "",
+	"VK_TO_WCHARS5":                                                    "",
+	"VK_TO_WCHARS6":                                                    "",
+	"VK_TO_WCHARS7":                                                    "",
+	"VK_TO_WCHARS8":                                                    "",
+	"VK_TO_WCHARS9":                                                    "",
+	"VK_TO_WCHAR_TABLE":                                                "",
+	"VK_VSC":                                                           "",
+	"VOLUME_ALLOCATE_BC_STREAM_INPUT":                                  "",
+	"VOLUME_ALLOCATE_BC_STREAM_OUTPUT":                                 "",
+	"VOLUME_ALLOCATION_HINT_INPUT":                                     "",
+	"VOLUME_ALLOCATION_HINT_OUTPUT":                                    "",
+	"VOLUME_CRITICAL_IO":                                               "",
+	"VOLUME_FAILOVER_SET":                                              "",
+	"VOLUME_GET_BC_PROPERTIES_INPUT":                                   "",
+	"VOLUME_GET_BC_PROPERTIES_OUTPUT":                                  "",
+	"VOLUME_LOGICAL_OFFSET":                                            "",
+	"VOLUME_NUMBER":                                                    "",
+	"VOLUME_PHYSICAL_OFFSET":                                           "",
+	"VOLUME_PHYSICAL_OFFSETS":                                          "",
+	"VOLUME_READ_PLEX_INPUT":                                           "",
+	"VOLUME_SET_GPT_ATTRIBUTES_INFORMATION":                            "",
+	"VOLUME_SHRINK_INFO":                                               "",
+	"VSC_LPWSTR":                                                       "",
+	"VSC_VK":                                                           "",
+	"VS_FIXEDFILEINFO":                                                 "",
+	"Val_context":                                                      "",
+	"VolLockBroadcast":                                                 "",
+	"WAITCHAIN_NODE_INFO":                                              "",
+	"WAITCHAIN_NODE_INFO_Anonymous":                                    "",
+	"WAITCHAIN_NODE_INFO_Anonymous_LockObject":                         "",
+	"WAITCHAIN_NODE_INFO_Anonymous_ThreadObject":                       "",
+	"WAKE_ALARM_INFORMATION":                                           "",
+	"WCRANGE":                                                          "",
+	"WDBGEXTS_CLR_DATA_INTERFACE":                                      "",
+	"WDBGEXTS_DISASSEMBLE_BUFFER":                                      "",
+	"WDBGEXTS_MODULE_IN_RANGE":                                         "",
+	"WDBGEXTS_QUERY_INTERFACE":                                         "",
+	"WDBGEXTS_THREAD_OS_INFO":                                          "",
+	"WGLSWAP":                                                          "",
+	"WHEA_AER_BRIDGE_DESCRIPTOR":                                       "",
+	"WHEA_AER_ENDPOINT_DESCRIPTOR":                                     "",
+	"WHEA_AER_ROOTPORT_DESCRIPTOR":                                     "",
+	"WHEA_DEVICE_DRIVER_DESCRIPTOR":                                    "",
+	"WHEA_DRIVER_BUFFER_SET":                                           "",
+	"WHEA_ERROR_SOURCE_CONFIGURATION_DD":                               "",
+	"WHEA_ERROR_SOURCE_CONFIGURATION_DEVICE_DRIVER":                    "",
+	"WHEA_ERROR_SOURCE_CONFIGURATION_DEVICE_DRIVER_V1":                 "",
+	"WHEA_ERROR_SOURCE_DESCRIPTOR":                                     "",
+	"WHEA_ERROR_SOURCE_DESCRIPTOR_Info":                                "",
+	"WHEA_GENERIC_ERROR_DESCRIPTOR":                                    "",
+	"WHEA_GENERIC_ERROR_DESCRIPTOR_V2":                                 "",
+	"WHEA_IPF_CMC_DESCRIPTOR":                                          "",
+	"WHEA_IPF_CPE_DESCRIPTOR":                                          "",
+	"WHEA_IPF_MCA_DESCRIPTOR":                                          "",
+	"WHEA_NOTIFICATION_DESCRIPTOR":                                     "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U":                                   "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Gsiv":                              "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Interrupt":                         "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_LocalInterrupt":                    "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Nmi":                               "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Polled":                            "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Sci":                               "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Sea":                               "",
+	"WHEA_NOTIFICATION_DESCRIPTOR_U_Sei":                               "",
+	"WHEA_NOTIFICATION_FLAGS":                                          "",
+	"WHEA_NOTIFICATION_FLAGS_Anonymous":                                "",
+	"WHEA_PCI_SLOT_NUMBER":                                             "",
+	"WHEA_PCI_SLOT_NUMBER_U":                                           "",
+	"WHEA_PCI_SLOT_NUMBER_U_Bits":                                      "",
+	"WHEA_XPF_CMC_DESCRIPTOR":                                          "",
+	"WHEA_XPF_MCE_DESCRIPTOR":                                          "",
+	"WHEA_XPF_MC_BANK_DESCRIPTOR":                                      "",
+	"WHEA_XPF_NMI_DESCRIPTOR":                                          "",
+	"WIM_ENTRY_INFO":                                                   "",
+	"WIM_EXTERNAL_FILE_INFO":                                           "",
+	"WIN32_FILE_ATTRIBUTE_DATA":                                        "",
+	"WIN32_FIND_DATAA":                                                 "",
+	"WIN32_FIND_DATAW":                                                 "",
+	"WIN32_FIND_STREAM_DATA":                                           "",
+	"WIN32_MEMORY_PARTITION_INFORMATION":                               "",
+	"WIN32_MEMORY_RANGE_ENTRY":                                         "",
+	"WIN32_MEMORY_REGION_INFORMATION":                                  "",
+	"WIN32_MEMORY_REGION_INFORMATION_Anonymous":                        "",
+	"WIN32_MEMORY_REGION_INFORMATION_Anonymous_Anonymous":              "",
+	"WIN32_STREAM_ID":                                                  "",
+	"WINDBG_EXTENSION_APIS":                                            "",
+	"WINDBG_EXTENSION_APIS32":                                          "",
+	"WINDBG_EXTENSION_APIS64":                                          "",
+	"WINDBG_OLDKD_EXTENSION_APIS":                                      "",
+	"WINDBG_OLD_EXTENSION_APIS":                                        "",
+	"WINDOWDATA":                                                       "",
+	"WINDOWINFO":                                                       "",
+	"WINDOWPLACEMENT":                                                  "",
+	"WINDOWPOS":                                                        "",
+	"WINDOW_BUFFER_SIZE_RECORD":                                        "",
+	"WINSTATIONINFORMATIONW":                                           "",
+	"WLDP_DEVICE_SECURITY_INFORMATION":                                 "",
+	"WLDP_HOST_INFORMATION":                                            "",
+	"WNDCLASSA":                                                        "",
+	"WNDCLASSEXA":                                                      "",
+	"WNDCLASSEXW":                                                      "",
+	"WNDCLASSW":                                                        "",
+	"WNF_STATE_NAME":                                                   "",
+	"WOF_FILE_COMPRESSION_INFO_V0":                                     "",
+	"WOF_FILE_COMPRESSION_INFO_V1":                                     "",
+	"WORD_BLOB":                                                        "",
+	"WORD_SIZEDARR":                                                    "",
+	"WOW64_CONTEXT":                                                    "",
+	"WOW64_DESCRIPTOR_TABLE_ENTRY":                                     "",
+	"WOW64_FLOATING_SAVE_AREA":                                         "",
+	"WOW64_LDT_ENTRY":                                                  "",
+	"WOW64_LDT_ENTRY_HighWord":                                         "",
+	"WOW64_LDT_ENTRY_HighWord_Bits":                                    "",
+	"WOW64_LDT_ENTRY_HighWord_Bytes":                                   "",
+	"WTA_OPTIONS":                                                      "",
+	"WTS_THUMBNAILID":                                                  "",
+	"WireBRECORD_":                                                     "",
+	"WireSAFEARRAY_":                                                   "",
+	"WireVARIANT_":                                                     "",
+	"WireVARIANT_Anonymous_":                                           "",
+	"XFORM":                                                            "",
+	"XPF_MCE_FLAGS":                                                    "",
+	"XPF_MCE_FLAGS_Anonymous":                                          "",
+	"XPF_MC_BANK_FLAGS":                                                "",
+	"XPF_MC_BANK_FLAGS_Anonymous":                                      "",
+	"XSAVE_AREA":                                                       "",
+	"XSAVE_AREA_HEADER":                                                "",
+	"XSAVE_CET_U_FORMAT":                                               "",
+	"XSAVE_FORMAT":                                                     "",
+	"XSTATE_CONFIGURATION":                                             "",
+	"XSTATE_CONFIGURATION_Anonymous":                                   "",
+	"XSTATE_CONFIGURATION_Anonymous_Anonymous":                         "",
+	"XSTATE_CONFIG_FEATURE_MSC_INFO":                                   "",
+	"XSTATE_CONTEXT":                                                   "",
+	"XSTATE_FEATURE":                                                   "",
+}
+
+// comImplPkgs are the go-com packages of the Impl and ComObj types of interfaces
+var comImplPkgs = map[string]string{
+	"IConnectionPoint":   "ole",
+	"IDispatch":          "ole",
+	"IEnumConnections":   "ole",
+	"IProvideClassInfo2": "ole",
+	"IUnknown":           "com",
+}
@@ -0,0 +1,206 @@
+//go:build ignore
+
+// knowntypes_gen writes knowntypes.go, of the types go-win32api and
+// go-com already have, as type checked from their sources
+package main
+
+import (
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	win32Path = "github.com/zzl/go-win32api/v2/win32"
+	comPath   = "github.com/zzl/go-com/com"
+	olePath   = "github.com/zzl/go-com/ole"
+)
+
+func main() {
+	build.Default.GOOS = "windows"
+	fset := token.NewFileSet()
+
+	win32Pkg, win32Files := checkPackage(fset, win32Path)
+	iids := parseIids(win32Files)
+	win32Types := make(map[string]string)
+	scope := win32Pkg.Scope()
+	for _, name := range scope.Names() {
+		if !isStructType(scope, name) || strings.HasSuffix(name, "Vtbl") {
+			continue
+		}
+		if isStructType(scope, name+"Vtbl") {
+			iid, ok := iids[name]
+			if !ok {
+				continue
+			}
+			win32Types[name] = iid
+		} else {
+			win32Types[name] = ""
+		}
+	}
+
+	comImplPkgs := make(map[string]string)
+	for _, pkgPath := range []string{comPath, olePath} {
+		pkg, _ := checkPackage(fset, pkgPath)
+		scope := pkg.Scope()
+		for _, name := range scope.Names() {
+			intfName := strings.TrimSuffix(name, "Impl")
+			if intfName == name || win32Types[intfName] == "" {
+				continue
+			}
+			if isStructType(scope, name) && isStructType(scope, intfName+"ComObj") {
+				comImplPkgs[intfName] = pkg.Name()
+			}
+		}
+	}
+
+	code := "// Code generated by knowntypes_gen.go; DO NOT EDIT.\n\n"
+	code += "package codegen\n\n"
+	code += "// win32Types are the interfaces and structs of go-win32api, " +
+		"with the IIDs of the interfaces\n"
+	code += "var win32Types = map[string]string{\n"
+	for _, name := range sortedKeys(win32Types) {
+		code += "\t" + strconv.Quote(name) + ": " + strconv.Quote(win32Types[name]) + ",\n"
+	}
+	code += "}\n\n"
+	code += "// comImplPkgs are the go-com packages of the Impl and ComObj " +
+		"types of interfaces\n"
+	code += "var comImplPkgs = map[string]string{\n"
+	for _, name := range sortedKeys(comImplPkgs) {
+		code += "\t" + strconv.Quote(name) + ": " + strconv.Quote(comImplPkgs[name]) + ",\n"
+	}
+	code += "}\n"
+
+	src, err := format.Source([]byte(code))
+	if err != nil {
+		panic(err)
+	}
+	err = os.WriteFile("knowntypes.go", src, 0644)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// checkPackage type checks the windows sources of a package
+func checkPackage(fset *token.FileSet, pkgPath string) (*types.Package, []*ast.File) {
+	out, err := exec.Command("go", "list", "-f", "{{.Dir}}", pkgPath).Output()
+	if err != nil {
+		panic(pkgPath + ": " + err.Error())
+	}
+	dir := strings.TrimSpace(string(out))
+	bp, err := build.Default.ImportDir(dir, 0)
+	if err != nil {
+		panic(err)
+	}
+	var files []*ast.File
+	for _, fileName := range bp.GoFiles {
+		file, err := parser.ParseFile(fset, dir+"/"+fileName, nil, 0)
+		if err != nil {
+			panic(err)
+		}
+		files = append(files, file)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	pkg, err := conf.Check(pkgPath, fset, files, nil)
+	if err != nil {
+		panic(err)
+	}
+	return pkg, files
+}
+
+// isStructType tells whether the name is of a struct type with fields,
+// leaving out the empty placeholders of coclasses (Shell, Folder..)
+func isStructType(scope *types.Scope, name string) bool {
+	tn, ok := scope.Lookup(name).(*types.TypeName)
+	if !ok || tn.IsAlias() {
+		return false
+	}
+	st, ok := tn.Type().Underlying().(*types.Struct)
+	return ok && st.NumFields() > 0
+}
+
+// parseIids reads the IID_<Name> = syscall.GUID{..} vars of the files
+func parseIids(files []*ast.File) map[string]string {
+	iids := make(map[string]string)
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if len(vs.Names) != 1 || len(vs.Values) != 1 ||
+					!strings.HasPrefix(vs.Names[0].Name, "IID_") {
+					continue
+				}
+				lit, ok := vs.Values[0].(*ast.CompositeLit)
+				if !ok {
+					continue
+				}
+				if iid := guidString(lit); iid != "" {
+					iids[strings.TrimPrefix(vs.Names[0].Name, "IID_")] = iid
+				}
+			}
+		}
+	}
+	return iids
+}
+
+// guidString formats a GUID{Data1, Data2, Data3, [8]byte{..}} literal as
+// GUID.String does, "" if it is not one
+func guidString(lit *ast.CompositeLit) string {
+	var vals []uint64
+	var collect func(expr ast.Expr) bool
+	collect = func(expr ast.Expr) bool {
+		switch e := expr.(type) {
+		case *ast.KeyValueExpr:
+			return collect(e.Value)
+		case *ast.CompositeLit:
+			for _, elt := range e.Elts {
+				if !collect(elt) {
+					return false
+				}
+			}
+			return true
+		case *ast.BasicLit:
+			val, err := strconv.ParseUint(e.Value, 0, 64)
+			vals = append(vals, val)
+			return err == nil
+		}
+		return false
+	}
+	if !collect(lit) || len(vals) != 11 {
+		return ""
+	}
+	hex := func(val uint64, width int) string {
+		s := strings.ToUpper(strconv.FormatUint(val, 16))
+		return strings.Repeat("0", width-len(s)) + s
+	}
+	s := hex(vals[0], 8) + "-" + hex(vals[1], 4) + "-" + hex(vals[2], 4) + "-"
+	for n := 3; n < 11; n++ {
+		if n == 5 {
+			s += "-"
+		}
+		s += hex(vals[n], 2)
+	}
+	return s
+}
+
+func sortedKeys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
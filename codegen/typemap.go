@@ -0,0 +1,207 @@
+package codegen
+
+// Type mapping overrides, as read from a file:
+//
+//	{
+//	  "types": [
+//	    {"type": "OLE_COLOR", "goType": "Color"},
+//	    {"vt": "date", "goType": "ole.Date"},
+//	    {"type": "wirePSAFEARRAY", "nativeType": "**win32.SAFEARRAY"}
+//	  ]
+//	}
+//
+// An entry applies to the types the typelib names "type" (an alias, or
+// any user defined type), or else to those of "vt", named as in dumps
+// ("bool", "bstr", "date"..). A goType such as Color may be declared in
+// a file of its own in the generated package. The members are:
+//
+//	goType:      the Go type of the parameters and return values of methods
+//	nativeType:  the Go type of the value as laid out, in structs and by
+//	             pointers; it must have the layout of the typelib's type
+//	toNative:    the syscall argument of a goType value $
+//	fromNative:  the goType value of the syscall result $
+//	toVariant:   the value passed to Invoke in a VARIANT for a goType $
+//	fromVariant: the goType value of the VARIANT $
+//
+// Those left out follow from the types: a goType that is not the native
+// type is converted to and from it, as in Color($) and uint32($), and
+// then passed as the native type is. Pointers to numbers mapped so, as
+// out parameters, point to the goType, which must then be of the same
+// size.
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/zzl/go-tlbimp/typelib"
+	"os"
+	"strings"
+)
+
+// TypeMapping is how a type is mapped to Go, in place of the defaults
+type TypeMapping struct {
+	Type        string `json:"type,omitempty"`
+	Vt          string `json:"vt,omitempty"`
+	GoType      string `json:"goType,omitempty"`
+	NativeType  string `json:"nativeType,omitempty"`
+	ToNative    string `json:"toNative,omitempty"`
+	FromNative  string `json:"fromNative,omitempty"`
+	ToVariant   string `json:"toVariant,omitempty"`
+	FromVariant string `json:"fromVariant,omitempty"`
+}
+
+// TypeMap is the type mappings of a file, by type name and by VT
+type TypeMap struct {
+	Types []*TypeMapping `json:"types"`
+
+	byType map[string]*TypeMapping
+	byVt   map[typelib.VARENUM]*TypeMapping
+}
+
+// ReadTypeMap reads a type mapping file
+func ReadTypeMap(filePath string) (*TypeMap, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var typeMap TypeMap
+	err = json.Unmarshal(data, &typeMap)
+	if err != nil {
+		return nil, err
+	}
+	typeMap.byType = make(map[string]*TypeMapping)
+	typeMap.byVt = make(map[typelib.VARENUM]*TypeMapping)
+	for _, m := range typeMap.Types {
+		if (m.Type == "") == (m.Vt == "") {
+			return nil, errors.New("a mapping needs either a type or a vt")
+		}
+		name := m.Type
+		if name == "" {
+			name = m.Vt
+		}
+		if m.GoType == "" && m.NativeType == "" {
+			return nil, errors.New(name + ": no goType or nativeType")
+		}
+		if m.Type != "" {
+			typeMap.byType[m.Type] = m
+			continue
+		}
+		vt, ok := typelib.ParseVt(m.Vt)
+		if _, named := typelib.GoTypeNames[vt]; !ok || !named || vt == typelib.VT_PTR {
+			return nil, errors.New("unsupported vt " + m.Vt)
+		}
+		typeMap.byVt[vt] = m
+	}
+	return &typeMap, nil
+}
+
+// Lookup returns the mapping of t, nil if there is none
+func (this *TypeMap) Lookup(t *typelib.VarType) *TypeMapping {
+	if this == nil || t == nil {
+		return nil
+	}
+	if m := this.byType[t.TypeName]; m != nil && t.TypeName != "" {
+		return m
+	}
+	return this.byVt[t.Vt]
+}
+
+// LookupPointee returns the mapping of the number a pointer t points to,
+// if it is converted by default, so that the pointer is to its goType
+func (this *TypeMap) LookupPointee(t *typelib.VarType) *TypeMapping {
+	pointee := t.Pointee()
+	if t.Vt != typelib.VT_PTR || pointee == nil || !pointee.Native {
+		return nil
+	}
+	m := this.Lookup(pointee)
+	if m == nil || m.GoType == "" || m.NativeType != "" ||
+		m.ToNative != "" || m.FromNative != "" {
+		return nil
+	}
+	return m
+}
+
+// goType returns the Go type of the values of t, of the mapping or native
+func (this *TypeMapping) goType(t *typelib.VarType) string {
+	if this.GoType != "" {
+		return this.GoType
+	}
+	return t.Name
+}
+
+// fromVariantExpr is the value of the VARIANT expr of a mapped type t
+func fromVariantExpr(m *TypeMapping, t *typelib.VarType, expr string) string {
+	if m.FromVariant != "" {
+		return expandTypeExpr(m.FromVariant, expr)
+	}
+	castExpr := expandTypeExpr(t.PVarCastExpr, expr)
+	if m.goType(t) == t.Name {
+		return castExpr
+	}
+	return m.goType(t) + "(" + castExpr + ")"
+}
+
+// expandTypeExpr substitutes expr for the $ of an expression of a mapping
+func expandTypeExpr(s string, expr string) string {
+	return strings.Replace(s, "$", expr, -1)
+}
+
+// applyTypeMap gives the types mapped to a nativeType its name, the
+// records and unions of go-win32api theirs, the enums of imported
+// libraries with no package their int type, and the pointers and arrays
+// of them the names that follow
+func (this *Generator) applyTypeMap() {
+	var rename func(t *typelib.VarType) string
+	rename = func(t *typelib.VarType) string { //the name replaced, if any
+		if t == nil {
+			return ""
+		}
+		oldRefName := rename(t.RefType)
+		if m := this.TypeMap.Lookup(t); m != nil && m.NativeType != "" {
+			oldName := t.Name
+			t.Name = m.NativeType
+			return oldName
+		}
+		if t.Vt == typelib.VT_USERDEFINED && (t.Kind == typelib.TKIND_RECORD ||
+			t.Kind == typelib.TKIND_UNION) && this.isWin32VarType(t) {
+			oldName := t.Name
+			t.Name = "win32." + t.Name
+			return oldName
+		}
+		if t.Enum && t.ImpLib != nil && this.impPkg(t.ImpLib) == "" {
+			oldName, impLib := t.Name, t.ImpLib
+			*t = *t.Underlying
+			t.ImpLib = impLib //still reported as used
+			return oldName
+		}
+		if oldRefName == "" || oldRefName == t.RefType.Name {
+			return ""
+		}
+		oldName := t.Name
+		if t.Vt == typelib.VT_PTR && t.Name == "*"+oldRefName {
+			t.Name = "*" + t.RefType.Name
+		} else if t.Vt == typelib.VT_CARRAY && strings.HasSuffix(t.Name, "]"+oldRefName) {
+			t.Name = strings.TrimSuffix(t.Name, oldRefName) + t.RefType.Name
+		}
+		if t.Name == oldName {
+			return ""
+		}
+		return oldName
+	}
+	for _, ti := range this.typeInfos {
+		for _, ti := range []*typelib.TypeInfo{ti, ti.DualInterface} {
+			if ti == nil {
+				continue
+			}
+			rename(ti.RelType)
+			for _, f := range ti.Fields {
+				rename(f.Type)
+			}
+			for _, f := range ti.Funcs {
+				rename(f.ReturnType)
+				for _, p := range f.Params {
+					rename(p.Type)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package codegen
+
+import (
+	"github.com/zzl/go-tlbimp/typelib"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func readTestTypeMap(t *testing.T, data string) (*TypeMap, error) {
+	path := filepath.Join(t.TempDir(), "typemap.json")
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return ReadTypeMap(path)
+}
+
+const testTypeMap = `{"types": [
+	{"type": "OLE_COLOR", "goType": "Color"},
+	{"vt": "date", "goType": "time.Time", "toNative": "toDate($)", "fromNative": "fromDate($)"},
+	{"type": "Handle", "nativeType": "win32.HANDLE"}
+]}`
+
+func TestReadTypeMap(t *testing.T) {
+	tests := []struct {
+		data string
+		err  string
+	}{
+		{testTypeMap, ""},
+		{`{"types": []}`, ""},
+		{`{"types": [{"type": "OLE_COLOR", "vt": "ui4", "goType": "Color"}]}`, "either a type or a vt"},
+		{`{"types": [{"goType": "Color"}]}`, "either a type or a vt"},
+		{`{"types": [{"type": "OLE_COLOR"}]}`, "OLE_COLOR: no goType or nativeType"},
+		{`{"types": [{"vt": "ptr", "goType": "uintptr"}]}`, "unsupported vt ptr"},
+		{`{"types": [{"vt": "nope", "goType": "uintptr"}]}`, "unsupported vt nope"},
+		{`{"types": [`, "unexpected end"},
+	}
+	for _, test := range tests {
+		_, err := readTestTypeMap(t, test.data)
+		if test.err == "" && err != nil || test.err != "" && (err == nil ||
+			!strings.Contains(err.Error(), test.err)) {
+			t.Errorf("%s: error %v, want %q", test.data, err, test.err)
+		}
+	}
+	if _, err := ReadTypeMap(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("no error for a missing file")
+	}
+}
+
+func TestTypeMapLookup(t *testing.T) {
+	typeMap, err := readTestTypeMap(t, testTypeMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		t      *typelib.VarType
+		goType string
+	}{
+		{&typelib.VarType{Vt: typelib.VT_USERDEFINED, TypeName: "OLE_COLOR"}, "Color"},
+		{&typelib.VarType{Vt: typelib.VT_DATE}, "time.Time"},
+		{&typelib.VarType{Vt: typelib.VT_DATE, TypeName: "DATE"}, "time.Time"},
+		{&typelib.VarType{Vt: typelib.VT_I4}, ""},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		goType := ""
+		if m := typeMap.Lookup(test.t); m != nil {
+			goType = m.GoType
+		}
+		if goType != test.goType {
+			t.Errorf("%+v: mapped to %q, want %q", test.t, goType, test.goType)
+		}
+	}
+	var none *TypeMap
+	if none.Lookup(tests[0].t) != nil {
+		t.Error("a nil TypeMap maps a type")
+	}
+}
+
+func TestTypeMapCode(t *testing.T) {
+	typeMap, err := readTestTypeMap(t, testTypeMap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := generate(t, &Generator{TypeMap: typeMap}, `[uuid(60000020-0000-0000-0000-000000000000)]
+library MapLib {
+	importlib("stdole2.tlb");
+	typedef long Handle;
+	typedef struct Item { OLE_COLOR color; Handle h; DATE when; } Item;
+	[object, uuid(60000021-0000-0000-0000-000000000000)]
+	interface IPaint : IUnknown {
+		HRESULT SetColor([in] OLE_COLOR c);
+		HRESULT GetColor([out, retval] OLE_COLOR* c);
+		HRESULT Stamp([in] DATE d, [out, retval] DATE* r);
+		HRESULT Use([in] Handle h);
+	};
+	[object, dual, uuid(60000022-0000-0000-0000-000000000000)]
+	interface IDispPaint : IDispatch {
+		[propget, id(1)] HRESULT Color([out, retval] OLE_COLOR* c);
+		[id(2)] HRESULT Stamp([in] DATE d);
+	};
+};`)
+	tests := []struct {
+		file string
+		code string
+	}{
+		//a goType is converted to and from the native type
+		{"IPaint.go", "SetColor(c Color) com.Error {"},
+		{"IPaint.go", "uintptr(uint32(c)))"},
+		{"IDispPaint.go", "Color() Color {"},
+		{"IDispPaint.go", "return Color(retVal.UintValVal())"},
+		//an out parameter of a number points to the goType
+		{"IPaint.go", "GetColor(c *Color) com.Error {"},
+		//conversions of the mapping, and none for pointers then
+		{"IPaint.go", "Stamp(d time.Time, r *ole.Date) com.Error {"},
+		{"IPaint.go", "toDate(d), uintptr(unsafe.Pointer(r)))"},
+		{"IPaint.go", "\t\"time\"\n"},
+		//a nativeType names the type everywhere
+		{"IPaint.go", "Use(h win32.HANDLE) com.Error {"},
+		{"types.go", "type Item struct {\n\tColor uint32\n\tH win32.HANDLE\n\tWhen ole.Date\n}\n"},
+	}
+	for _, test := range tests {
+		if code := files[test.file]; !strings.Contains(code, test.code) {
+			t.Errorf("%s has no %q:\n%s", test.file, test.code, code)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"github.com/zzl/go-tlbimp/codegen"
@@ -14,29 +15,57 @@ var tlbPath string
 var outputDir string
 var sRefTlbs string
 var sRefPkgs string
+var impMapPath string
+var impDir string
+var listRes bool
+var sArchs string
+var constEnums bool
+var typeMapPath string
 
 func main() {
 
-	flag.StringVar(&tlbPath, "tlb", "", "target tlb file path")
+	if len(os.Args) > 1 && os.Args[1] == "dump" {
+		dumpMain(os.Args[2:])
+		return
+	}
+
+	flag.StringVar(&tlbPath, "tlb", "", "target tlb file path (or dll/exe/ocx, with optional \\N resource index, idl/odl source, MIDL header, winmd file or json dump)")
+	flag.BoolVar(&listRes, "list", false, "list the TYPELIB resources in the -tlb file")
 	flag.StringVar(&outputDir, "out-dir", "", "output directory")
 
 	flag.StringVar(&sRefTlbs, "imp-tlbs", "", "import tlb file paths(; separated)")
 	flag.StringVar(&sRefPkgs, "imp-pkgs", "", "import package names(; separated)")
+	flag.StringVar(&impMapPath, "imp-map", "", "file mapping the LIBIDs of imported typelibs to packages, "+
+		"a \"{LIBID} package\" per line")
+	flag.StringVar(&impDir, "imp-dir", "", "directory of known tlbs to resolve imported types from")
+	flag.StringVar(&sArchs, "arch", "", "target GOARCH: 386, amd64 or arm64, several (, separated) or all "+
+		"for per-arch files where they differ (default by the typelib's syskind)")
+	flag.StringVar(&typeMapPath, "type-map", "", "json file overriding how types are mapped to Go")
+	flag.BoolVar(&constEnums, "const-enums", false, "generate enums as typed constants, with String, Values and Parse funcs")
 
 	flag.Parse()
+	if listRes && tlbPath != "" {
+		listTypeLibResources(tlbPath)
+		return
+	}
 	if tlbPath == "" || outputDir == "" {
 		flag.Usage()
 		return
 	}
 
-	if !utils.FileExists(tlbPath) {
+	if !tlbExists(tlbPath) {
 		println("Target tlb not found: " + tlbPath)
 		return
 	}
+	archs, ok := parseArchs(sArchs)
+	if !ok {
+		println("Unsupported arch: " + sArchs)
+		return
+	}
 
 	tlb, err := typelib.NewTypeLibFromFile(tlbPath)
 	if err != nil {
-		println("Failed to load " + tlbPath)
+		println("Failed to load " + tlbPath + ": " + err.Error())
 		return
 	}
 
@@ -72,22 +101,174 @@ func main() {
 	var generator codegen.Generator
 	generator.TypeLib = tlb
 	generator.OutputPath = outputDir
+	generator.Archs = archs
+	generator.ConstEnums = constEnums
 
-	generator.RefLibMap = make(map[string]*typelib.TypeLib)
+	generator.RefLibMap = make(map[string]typelib.Source)
 	for n, refTlbPath := range refTlbPaths {
-		if !utils.FileExists(refTlbPath) {
+		if !tlbExists(refTlbPath) {
 			println("Ref tlb not found: " + refTlbPath)
 			return
 		}
 		refTlb, err := typelib.NewTypeLibFromFile(refTlbPath)
 		if err != nil {
-			println("Failed to load " + refTlbPath)
+			println("Failed to load " + refTlbPath + ": " + err.Error())
 			return
 		}
 		refPkg := refPkgs[n]
 		generator.RefLibMap[refPkg] = refTlb
 	}
+	if impMapPath != "" {
+		generator.ImpPkgMap, err = readImpMap(impMapPath)
+		if err != nil {
+			println("Failed to read " + impMapPath + ": " + err.Error())
+			return
+		}
+	}
+	if typeMapPath != "" {
+		generator.TypeMap, err = codegen.ReadTypeMap(typeMapPath)
+		if err != nil {
+			println("Failed to read " + typeMapPath + ": " + err.Error())
+			return
+		}
+	}
+	if lib, ok := tlb.(*typelib.TypeLib); ok {
+		resolveImports(lib, refTlbPaths, impDir)
+	}
 
 	generator.Generate()
 	println("Done.")
 }
+
+// parseArchs parses the -arch option, nil for the default
+func parseArchs(s string) ([]string, bool) {
+	if s == "" {
+		return nil, true
+	}
+	if s == "all" {
+		return utils.Archs, true
+	}
+	archs := strings.Split(s, ",")
+	for _, arch := range archs {
+		if utils.ArchPtrSize(arch) == 0 {
+			return nil, false
+		}
+	}
+	return archs, true
+}
+
+// readImpMap reads a "{LIBID} package" per line, # for comments
+func readImpMap(filePath string) (map[typelib.GUID]string, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	impMap := make(map[typelib.GUID]string)
+	for n, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || line[0] == '#' {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("line %d: expected a LIBID and a package", n+1)
+		}
+		libId, err := typelib.ParseGuid(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", n+1, err)
+		}
+		impMap[libId] = fields[1]
+	}
+	return impMap, nil
+}
+
+// resolveImports fills in the types of imported libraries that the
+// pure-Go readers leave as placeholders, from the -imp-tlbs libraries
+// and then from those found in the -imp-dir directory
+func resolveImports(lib *typelib.TypeLib, refTlbPaths []string, impDir string) {
+	var refLibs []*typelib.TypeLib
+	for _, refTlbPath := range refTlbPaths {
+		refLib, err := typelib.ReadTypeLibFile(refTlbPath)
+		if err == nil {
+			refLibs = append(refLibs, refLib)
+		}
+	}
+	missing := lib.ResolveImports(refLibs)
+	if len(missing) != 0 && impDir != "" {
+		lib.ResolveImports(typelib.ReadTypeLibDir(impDir, missing))
+	}
+}
+
+func tlbExists(tlbPath string) bool {
+	filePath, _ := typelib.SplitResourcePath(tlbPath)
+	return utils.FileExists(filePath)
+}
+
+func listTypeLibResources(filePath string) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		println("Failed to read " + filePath + ": " + err.Error())
+		return
+	}
+	resList, err := typelib.ListTypeLibResources(data)
+	if err != nil {
+		println("Failed to read resources: " + err.Error())
+		return
+	}
+	if len(resList) == 0 {
+		println("No TYPELIB resource found.")
+		return
+	}
+	for _, res := range resList {
+		line := filePath + "\\" + res.String()
+		tlb, err := typelib.NewTypeLibFromBytes(res.Data)
+		if err != nil {
+			line += "\t(" + err.Error() + ")"
+		} else {
+			attr := tlb.GetLibAttr()
+			line += "\t" + tlb.GetName() + "\t" + fmt.Sprintf("%d.%d", attr.MajorVer, attr.MinorVer) +
+				"\t{" + attr.Guid.String() + "}"
+		}
+		fmt.Println(line)
+	}
+}
+
+// dumpMain writes the typelib model as json, see typelib/dump.go
+func dumpMain(args []string) {
+	flags := flag.NewFlagSet("dump", flag.ExitOnError)
+	flags.StringVar(&tlbPath, "tlb", "", "target tlb file path")
+	var outPath string
+	flags.StringVar(&outPath, "out", "", "output json file path (stdout if not specified)")
+	flags.Parse(args)
+	if tlbPath == "" {
+		flags.Usage()
+		return
+	}
+	if !tlbExists(tlbPath) {
+		println("Target tlb not found: " + tlbPath)
+		return
+	}
+	tlb, err := typelib.NewTypeLibFromFile(tlbPath)
+	if err != nil {
+		println("Failed to load " + tlbPath + ": " + err.Error())
+		return
+	}
+	dump, errs := typelib.NewTypeLibDump(tlb)
+	for _, err := range errs {
+		println("warning: skipped " + err.Error())
+	}
+	data, err := json.MarshalIndent(dump, "", "  ")
+	if err != nil {
+		println("Failed to dump " + tlbPath + ": " + err.Error())
+		return
+	}
+	data = append(data, '\n')
+	if outPath == "" {
+		os.Stdout.Write(data)
+		return
+	}
+	err = os.WriteFile(outPath, data, 0644)
+	if err != nil {
+		println("Failed to write " + outPath)
+	}
+}
@@ -0,0 +1,52 @@
+package typelib
+
+import (
+	"github.com/zzl/go-com/ole"
+	"github.com/zzl/go-win32api/v2/win32"
+)
+
+func NewParamInfo(pTypeInfo *win32.ITypeInfo, pFuncDesc *win32.FUNCDESC,
+	name string, pParamDesc win32.ELEMDESC, cParams int, index int) (*ParamInfo, error) {
+	return newParamInfo(nil, pTypeInfo, pFuncDesc, name, pParamDesc, cParams, index)
+}
+
+func newParamInfo(g *comGraph, pTypeInfo *win32.ITypeInfo, pFuncDesc *win32.FUNCDESC,
+	name string, pParamDesc win32.ELEMDESC, cParams int, index int) (*ParamInfo, error) {
+
+	info := &ParamInfo{
+		Name:     name,
+		CustData: getFuncCustData(pTypeInfo, pFuncDesc, index),
+	}
+
+	idlFlags := pParamDesc.IdldescVal().WIDLFlags
+	if idlFlags&win32.IDLFLAG_FIN != 0 {
+		info.Flags.In = true
+	}
+	if idlFlags&win32.IDLFLAG_FOUT != 0 {
+		info.Flags.Out = true
+	}
+	if idlFlags&win32.IDLFLAG_FRETVAL != 0 {
+		info.Flags.Retval = true
+	}
+	if idlFlags&win32.IDLFLAG_FLCID != 0 {
+		info.Flags.Lcid = true
+	}
+
+	if win32.PARAMFLAGS(idlFlags)&win32.PARAMFLAG_FOPT != 0 {
+		info.Flags.Optional = true
+	}
+	if win32.PARAMFLAGS(idlFlags)&win32.PARAMFLAG_FHASDEFAULT != 0 {
+		info.Flags.HasDefault = true
+		pDescEx := pParamDesc.ParamdescVal().Pparamdescex
+		if pDescEx != nil {
+			info.DefaultValue = (*ole.Variant)(&pDescEx.VarDefaultValue).Value()
+		}
+	}
+
+	var err error
+	info.Type, err = _newVarType(g, pTypeInfo, &pParamDesc.Tdesc, true)
+	if err != nil {
+		return nil, withContext(err, "", "", index)
+	}
+	return info, nil
+}
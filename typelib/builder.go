@@ -0,0 +1,467 @@
+package typelib
+
+import (
+	"fmt"
+	"github.com/zzl/go-tlbimp/utils"
+	"strings"
+)
+
+// Builds the TypeInfo model from the descriptors produced by the
+// pure-Go readers, following what NewTypeInfo does for ITypeInfo.
+
+// typeGraph holds the TypeInfos built from the entries of a library for
+// an arch, each built once: the super types, dual interfaces and VarTypes
+// that refer to a type share its TypeInfo
+type typeGraph struct {
+	arch  string
+	infos map[typeGraphKey]*TypeInfo
+	errs  map[typeGraphKey]error //of the types that could not be built
+}
+
+// a dual dispinterface is also seen as an interface
+type typeGraphKey struct {
+	e    *typeEntry
+	kind TYPEKIND
+}
+
+func newTypeGraph() *typeGraph {
+	return &typeGraph{
+		arch:  utils.Arch,
+		infos: make(map[typeGraphKey]*TypeInfo),
+		errs:  make(map[typeGraphKey]error),
+	}
+}
+
+// typeInfo returns the TypeInfo of e seen as kind, building it the first
+// time. It is registered before its members are built, for the types
+// referring back to it.
+func (this *typeGraph) typeInfo(e *typeEntry, kind TYPEKIND) *TypeInfo {
+	key := typeGraphKey{e, kind}
+	if info, ok := this.infos[key]; ok {
+		return info
+	}
+	info := &TypeInfo{}
+	this.infos[key] = info
+	if err := this.buildTypeInfo(info, e, kind); err != nil {
+		this.errs[key] = withContext(err, e.name, "", -1)
+	}
+	return info
+}
+
+// typeInfoErr returns the error building the TypeInfo of e as kind
+func (this *typeGraph) typeInfoErr(e *typeEntry, kind TYPEKIND) error {
+	return this.errs[typeGraphKey{e, kind}]
+}
+
+// buildTypeInfo builds the type of e seen as kind, leaving out the members
+// that cannot be built, with their errors in Errors. An error is returned
+// if the type itself cannot be.
+func (this *typeGraph) buildTypeInfo(info *TypeInfo, e *typeEntry, kind TYPEKIND) error {
+	info.Name = e.name
+	info.Doc = e.doc
+	info.Guid = e.guid
+	info.Kind = kind
+	info.CustData = e.custData
+	info.ImpLib = e.impLib
+	info.SizeInstance, info.Alignment = e.sizeInstance, e.alignment
+
+	dual := kind == TKIND_DISPATCH && e.isDual()
+	funcs := e.funcs
+	if dual {
+		funcs = collectDispFuncs(e)
+	}
+
+	info.Flags.Hidden = e.flags&TYPEFLAG_FHIDDEN != 0
+	info.Flags.Dual = e.flags&TYPEFLAG_FDUAL != 0
+	info.Flags.OleAutomation = e.flags&TYPEFLAG_FOLEAUTOMATION != 0
+	info.Flags.Restricted = e.flags&TYPEFLAG_FRESTRICTED != 0
+
+	addField := func(v *varEntry, withValue bool) {
+		fi, err := newFieldInfoFromEntry(this, v, withValue)
+		if err != nil {
+			info.addError(err, v.name)
+			return
+		}
+		info.Fields = append(info.Fields, fi)
+	}
+	addFunc := func(f *funcEntry, dispFunc bool) *FuncInfo {
+		fi, err := newFuncInfoFromEntry(this, f, kind, dispFunc)
+		if err != nil {
+			info.addError(err, f.name)
+			return nil
+		}
+		info.Funcs = append(info.Funcs, fi)
+		return fi
+	}
+
+	var err error
+	switch kind {
+	case TKIND_ALIAS:
+		info.RelType, err = newVarTypeFromDesc(this, e.alias, true)
+	case TKIND_ENUM:
+		for _, v := range e.vars {
+			addField(v, true)
+		}
+	case TKIND_RECORD:
+		for _, v := range e.vars {
+			addField(v, false)
+		}
+		info.Size, info.Align = getEntryStructSize(e)
+	case TKIND_UNION:
+		for _, v := range e.vars {
+			addField(v, false)
+		}
+		info.Size, info.Align = getEntryUnionSize(e)
+	case TKIND_INTERFACE:
+		if len(e.impls) > 0 && e.impls[0].ref != nil {
+			info.Super = this.interfaceTypeInfo(e.impls[0].ref)
+		}
+		for _, f := range funcs {
+			if f.vtblIndex < 0 {
+				info.addError(newTypeError("unknown vtable slot, "+
+					"the base interface is of a library that was not read"), f.name)
+				continue
+			}
+			addFunc(f, false)
+		}
+	case TKIND_DISPATCH:
+		info.Super = this.typeInfo(stdoleDispatch(), TKIND_INTERFACE)
+		info.DispInterface = true
+		if dual {
+			info.DualInterface = this.typeInfo(e, TKIND_INTERFACE)
+		}
+		for _, v := range e.vars {
+			addField(v, false)
+		}
+		for _, f := range funcs {
+			addFunc(f, true)
+		}
+	case TKIND_MODULE:
+		info.DllName = e.dllName
+		for _, v := range e.vars {
+			addField(v, v.varKind == VAR_CONST)
+		}
+		for _, f := range funcs {
+			if fi := addFunc(f, false); fi != nil {
+				fi.DllEntry = f.dllEntry
+				fi.Ordinal = f.ordinal
+			}
+		}
+	case TKIND_COCLASS:
+		for _, impl := range e.impls {
+			if impl.ref == nil {
+				break
+			}
+			info.ImplTypes = append(info.ImplTypes, &ImplType{
+				Name:          impl.ref.name,
+				Guid:          impl.ref.guid,
+				Default:       impl.flags&IMPLTYPEFLAG_FDEFAULT != 0,
+				Source:        impl.flags&IMPLTYPEFLAG_FSOURCE != 0,
+				DispInterface: impl.ref.kind == TKIND_DISPATCH,
+				CustData:      impl.custData,
+			})
+		}
+	}
+	info.FuncCount = len(info.Funcs)
+	info.FieldCount = len(info.Fields)
+	return err
+}
+
+// the vtable part of a dual interface is seen as an interface
+func (this *typeGraph) interfaceTypeInfo(e *typeEntry) *TypeInfo {
+	if e.kind == TKIND_DISPATCH && e.isDual() {
+		return this.typeInfo(e, TKIND_INTERFACE)
+	}
+	return this.typeInfo(e, e.kind)
+}
+
+// collectDispFuncs returns the members of a dual interface as its
+// dispatch view reports them, inherited ones included.
+func collectDispFuncs(e *typeEntry) []*funcEntry {
+	var funcs []*funcEntry
+	if len(e.impls) > 0 && e.impls[0].ref != nil {
+		funcs = collectDispFuncs(e.impls[0].ref)
+	}
+	for _, f := range e.funcs {
+		funcs = append(funcs, f.toDispatch())
+	}
+	return funcs
+}
+
+func newFuncInfoFromEntry(g *typeGraph, f *funcEntry, kind TYPEKIND, dispFunc bool) (*FuncInfo, error) {
+	info := &FuncInfo{
+		Id:         f.memid,
+		Kind:       f.funcKind,
+		CallConv:   f.callConv,
+		VtblOffset: f.vtblIndex * utils.PtrSize,
+		ParamsOpt:  f.cParamsOpt,
+	}
+
+	info.Flags.PropGet = f.invKind&INVOKE_PROPERTYGET != 0
+	info.Flags.PropPut = f.invKind&INVOKE_PROPERTYPUT != 0
+	info.Flags.PropPutRef = f.invKind&INVOKE_PROPERTYPUTREF != 0
+	info.Flags.Restricted = f.flags&FUNCFLAG_FRESTRICTED != 0
+	info.Flags.Hidden = f.flags&FUNCFLAG_FHIDDEN != 0
+	info.Flags.Vararg = f.cParamsOpt == -1
+
+	info.Name = f.name
+	info.Doc = f.doc
+	info.CustData = f.custData
+
+	cParams := len(f.params)
+	if dispFunc {
+		for n, p := range f.params {
+			if p.flags&(PARAMFLAG_FLCID|PARAMFLAG_FRETVAL) != 0 {
+				cParams = n
+				break
+			}
+		}
+	}
+
+	rhsNamed := false
+	for n := 0; n < cParams; n++ {
+		p := f.params[n]
+		name := p.name
+		if name == "" && !rhsNamed {
+			name = "rhs"
+			rhsNamed = true
+		}
+		pi, err := newParamInfoFromEntry(g, p, name)
+		if err != nil {
+			return nil, withContext(err, "", info.Name, n)
+		}
+		info.Params = append(info.Params, pi)
+	}
+
+	var err error
+	info.ReturnType, err = newVarTypeFromDesc(g, f.ret, true)
+	if err != nil {
+		return nil, withContext(err, "", info.Name, -1)
+	}
+	return info, nil
+}
+
+func newParamInfoFromEntry(g *typeGraph, p *paramEntry, name string) (*ParamInfo, error) {
+	info := &ParamInfo{
+		Name:     name,
+		CustData: p.custData,
+	}
+	info.Flags.In = p.flags&PARAMFLAG_FIN != 0
+	info.Flags.Out = p.flags&PARAMFLAG_FOUT != 0
+	info.Flags.Retval = p.flags&PARAMFLAG_FRETVAL != 0
+	info.Flags.Optional = p.flags&PARAMFLAG_FOPT != 0
+	info.Flags.HasDefault = p.flags&PARAMFLAG_FHASDEFAULT != 0
+	info.Flags.Lcid = p.flags&PARAMFLAG_FLCID != 0
+	if info.Flags.HasDefault {
+		info.DefaultValue = p.value
+	}
+
+	var err error
+	info.Type, err = newVarTypeFromDesc(g, p.typ, true)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+func newFieldInfoFromEntry(g *typeGraph, v *varEntry, withValue bool) (*FieldInfo, error) {
+	fi := &FieldInfo{
+		Id:       v.memid,
+		Name:     v.name,
+		Doc:      v.doc,
+		CustData: v.custData,
+	}
+	fi.Flags.ReadOnly = v.flags&VARFLAG_FREADONLY != 0
+	fi.Flags.Hidden = v.flags&VARFLAG_FHIDDEN != 0
+	fi.Flags.Restricted = v.flags&VARFLAG_FRESTRICTED != 0
+	var err error
+	fi.Type, err = newVarTypeFromDesc(g, v.typ, true)
+	if err != nil {
+		return nil, withContext(err, "", fi.Name, -1)
+	}
+	if v.varKind == VAR_PERINSTANCE {
+		fi.Offset = v.oInst
+	}
+	if withValue {
+		fi.Value = v.value
+	}
+	return fi, nil
+}
+
+// newVarTypeFromDesc builds the type of d, the user defined ones pointing
+// to their TypeInfo in g if not nil
+func newVarTypeFromDesc(g *typeGraph, d *typeDesc, resolveIndirectRefType bool) (*VarType, error) {
+	t, ok := newAutomationVarType(d.vt, resolveIndirectRefType)
+	if !ok {
+		switch d.vt {
+		case VT_PTR:
+			var ref *VarType
+			if resolveIndirectRefType {
+				var err error
+				ref, err = newVarTypeFromDesc(g, d.elem, true)
+				if err != nil {
+					return nil, err
+				}
+			}
+			setPtrVarType(&t, ref)
+		case VT_CARRAY:
+			elem, err := newVarTypeFromDesc(g, d.elem, resolveIndirectRefType)
+			if err != nil {
+				return nil, err
+			}
+			setArrayVarType(&t, elem, d.dims)
+		case VT_USERDEFINED:
+			err := newUserDefinedVarType(g, &t, d.ref, resolveIndirectRefType)
+			if err != nil {
+				return nil, err
+			}
+			if d.ref != nil {
+				t.ImpLib = d.ref.impLib
+			}
+		default:
+			return nil, newTypeError(fmt.Sprintf("unsupported VT 0x%x", d.vt))
+		}
+	}
+	if t.Align == 0 {
+		t.Align = t.Size
+	}
+	return &t, nil
+}
+
+// descSize returns the size and alignment of the type of d, 0 for the
+// types that cannot be built, whose members are left out
+func descSize(d *typeDesc) (int, int) {
+	t, err := newVarTypeFromDesc(nil, d, false)
+	if err != nil {
+		return 0, 0
+	}
+	return t.Size, t.Align
+}
+
+func newUserDefinedVarType(g *typeGraph, t *VarType, ref *typeEntry, resolveIndirectRefType bool) error {
+	if ref == nil || ref.name == "" {
+		//unresolved import
+		*t, _ = newAutomationVarType(VT_UNKNOWN, resolveIndirectRefType)
+		return nil
+	}
+	t.Vt = VT_USERDEFINED
+	t.Kind = ref.kind
+	t.TypeName = ref.name
+	t.Name = utils.CapName(ref.name)
+
+	if strings.HasPrefix(t.Name, "MIDL_IWinTypes") {
+		t.Native = true
+		t.Name = "uintptr"
+		t.Unsigned = true
+		t.Size = utils.PtrSize
+		return nil
+	}
+	if strings.HasPrefix(t.Name, "Wire") { //?
+		t.Name = "win32." + t.Name[4:]
+		t.Native = true
+		t.Unsigned = true
+		t.Size = utils.PtrSize
+		return nil
+	}
+
+	switch ref.kind {
+	case TKIND_ENUM:
+		desc := vtDesc(VT_I4)
+		if len(ref.vars) > 0 {
+			desc = ref.vars[0].typ
+		}
+		base, err := newVarTypeFromDesc(g, desc, true)
+		if err != nil {
+			return err
+		}
+		setEnumVarType(t, base)
+	case TKIND_RECORD:
+		if t.Name == "GUID" {
+			setGuidVarType(t)
+			break
+		}
+		t.Struct = true
+		t.Size, t.Align = getEntryStructSize(ref)
+	case TKIND_COCLASS:
+		t.Interface = true
+		for _, impl := range ref.impls {
+			if impl.ref != nil && impl.flags&IMPLTYPEFLAG_FDEFAULT != 0 &&
+				impl.flags&IMPLTYPEFLAG_FSOURCE == 0 {
+				t.DispInterface = impl.ref.kind == TKIND_DISPATCH
+			}
+		}
+	case TKIND_INTERFACE:
+		t.Interface = true
+	case TKIND_DISPATCH:
+		t.Interface = true
+		t.DispInterface = true
+	case TKIND_ALIAS:
+		if t.Name == "GUID" {
+			setGuidVarType(t)
+			break
+		}
+		name0 := t.Name
+		aliased, err := newVarTypeFromDesc(g, ref.alias, resolveIndirectRefType)
+		if err != nil {
+			return err
+		}
+		*t = *aliased
+		t.TypeName = ref.name
+		if !t.Native {
+			t.Name = name0
+		}
+	case TKIND_UNION:
+		t.Struct = true
+		t.Size, t.Align = getEntryUnionSize(ref)
+	}
+	if g != nil {
+		t.TypeInfo = g.typeInfo(ref, ref.kind)
+	}
+	return nil
+}
+
+// setEnumVarType makes t the enum named by it, of the int type base
+func setEnumVarType(t *VarType, base *VarType) {
+	name := t.Name
+	*t = *base
+	t.Name = name
+	t.Vt = VT_USERDEFINED
+	t.Kind = TKIND_ENUM
+	t.Enum = true
+	t.Underlying = base
+	t.PVarCastExpr = name + "(" + base.PVarCastExpr + ")"
+}
+
+func setGuidVarType(t *VarType) {
+	t.Kind = TKIND_RECORD
+	t.Name = "syscall.GUID"
+	t.Struct = true
+	t.Size, t.Align = guidSize()
+}
+
+func getEntryStructSize(e *typeEntry) (int, int) {
+	fieldSizes := make([]utils.SizeInfo, len(e.vars))
+	for n, v := range e.vars {
+		size, align := descSize(v.typ)
+		fieldSizes[n] = utils.SizeInfo{TotalSize: size, AlignSize: align}
+	}
+	size := utils.StructSize(fieldSizes...)
+	return size.TotalSize, size.AlignSize
+}
+
+func getEntryUnionSize(e *typeEntry) (int, int) {
+	var maxSize, maxAlign int
+	for _, v := range e.vars {
+		size, align := descSize(v.typ)
+		if size > maxSize {
+			maxSize = size
+		}
+		if align > maxAlign {
+			maxAlign = align
+		}
+	}
+	if maxAlign > 1 {
+		maxSize = (maxSize + maxAlign - 1) / maxAlign * maxAlign
+	}
+	return maxSize, maxAlign
+}
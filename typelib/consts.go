@@ -0,0 +1,216 @@
+package typelib
+
+// Automation constants used by the model, with the same names and values
+// as their win32 counterparts, so that the package builds without win32.
+
+type TYPEKIND int32
+
+const (
+	TKIND_ENUM      TYPEKIND = 0
+	TKIND_RECORD    TYPEKIND = 1
+	TKIND_MODULE    TYPEKIND = 2
+	TKIND_INTERFACE TYPEKIND = 3
+	TKIND_DISPATCH  TYPEKIND = 4
+	TKIND_COCLASS   TYPEKIND = 5
+	TKIND_ALIAS     TYPEKIND = 6
+	TKIND_UNION     TYPEKIND = 7
+)
+
+type VARENUM uint16
+
+const (
+	VT_EMPTY           VARENUM = 0
+	VT_NULL            VARENUM = 1
+	VT_I2              VARENUM = 2
+	VT_I4              VARENUM = 3
+	VT_R4              VARENUM = 4
+	VT_R8              VARENUM = 5
+	VT_CY              VARENUM = 6
+	VT_DATE            VARENUM = 7
+	VT_BSTR            VARENUM = 8
+	VT_DISPATCH        VARENUM = 9
+	VT_ERROR           VARENUM = 10
+	VT_BOOL            VARENUM = 11
+	VT_VARIANT         VARENUM = 12
+	VT_UNKNOWN         VARENUM = 13
+	VT_DECIMAL         VARENUM = 14
+	VT_I1              VARENUM = 16
+	VT_UI1             VARENUM = 17
+	VT_UI2             VARENUM = 18
+	VT_UI4             VARENUM = 19
+	VT_I8              VARENUM = 20
+	VT_UI8             VARENUM = 21
+	VT_INT             VARENUM = 22
+	VT_UINT            VARENUM = 23
+	VT_VOID            VARENUM = 24
+	VT_HRESULT         VARENUM = 25
+	VT_PTR             VARENUM = 26
+	VT_SAFEARRAY       VARENUM = 27
+	VT_CARRAY          VARENUM = 28
+	VT_USERDEFINED     VARENUM = 29
+	VT_LPSTR           VARENUM = 30
+	VT_LPWSTR          VARENUM = 31
+	VT_RECORD          VARENUM = 36
+	VT_INT_PTR         VARENUM = 37
+	VT_UINT_PTR        VARENUM = 38
+	VT_FILETIME        VARENUM = 64
+	VT_BLOB            VARENUM = 65
+	VT_STREAM          VARENUM = 66
+	VT_STORAGE         VARENUM = 67
+	VT_STREAMED_OBJECT VARENUM = 68
+	VT_STORED_OBJECT   VARENUM = 69
+	VT_BLOB_OBJECT     VARENUM = 70
+	VT_CF              VARENUM = 71
+	VT_CLSID           VARENUM = 72
+	VT_VECTOR          VARENUM = 0x1000
+	VT_ARRAY           VARENUM = 0x2000
+	VT_BYREF           VARENUM = 0x4000
+	VT_TYPEMASK        VARENUM = 0xfff
+)
+
+type TYPEFLAGS int32
+
+const (
+	TYPEFLAG_FAPPOBJECT     TYPEFLAGS = 0x1
+	TYPEFLAG_FCANCREATE     TYPEFLAGS = 0x2
+	TYPEFLAG_FLICENSED      TYPEFLAGS = 0x4
+	TYPEFLAG_FPREDECLID     TYPEFLAGS = 0x8
+	TYPEFLAG_FHIDDEN        TYPEFLAGS = 0x10
+	TYPEFLAG_FCONTROL       TYPEFLAGS = 0x20
+	TYPEFLAG_FDUAL          TYPEFLAGS = 0x40
+	TYPEFLAG_FNONEXTENSIBLE TYPEFLAGS = 0x80
+	TYPEFLAG_FOLEAUTOMATION TYPEFLAGS = 0x100
+	TYPEFLAG_FRESTRICTED    TYPEFLAGS = 0x200
+	TYPEFLAG_FAGGREGATABLE  TYPEFLAGS = 0x400
+	TYPEFLAG_FREPLACEABLE   TYPEFLAGS = 0x800
+	TYPEFLAG_FDISPATCHABLE  TYPEFLAGS = 0x1000
+	TYPEFLAG_FREVERSEBIND   TYPEFLAGS = 0x2000
+	TYPEFLAG_FPROXY         TYPEFLAGS = 0x4000
+)
+
+type FUNCKIND int32
+
+const (
+	FUNC_VIRTUAL     FUNCKIND = 0
+	FUNC_PUREVIRTUAL FUNCKIND = 1
+	FUNC_NONVIRTUAL  FUNCKIND = 2
+	FUNC_STATIC      FUNCKIND = 3
+	FUNC_DISPATCH    FUNCKIND = 4
+)
+
+type INVOKEKIND int32
+
+const (
+	INVOKE_FUNC           INVOKEKIND = 1
+	INVOKE_PROPERTYGET    INVOKEKIND = 2
+	INVOKE_PROPERTYPUT    INVOKEKIND = 4
+	INVOKE_PROPERTYPUTREF INVOKEKIND = 8
+)
+
+type CALLCONV int32
+
+const (
+	CC_FASTCALL   CALLCONV = 0
+	CC_CDECL      CALLCONV = 1
+	CC_MSCPASCAL  CALLCONV = 2
+	CC_PASCAL     CALLCONV = 2
+	CC_MACPASCAL  CALLCONV = 3
+	CC_STDCALL    CALLCONV = 4
+	CC_FPFASTCALL CALLCONV = 5
+	CC_SYSCALL    CALLCONV = 6
+	CC_MPWCDECL   CALLCONV = 7
+	CC_MPWPASCAL  CALLCONV = 8
+)
+
+type FUNCFLAGS uint16
+
+const (
+	FUNCFLAG_FRESTRICTED       FUNCFLAGS = 0x1
+	FUNCFLAG_FSOURCE           FUNCFLAGS = 0x2
+	FUNCFLAG_FBINDABLE         FUNCFLAGS = 0x4
+	FUNCFLAG_FREQUESTEDIT      FUNCFLAGS = 0x8
+	FUNCFLAG_FDISPLAYBIND      FUNCFLAGS = 0x10
+	FUNCFLAG_FDEFAULTBIND      FUNCFLAGS = 0x20
+	FUNCFLAG_FHIDDEN           FUNCFLAGS = 0x40
+	FUNCFLAG_FUSESGETLASTERROR FUNCFLAGS = 0x80
+	FUNCFLAG_FDEFAULTCOLLELEM  FUNCFLAGS = 0x100
+	FUNCFLAG_FUIDEFAULT        FUNCFLAGS = 0x200
+	FUNCFLAG_FNONBROWSABLE     FUNCFLAGS = 0x400
+	FUNCFLAG_FREPLACEABLE      FUNCFLAGS = 0x800
+	FUNCFLAG_FIMMEDIATEBIND    FUNCFLAGS = 0x1000
+)
+
+type VARKIND int32
+
+const (
+	VAR_PERINSTANCE VARKIND = 0
+	VAR_STATIC      VARKIND = 1
+	VAR_CONST       VARKIND = 2
+	VAR_DISPATCH    VARKIND = 3
+)
+
+type VARFLAGS uint16
+
+const (
+	VARFLAG_FREADONLY        VARFLAGS = 0x1
+	VARFLAG_FSOURCE          VARFLAGS = 0x2
+	VARFLAG_FBINDABLE        VARFLAGS = 0x4
+	VARFLAG_FREQUESTEDIT     VARFLAGS = 0x8
+	VARFLAG_FDISPLAYBIND     VARFLAGS = 0x10
+	VARFLAG_FDEFAULTBIND     VARFLAGS = 0x20
+	VARFLAG_FHIDDEN          VARFLAGS = 0x40
+	VARFLAG_FRESTRICTED      VARFLAGS = 0x80
+	VARFLAG_FDEFAULTCOLLELEM VARFLAGS = 0x100
+	VARFLAG_FUIDEFAULT       VARFLAGS = 0x200
+	VARFLAG_FNONBROWSABLE    VARFLAGS = 0x400
+	VARFLAG_FREPLACEABLE     VARFLAGS = 0x800
+	VARFLAG_FIMMEDIATEBIND   VARFLAGS = 0x1000
+)
+
+type PARAMFLAGS uint16
+
+const (
+	PARAMFLAG_NONE         PARAMFLAGS = 0
+	PARAMFLAG_FIN          PARAMFLAGS = 0x1
+	PARAMFLAG_FOUT         PARAMFLAGS = 0x2
+	PARAMFLAG_FLCID        PARAMFLAGS = 0x4
+	PARAMFLAG_FRETVAL      PARAMFLAGS = 0x8
+	PARAMFLAG_FOPT         PARAMFLAGS = 0x10
+	PARAMFLAG_FHASDEFAULT  PARAMFLAGS = 0x20
+	PARAMFLAG_FHASCUSTDATA PARAMFLAGS = 0x40
+)
+
+type IMPLTYPEFLAGS int32
+
+const (
+	IMPLTYPEFLAG_FDEFAULT       IMPLTYPEFLAGS = 0x1
+	IMPLTYPEFLAG_FSOURCE        IMPLTYPEFLAGS = 0x2
+	IMPLTYPEFLAG_FRESTRICTED    IMPLTYPEFLAGS = 0x4
+	IMPLTYPEFLAG_FDEFAULTVTABLE IMPLTYPEFLAGS = 0x8
+)
+
+type SYSKIND int32
+
+const (
+	SYS_WIN16 SYSKIND = 0
+	SYS_WIN32 SYSKIND = 1
+	SYS_MAC   SYSKIND = 2
+	SYS_WIN64 SYSKIND = 3
+)
+
+type LIBFLAGS int32
+
+const (
+	LIBFLAG_FRESTRICTED   LIBFLAGS = 0x1
+	LIBFLAG_FCONTROL      LIBFLAGS = 0x2
+	LIBFLAG_FHIDDEN       LIBFLAGS = 0x4
+	LIBFLAG_FHASDISKIMAGE LIBFLAGS = 0x8
+)
+
+type MEMBERID = int32
+
+const (
+	MEMBERID_NIL   MEMBERID = -1
+	DISPID_VALUE   MEMBERID = 0
+	DISPID_NEWENUM MEMBERID = -4
+)
@@ -0,0 +1,22 @@
+package typelib
+
+// CustData is a [custom(guid, value)] attribute, as returned by the
+// GetAllXxxCustData methods of ITypeInfo2 and ITypeLib2.
+type CustData struct {
+	Guid  GUID
+	Value interface{}
+}
+
+// GUID_ManagedName is the custom data tlbexp puts on the types it
+// exports, holding the full .NET name of the type.
+var GUID_ManagedName = mustParseGuid("0F21F359-AB84-41E8-9A78-36D110E6D2F9")
+
+// FindCustData returns the value of the custom data of the guid.
+func FindCustData(custData []*CustData, guid GUID) (interface{}, bool) {
+	for _, cd := range custData {
+		if cd.Guid == guid {
+			return cd.Value, true
+		}
+	}
+	return nil, false
+}
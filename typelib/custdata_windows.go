@@ -0,0 +1,92 @@
+package typelib
+
+import (
+	"github.com/zzl/go-com/ole"
+	"github.com/zzl/go-win32api/v2/win32"
+	"unsafe"
+)
+
+// getTypeInfo2 returns the ITypeInfo2 of p, nil if not supported.
+// The caller releases it.
+func getTypeInfo2(p *win32.ITypeInfo) *win32.ITypeInfo2 {
+	var p2 *win32.ITypeInfo2
+	hr := p.QueryInterface(&win32.IID_ITypeInfo2, unsafe.Pointer(&p2))
+	if win32.FAILED(hr) {
+		return nil
+	}
+	return p2
+}
+
+// custDataList takes the items of a CUSTDATA filled by one of the
+// GetAllXxxCustData methods, and clears it.
+func custDataList(hr win32.HRESULT, custData *win32.CUSTDATA) []*CustData {
+	if win32.FAILED(hr) {
+		return nil
+	}
+	defer win32.ClearCustData(custData)
+	var list []*CustData
+	items := unsafe.Slice(custData.PrgCustData, custData.CCustData)
+	for n := range items {
+		list = append(list, &CustData{
+			Guid:  GUID(items[n].Guid),
+			Value: (*ole.Variant)(&items[n].VarValue).Value(),
+		})
+	}
+	return list
+}
+
+func getTypeCustData(p *win32.ITypeInfo) []*CustData {
+	p2 := getTypeInfo2(p)
+	if p2 == nil {
+		return nil
+	}
+	defer p2.Release()
+	var custData win32.CUSTDATA
+	return custDataList(p2.GetAllCustData(&custData), &custData)
+}
+
+func getImplTypeCustData(p *win32.ITypeInfo, index uint32) []*CustData {
+	p2 := getTypeInfo2(p)
+	if p2 == nil {
+		return nil
+	}
+	defer p2.Release()
+	var custData win32.CUSTDATA
+	return custDataList(p2.GetAllImplTypeCustData(index, &custData), &custData)
+}
+
+// getFuncCustData returns the custom data of the function, or of its
+// parameter if paramIndex is not negative.
+func getFuncCustData(p *win32.ITypeInfo, pFuncDesc *win32.FUNCDESC, paramIndex int) []*CustData {
+	p2 := getTypeInfo2(p)
+	if p2 == nil {
+		return nil
+	}
+	defer p2.Release()
+	var index uint32
+	hr := p2.GetFuncIndexOfMemId(pFuncDesc.Memid, pFuncDesc.Invkind, &index)
+	if win32.FAILED(hr) {
+		return nil
+	}
+	var custData win32.CUSTDATA
+	if paramIndex < 0 {
+		return custDataList(p2.GetAllFuncCustData(index, &custData), &custData)
+	}
+	hr = p2.GetAllParamCustData(index, uint32(paramIndex), &custData)
+	return custDataList(hr, &custData)
+}
+
+func getVarCustData(p *win32.ITypeInfo, pVarDesc *win32.VARDESC) []*CustData {
+	p2 := getTypeInfo2(p)
+	if p2 == nil {
+		return nil
+	}
+	defer p2.Release()
+	var index uint32
+	hr := p2.GetVarIndexOfMemId(pVarDesc.Memid, &index)
+	if win32.FAILED(hr) {
+		return nil
+	}
+	var custData win32.CUSTDATA
+	return custDataList(p2.GetAllVarCustData(index, &custData), &custData)
+}
@@ -0,0 +1,68 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"unicode/utf16"
+)
+
+// dataReader reads little endian values from a byte slice. Reads out of
+// range return zero values and set bad, so that decoders can check once.
+type dataReader struct {
+	data []byte
+	bad  bool
+}
+
+func (this *dataReader) u8(off int) byte {
+	if off < 0 || off >= len(this.data) {
+		this.bad = true
+		return 0
+	}
+	return this.data[off]
+}
+
+func (this *dataReader) u16(off int) uint16 {
+	if off < 0 || off+2 > len(this.data) {
+		this.bad = true
+		return 0
+	}
+	return binary.LittleEndian.Uint16(this.data[off:])
+}
+
+func (this *dataReader) i32(off int) int32 {
+	if off < 0 || off+4 > len(this.data) {
+		this.bad = true
+		return 0
+	}
+	return int32(binary.LittleEndian.Uint32(this.data[off:]))
+}
+
+func (this *dataReader) bytes(off int, size int) []byte {
+	if off < 0 || size < 0 || off+size > len(this.data) {
+		this.bad = true
+		return nil
+	}
+	return this.data[off : off+size]
+}
+
+// guidAt reads a guid stored in its binary layout.
+func (this *dataReader) guidAt(off int) GUID {
+	var g GUID
+	b := this.bytes(off, 16)
+	if b == nil {
+		return g
+	}
+	g.Data1 = binary.LittleEndian.Uint32(b)
+	g.Data2 = binary.LittleEndian.Uint16(b[4:])
+	g.Data3 = binary.LittleEndian.Uint16(b[6:])
+	copy(g.Data4[:], b[8:])
+	return g
+}
+
+// utf16ToStr decodes a little endian UTF-16 string without terminator
+func utf16ToStr(b []byte) string {
+	chars := make([]uint16, len(b)/2)
+	for n := range chars {
+		chars[n] = uint16(b[2*n]) | uint16(b[2*n+1])<<8
+	}
+	return string(utf16.Decode(chars))
+}
@@ -0,0 +1,185 @@
+package typelib
+
+// The pure-Go readers decode their input into the descriptors below,
+// which mirror what ITypeInfo exposes (TYPEATTR, FUNCDESC, VARDESC..).
+// They are then turned into the TypeInfo model by builder.go.
+
+type typeDesc struct {
+	vt   VARENUM
+	elem *typeDesc  //VT_PTR, VT_SAFEARRAY, VT_CARRAY
+	dims []int      //VT_CARRAY
+	ref  *typeEntry //VT_USERDEFINED
+}
+
+type paramEntry struct {
+	name  string
+	typ   *typeDesc
+	flags PARAMFLAGS
+	value interface{} //default value
+
+	custData []*CustData
+}
+
+type funcEntry struct {
+	memid      int32
+	name       string
+	doc        string
+	funcKind   FUNCKIND
+	invKind    INVOKEKIND
+	callConv   CALLCONV
+	flags      FUNCFLAGS
+	vtblIndex  int //oVft / pointer size
+	cParamsOpt int
+	ret        *typeDesc
+	params     []*paramEntry
+
+	dllEntry string
+	ordinal  int
+
+	custData []*CustData
+}
+
+type varEntry struct {
+	memid   int32
+	name    string
+	doc     string
+	typ     *typeDesc
+	flags   VARFLAGS
+	varKind VARKIND
+	oInst   int
+	value   interface{} //VAR_CONST
+
+	custData []*CustData
+}
+
+type implEntry struct {
+	ref   *typeEntry
+	flags IMPLTYPEFLAGS
+
+	custData []*CustData
+}
+
+type typeEntry struct {
+	name string
+	doc  string
+	guid GUID
+	kind TYPEKIND
+
+	flags        TYPEFLAGS
+	sizeInstance int
+	alignment    int
+	sizeVft      int
+	verMajor     uint16
+	verMinor     uint16
+	helpContext  uint32
+	dllName      string
+
+	alias *typeDesc
+	funcs []*funcEntry
+	vars  []*varEntry
+	impls []*implEntry
+
+	custData []*CustData
+
+	//for a type of another library, see implib.go
+	impLib   *ImpLib
+	impIndex int
+}
+
+func (this *typeEntry) isDual() bool {
+	return this.flags&TYPEFLAG_FDUAL != 0
+}
+
+func baseOf(e *typeEntry) *typeEntry {
+	if len(e.impls) == 0 {
+		return nil
+	}
+	return e.impls[0].ref
+}
+
+// layoutEntry fills in what a typelib compiler computes for the readers
+// of sources that lack it: the member ids left unset, the vtable offsets
+// and the instance sizes. The vtable of an interface derived from one of
+// a library that was not read is unknown: its slots are left at -1, and
+// sizeVft too, until ResolveImports finds the base.
+func layoutEntry(e *typeEntry, ptrSize int) {
+	switch e.kind {
+	case TKIND_INTERFACE, TKIND_DISPATCH:
+		level := 0
+		baseFuncCount := 0
+		if e.kind == TKIND_INTERFACE || e.isDual() {
+			for base := baseOf(e); base != nil; base = baseOf(base) {
+				if base.impLib != nil && base.name == "" { //placeholder
+					baseFuncCount = -1
+					break
+				}
+				level++
+				baseFuncCount += len(base.funcs)
+			}
+		}
+		for n, f := range e.funcs {
+			if f.memid == MEMBERID_NIL {
+				f.memid = int32(0x60000000 | level<<16 | n)
+			}
+			if f.funcKind != FUNC_DISPATCH {
+				f.vtblIndex = -1
+				if baseFuncCount >= 0 {
+					f.vtblIndex = baseFuncCount + n
+				}
+			}
+		}
+		for n, v := range e.vars {
+			if v.memid == MEMBERID_NIL {
+				v.memid = int32(0x40000000 + n)
+			}
+		}
+		e.sizeVft = -1
+		if baseFuncCount >= 0 {
+			e.sizeVft = (baseFuncCount + len(e.funcs)) * ptrSize
+		}
+		e.sizeInstance, e.alignment = ptrSize, ptrSize
+	case TKIND_MODULE:
+		for n, f := range e.funcs {
+			if f.memid == MEMBERID_NIL {
+				f.memid = int32(0x60000000 + n)
+			}
+		}
+	case TKIND_RECORD:
+		offset := 0
+		for _, v := range e.vars {
+			size, align := descSize(v.typ)
+			if align > 1 {
+				offset = (offset + align - 1) / align * align
+			}
+			v.oInst = offset
+			offset += size
+		}
+		e.sizeInstance, e.alignment = getEntryStructSize(e)
+	case TKIND_UNION:
+		e.sizeInstance, e.alignment = getEntryUnionSize(e)
+	case TKIND_ENUM:
+		e.sizeInstance, e.alignment = 4, 4
+	case TKIND_ALIAS:
+		e.sizeInstance, e.alignment = descSize(e.alias)
+	}
+}
+
+// toDispatch returns the dispatch view of a vtable function, the way
+// ITypeInfo reports the members of a dual interface: the [retval]
+// parameter becomes the return value and the HRESULT disappears.
+func (this *funcEntry) toDispatch() *funcEntry {
+	f := *this
+	f.funcKind = FUNC_DISPATCH
+	if f.ret == nil || f.ret.vt != VT_HRESULT {
+		return &f
+	}
+	count := len(f.params)
+	if count > 0 && f.params[count-1].flags&PARAMFLAG_FRETVAL != 0 &&
+		f.params[count-1].typ.vt == VT_PTR {
+		f.ret = f.params[count-1].typ.elem
+		f.params = f.params[:count-1]
+	} else {
+		f.ret = &typeDesc{vt: VT_VOID}
+	}
+	return &f
+}
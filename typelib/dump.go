@@ -0,0 +1,901 @@
+package typelib
+
+// JSON form of the TypeLib model, as written by the dump command.
+//
+// Schema "go-tlbimp/typelib", version 1:
+//
+//	{
+//	  "schema": "go-tlbimp/typelib",
+//	  "version": 1,
+//	  "library": {"name", "doc", "helpFile", "helpContext", "guid", "lcid",
+//	              "sysKind", "majorVersion", "minorVersion", "flags",
+//	              "custData": [CustData..]},
+//	  "types": [TypeInfo..]
+//	}
+//
+// TypeInfo:  {"name", "doc", "guid", "kind", "flags", "funcCount",
+//             "fieldCount", "size", "align", "sizeInstance", "alignment",
+//             "relType": VarType, "fields": [FieldInfo..],
+//             "funcs": [FuncInfo..], "super": TypeInfo,
+//             "dualInterface": TypeInfo, "dispInterface",
+//             "implTypes": [ImplType..], "dllName", "custData": [CustData..],
+//             "impLib": ImpLib}
+// FuncInfo:  {"id", "name", "doc", "funcKind", "callConv", "oVft",
+//             "paramsOpt", "flags", "params": [ParamInfo..],
+//             "returnType": VarType, "custData": [CustData..],
+//             "dllEntry", "ordinal"}
+// ParamInfo: {"name", "flags", "type": VarType, "defaultValue",
+//             "custData": [CustData..]}
+// FieldInfo: {"id", "name", "doc", "flags", "type": VarType, "offset",
+//             "value", "custData": [CustData..]}
+// ImplType:  {"name", "guid", "default", "source", "dispInterface",
+//             "custData": [CustData..]}
+// CustData:  {"guid", "value"}
+// VarType:   {"name", "vt", "kind", "dims", "typeName", "size", "align",
+//             "native", "enum", "unsigned", "pointer", "array", "struct",
+//             "interface", "dispInterface", "refType": VarType,
+//             "underlying": VarType, "impLib": ImpLib}
+// ImpLib:    {"name", "guid", "majorVersion", "minorVersion", "lcid"}
+//
+// Guids are written as by GUID.String, kinds as "enum", "record",
+// "module", "interface", "dispatch", "coclass", "alias" or "union",
+// vts by the names of vtNames, such as "bstr", "ptr" or "userDefined",
+// func kinds as "virtual", "pureVirtual", "nonVirtual", "static" or
+// "dispatch", flags as objects of booleans. Members that are empty or false are
+// omitted. The super types and the vtable view of dual interfaces are
+// complete TypeInfo objects, so the chain of inherited funcs is there.
+// "size" and "align" are the layout of the Go type, "sizeInstance",
+// "alignment" and the field offsets the one of the typelib. Sizes and
+// vtable offsets are those of the arch of the library's sysKind.
+// The version is bumped whenever a member changes meaning or is removed.
+//
+// A dump is a Source itself, so code can be generated from a snapshot
+// without the original typelib, or from a hand-patched one.
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"strconv"
+)
+
+const DumpSchema = "go-tlbimp/typelib"
+const DumpSchemaVersion = 1
+
+var typeKindNames = map[TYPEKIND]string{
+	TKIND_ENUM:      "enum",
+	TKIND_RECORD:    "record",
+	TKIND_MODULE:    "module",
+	TKIND_INTERFACE: "interface",
+	TKIND_DISPATCH:  "dispatch",
+	TKIND_COCLASS:   "coclass",
+	TKIND_ALIAS:     "alias",
+	TKIND_UNION:     "union",
+}
+
+var vtNames = map[VARENUM]string{
+	VT_I2:          "i2",
+	VT_I4:          "i4",
+	VT_R4:          "r4",
+	VT_R8:          "r8",
+	VT_CY:          "cy",
+	VT_DATE:        "date",
+	VT_BSTR:        "bstr",
+	VT_DISPATCH:    "dispatch",
+	VT_ERROR:       "error",
+	VT_BOOL:        "bool",
+	VT_VARIANT:     "variant",
+	VT_UNKNOWN:     "unknown",
+	VT_DECIMAL:     "decimal",
+	VT_I1:          "i1",
+	VT_UI1:         "ui1",
+	VT_UI2:         "ui2",
+	VT_UI4:         "ui4",
+	VT_I8:          "i8",
+	VT_UI8:         "ui8",
+	VT_INT:         "int",
+	VT_UINT:        "uint",
+	VT_VOID:        "void",
+	VT_HRESULT:     "hresult",
+	VT_PTR:         "ptr",
+	VT_SAFEARRAY:   "safeArray",
+	VT_CARRAY:      "carray",
+	VT_USERDEFINED: "userDefined",
+	VT_LPSTR:       "lpstr",
+	VT_LPWSTR:      "lpwstr",
+	VT_INT_PTR:     "intPtr",
+	VT_UINT_PTR:    "uintPtr",
+}
+
+var funcKindNames = map[FUNCKIND]string{
+	FUNC_VIRTUAL:     "virtual",
+	FUNC_PUREVIRTUAL: "pureVirtual",
+	FUNC_NONVIRTUAL:  "nonVirtual",
+	FUNC_STATIC:      "static",
+	FUNC_DISPATCH:    "dispatch",
+}
+
+type TypeLibDump struct {
+	Schema  string          `json:"schema"`
+	Version int             `json:"version"`
+	Library *LibraryDump    `json:"library"`
+	Types   []*TypeInfoDump `json:"types"`
+
+	infos     []*TypeInfo //read back for infosArch
+	infosArch string
+}
+
+type LibraryDump struct {
+	Name         string          `json:"name"`
+	Doc          string          `json:"doc,omitempty"`
+	HelpFile     string          `json:"helpFile,omitempty"`
+	HelpContext  uint32          `json:"helpContext,omitempty"`
+	Guid         string          `json:"guid"`
+	Lcid         uint32          `json:"lcid"`
+	SysKind      SYSKIND         `json:"sysKind"`
+	MajorVersion uint16          `json:"majorVersion"`
+	MinorVersion uint16          `json:"minorVersion"`
+	Flags        LIBFLAGS        `json:"flags,omitempty"`
+	CustData     []*CustDataDump `json:"custData,omitempty"`
+}
+
+type CustDataDump struct {
+	Guid  string      `json:"guid"`
+	Value interface{} `json:"value"`
+}
+
+type TypeFlagsDump struct {
+	Default       bool `json:"default,omitempty"`
+	Hidden        bool `json:"hidden,omitempty"`
+	Dual          bool `json:"dual,omitempty"`
+	OleAutomation bool `json:"oleAutomation,omitempty"`
+	Restricted    bool `json:"restricted,omitempty"`
+}
+
+type TypeInfoDump struct {
+	Name          string           `json:"name"`
+	Doc           string           `json:"doc,omitempty"`
+	Guid          string           `json:"guid"`
+	Kind          string           `json:"kind"`
+	Flags         TypeFlagsDump    `json:"flags"`
+	FuncCount     int              `json:"funcCount"`
+	FieldCount    int              `json:"fieldCount"`
+	Size          int              `json:"size"`
+	Align         int              `json:"align"`
+	SizeInstance  int              `json:"sizeInstance,omitempty"`
+	Alignment     int              `json:"alignment,omitempty"`
+	RelType       *VarTypeDump     `json:"relType,omitempty"`
+	Fields        []*FieldInfoDump `json:"fields,omitempty"`
+	Funcs         []*FuncInfoDump  `json:"funcs,omitempty"`
+	Super         *TypeInfoDump    `json:"super,omitempty"`
+	DualInterface *TypeInfoDump    `json:"dualInterface,omitempty"`
+	DispInterface bool             `json:"dispInterface,omitempty"`
+	ImplTypes     []*ImplTypeDump  `json:"implTypes,omitempty"`
+	DllName       string           `json:"dllName,omitempty"`
+	CustData      []*CustDataDump  `json:"custData,omitempty"`
+	ImpLib        *ImpLibDump      `json:"impLib,omitempty"`
+}
+
+type ImplTypeDump struct {
+	Name          string          `json:"name"`
+	Guid          string          `json:"guid"`
+	Default       bool            `json:"default,omitempty"`
+	Source        bool            `json:"source,omitempty"`
+	DispInterface bool            `json:"dispInterface,omitempty"`
+	CustData      []*CustDataDump `json:"custData,omitempty"`
+}
+
+type FuncFlagsDump struct {
+	PropGet    bool `json:"propGet,omitempty"`
+	PropPut    bool `json:"propPut,omitempty"`
+	PropPutRef bool `json:"propPutRef,omitempty"`
+	Restricted bool `json:"restricted,omitempty"`
+	Hidden     bool `json:"hidden,omitempty"`
+	Vararg     bool `json:"vararg,omitempty"`
+}
+
+type FuncInfoDump struct {
+	Id         MEMBERID         `json:"id"`
+	Name       string           `json:"name"`
+	Doc        string           `json:"doc,omitempty"`
+	FuncKind   string           `json:"funcKind"`
+	CallConv   CALLCONV         `json:"callConv"`
+	VtblOffset int              `json:"oVft"`
+	ParamsOpt  int              `json:"paramsOpt"`
+	Flags      FuncFlagsDump    `json:"flags"`
+	Params     []*ParamInfoDump `json:"params,omitempty"`
+	ReturnType *VarTypeDump     `json:"returnType"`
+	CustData   []*CustDataDump  `json:"custData,omitempty"`
+	DllEntry   string           `json:"dllEntry,omitempty"`
+	Ordinal    int              `json:"ordinal,omitempty"`
+}
+
+type ParamFlagsDump struct {
+	In         bool `json:"in,omitempty"`
+	Out        bool `json:"out,omitempty"`
+	Retval     bool `json:"retval,omitempty"`
+	Optional   bool `json:"optional,omitempty"`
+	HasDefault bool `json:"hasDefault,omitempty"`
+	Lcid       bool `json:"lcid,omitempty"`
+}
+
+type ParamInfoDump struct {
+	Name         string          `json:"name"`
+	Flags        ParamFlagsDump  `json:"flags"`
+	Type         *VarTypeDump    `json:"type"`
+	DefaultValue interface{}     `json:"defaultValue,omitempty"`
+	CustData     []*CustDataDump `json:"custData,omitempty"`
+}
+
+type FieldFlagsDump struct {
+	ReadOnly   bool `json:"readOnly,omitempty"`
+	Hidden     bool `json:"hidden,omitempty"`
+	Restricted bool `json:"restricted,omitempty"`
+}
+
+type FieldInfoDump struct {
+	Id       MEMBERID        `json:"id,omitempty"`
+	Name     string          `json:"name"`
+	Doc      string          `json:"doc,omitempty"`
+	Flags    FieldFlagsDump  `json:"flags"`
+	Type     *VarTypeDump    `json:"type"`
+	Offset   int             `json:"offset,omitempty"`
+	Value    interface{}     `json:"value,omitempty"`
+	CustData []*CustDataDump `json:"custData,omitempty"`
+}
+
+type VarTypeDump struct {
+	Name          string       `json:"name"`
+	Vt            string       `json:"vt"`
+	Kind          string       `json:"kind,omitempty"`
+	Dims          []int        `json:"dims,omitempty"`
+	TypeName      string       `json:"typeName,omitempty"`
+	Size          int          `json:"size"`
+	Align         int          `json:"align"`
+	Native        bool         `json:"native,omitempty"`
+	Enum          bool         `json:"enum,omitempty"`
+	Unsigned      bool         `json:"unsigned,omitempty"`
+	Pointer       bool         `json:"pointer,omitempty"`
+	Array         bool         `json:"array,omitempty"`
+	Struct        bool         `json:"struct,omitempty"`
+	Interface     bool         `json:"interface,omitempty"`
+	DispInterface bool         `json:"dispInterface,omitempty"`
+	RefType       *VarTypeDump `json:"refType,omitempty"`
+	Underlying    *VarTypeDump `json:"underlying,omitempty"`
+	ImpLib        *ImpLibDump  `json:"impLib,omitempty"`
+}
+
+type ImpLibDump struct {
+	Name         string `json:"name,omitempty"`
+	Guid         string `json:"guid"`
+	MajorVersion uint16 `json:"majorVersion"`
+	MinorVersion uint16 `json:"minorVersion"`
+	Lcid         uint32 `json:"lcid"`
+}
+
+// NewTypeLibDump dumps the types of lib, in the layouts of its sysKind.
+// Those that cannot be read are left out, as are members, and their
+// errors returned.
+func NewTypeLibDump(lib Source) (*TypeLibDump, []error) {
+	attr := lib.GetLibAttr()
+	defer utils.SetArch(utils.Arch)
+	utils.SetArch(attr.Arch())
+	dump := &TypeLibDump{
+		Schema:  DumpSchema,
+		Version: DumpSchemaVersion,
+		Library: &LibraryDump{
+			Name:         lib.GetName(),
+			Doc:          lib.GetDoc(),
+			HelpFile:     lib.GetHelpFile(),
+			HelpContext:  lib.GetHelpContext(),
+			Guid:         attr.Guid.String(),
+			Lcid:         attr.Lcid,
+			SysKind:      attr.SysKind,
+			MajorVersion: attr.MajorVer,
+			MinorVersion: attr.MinorVer,
+			Flags:        attr.Flags,
+			CustData:     newCustDataDump(lib.GetCustData()),
+		},
+		Types: []*TypeInfoDump{},
+	}
+	var errs []error
+	count := lib.GetTypeInfoCount()
+	for n := 0; n < count; n++ {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		errs = append(errs, ti.Errors...)
+		dump.Types = append(dump.Types, newTypeInfoDump(ti))
+	}
+	return dump, errs
+}
+
+func newTypeInfoDump(ti *TypeInfo) *TypeInfoDump {
+	if ti == nil {
+		return nil
+	}
+	dump := &TypeInfoDump{
+		Name:          ti.Name,
+		Doc:           ti.Doc,
+		Guid:          ti.Guid.String(),
+		Kind:          typeKindNames[ti.Kind],
+		Flags:         TypeFlagsDump(ti.Flags),
+		FuncCount:     ti.FuncCount,
+		FieldCount:    ti.FieldCount,
+		Size:          ti.Size,
+		Align:         ti.Align,
+		SizeInstance:  ti.SizeInstance,
+		Alignment:     ti.Alignment,
+		RelType:       newVarTypeDump(ti.RelType),
+		Super:         newTypeInfoDump(ti.Super),
+		DualInterface: newTypeInfoDump(ti.DualInterface),
+		DispInterface: ti.DispInterface,
+		DllName:       ti.DllName,
+		CustData:      newCustDataDump(ti.CustData),
+		ImpLib:        newImpLibDump(ti.ImpLib),
+	}
+	for _, f := range ti.Fields {
+		dump.Fields = append(dump.Fields, &FieldInfoDump{
+			Id:       f.Id,
+			Name:     f.Name,
+			Doc:      f.Doc,
+			Flags:    FieldFlagsDump(f.Flags),
+			Type:     newVarTypeDump(f.Type),
+			Offset:   f.Offset,
+			Value:    f.Value,
+			CustData: newCustDataDump(f.CustData),
+		})
+	}
+	for _, f := range ti.Funcs {
+		fDump := &FuncInfoDump{
+			Id:         f.Id,
+			Name:       f.Name,
+			Doc:        f.Doc,
+			FuncKind:   funcKindNames[f.Kind],
+			CallConv:   f.CallConv,
+			VtblOffset: f.VtblOffset,
+			ParamsOpt:  f.ParamsOpt,
+			Flags:      FuncFlagsDump(f.Flags),
+			ReturnType: newVarTypeDump(f.ReturnType),
+			CustData:   newCustDataDump(f.CustData),
+			DllEntry:   f.DllEntry,
+			Ordinal:    f.Ordinal,
+		}
+		for _, p := range f.Params {
+			fDump.Params = append(fDump.Params, &ParamInfoDump{
+				Name:         p.Name,
+				Flags:        ParamFlagsDump(p.Flags),
+				Type:         newVarTypeDump(p.Type),
+				DefaultValue: p.DefaultValue,
+				CustData:     newCustDataDump(p.CustData),
+			})
+		}
+		dump.Funcs = append(dump.Funcs, fDump)
+	}
+	for _, it := range ti.ImplTypes {
+		dump.ImplTypes = append(dump.ImplTypes, &ImplTypeDump{
+			Name:          it.Name,
+			Guid:          it.Guid.String(),
+			Default:       it.Default,
+			Source:        it.Source,
+			DispInterface: it.DispInterface,
+			CustData:      newCustDataDump(it.CustData),
+		})
+	}
+	return dump
+}
+
+func newCustDataDump(custData []*CustData) []*CustDataDump {
+	var dump []*CustDataDump
+	for _, cd := range custData {
+		dump = append(dump, &CustDataDump{
+			Guid:  cd.Guid.String(),
+			Value: cd.Value,
+		})
+	}
+	return dump
+}
+
+func newVarTypeDump(t *VarType) *VarTypeDump {
+	if t == nil {
+		return nil
+	}
+	var kind string
+	if t.Vt == VT_USERDEFINED {
+		kind = typeKindNames[t.Kind]
+	}
+	return &VarTypeDump{
+		Name:          t.Name,
+		Vt:            vtNames[t.Vt],
+		Kind:          kind,
+		Dims:          t.Dims,
+		TypeName:      t.TypeName,
+		Size:          t.Size,
+		Align:         t.Align,
+		Native:        t.Native,
+		Enum:          t.Enum,
+		Unsigned:      t.Unsigned,
+		Pointer:       t.Pointer,
+		Array:         t.Array,
+		Struct:        t.Struct,
+		Interface:     t.Interface,
+		DispInterface: t.DispInterface,
+		RefType:       newVarTypeDump(t.RefType),
+		Underlying:    newVarTypeDump(t.Underlying),
+		ImpLib:        newImpLibDump(t.ImpLib),
+	}
+}
+
+func newImpLibDump(lib *ImpLib) *ImpLibDump {
+	if lib == nil {
+		return nil
+	}
+	return &ImpLibDump{
+		Name:         lib.Name,
+		Guid:         lib.Guid.String(),
+		MajorVersion: lib.MajorVer,
+		MinorVersion: lib.MinorVer,
+		Lcid:         lib.Lcid,
+	}
+}
+
+// ReadTypeLibDumpFile loads a model written by the dump command.
+func ReadTypeLibDumpFile(filePath string) (*TypeLibDump, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return NewTypeLibDumpFromJson(data)
+}
+
+func NewTypeLibDumpFromJson(data []byte) (*TypeLibDump, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var dump TypeLibDump
+	err := decoder.Decode(&dump)
+	if err != nil {
+		return nil, err
+	}
+	if dump.Schema != DumpSchema {
+		return nil, errors.New("not a " + DumpSchema + " dump")
+	}
+	if dump.Version < 1 || dump.Version > DumpSchemaVersion {
+		return nil, errors.New("unsupported dump version " + strconv.Itoa(dump.Version))
+	}
+	if dump.Library == nil {
+		return nil, errors.New("library missing")
+	}
+	if _, err := ParseGuid(dump.Library.Guid); err != nil {
+		return nil, errors.New("library: " + err.Error())
+	}
+	if err := validateCustData(dump.Library.CustData); err != nil {
+		return nil, errors.New("library: " + err.Error())
+	}
+	for _, ti := range dump.Types {
+		if err := ti.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &dump, nil
+}
+
+func (this *TypeInfoDump) validate() error {
+	if this == nil {
+		return nil
+	}
+	if _, err := ParseGuid(this.Guid); err != nil {
+		return errors.New(this.Name + ": " + err.Error())
+	}
+	if _, ok := parseTypeKind(this.Kind); !ok {
+		return errors.New(this.Name + ": unknown kind " + this.Kind)
+	}
+	if err := this.RelType.validate(); err != nil {
+		return errors.New(this.Name + ": " + err.Error())
+	}
+	if err := validateCustData(this.CustData); err != nil {
+		return errors.New(this.Name + ": " + err.Error())
+	}
+	for _, f := range this.Fields {
+		if err := f.Type.validate(); err != nil {
+			return errors.New(this.Name + "." + f.Name + ": " + err.Error())
+		}
+		if err := validateCustData(f.CustData); err != nil {
+			return errors.New(this.Name + "." + f.Name + ": " + err.Error())
+		}
+	}
+	for _, f := range this.Funcs {
+		if _, ok := parseFuncKind(f.FuncKind); !ok {
+			return errors.New(this.Name + "." + f.Name + ": unknown func kind " + f.FuncKind)
+		}
+		if err := f.ReturnType.validate(); err != nil {
+			return errors.New(this.Name + "." + f.Name + ": " + err.Error())
+		}
+		if err := validateCustData(f.CustData); err != nil {
+			return errors.New(this.Name + "." + f.Name + ": " + err.Error())
+		}
+		for _, p := range f.Params {
+			if err := p.Type.validate(); err != nil {
+				return errors.New(this.Name + "." + f.Name + "." + p.Name + ": " + err.Error())
+			}
+			if err := validateCustData(p.CustData); err != nil {
+				return errors.New(this.Name + "." + f.Name + "." + p.Name + ": " + err.Error())
+			}
+		}
+	}
+	for _, it := range this.ImplTypes {
+		if _, err := ParseGuid(it.Guid); err != nil {
+			return errors.New(this.Name + "." + it.Name + ": " + err.Error())
+		}
+		if err := validateCustData(it.CustData); err != nil {
+			return errors.New(this.Name + "." + it.Name + ": " + err.Error())
+		}
+	}
+	if err := this.Super.validate(); err != nil {
+		return err
+	}
+	return this.DualInterface.validate()
+}
+
+func (this *VarTypeDump) validate() error {
+	if this == nil {
+		return nil
+	}
+	vt, ok := ParseVt(this.Vt)
+	if !ok {
+		return errors.New("unknown vt " + this.Vt)
+	}
+	if vt == VT_USERDEFINED {
+		if _, ok := parseTypeKind(this.Kind); !ok {
+			return errors.New(this.Name + ": unknown kind " + this.Kind)
+		}
+	}
+	if err := this.RefType.validate(); err != nil {
+		return err
+	}
+	return this.Underlying.validate()
+}
+
+func validateCustData(custData []*CustDataDump) error {
+	for _, cd := range custData {
+		if _, err := ParseGuid(cd.Guid); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseTypeKind(name string) (TYPEKIND, bool) {
+	for kind, kindName := range typeKindNames {
+		if kindName == name {
+			return kind, true
+		}
+	}
+	return 0, false
+}
+
+// ParseVt returns the VT of a name as dumps write them, such as "bstr"
+func ParseVt(name string) (VARENUM, bool) {
+	for vt, vtName := range vtNames {
+		if vtName == name {
+			return vt, true
+		}
+	}
+	return 0, false
+}
+
+func parseFuncKind(name string) (FUNCKIND, bool) {
+	for kind, kindName := range funcKindNames {
+		if kindName == name {
+			return kind, true
+		}
+	}
+	return 0, false
+}
+
+func (this *TypeLibDump) GetName() string {
+	return this.Library.Name
+}
+
+func (this *TypeLibDump) GetDoc() string {
+	return this.Library.Doc
+}
+
+func (this *TypeLibDump) GetHelpFile() string {
+	return this.Library.HelpFile
+}
+
+func (this *TypeLibDump) GetHelpContext() uint32 {
+	return this.Library.HelpContext
+}
+
+func (this *TypeLibDump) GetCustData() []*CustData {
+	return toCustData(this.Library.CustData)
+}
+
+func (this *TypeLibDump) GetLibAttr() *LibAttr {
+	guid, _ := ParseGuid(this.Library.Guid)
+	return &LibAttr{
+		Guid:     guid,
+		Lcid:     this.Library.Lcid,
+		SysKind:  this.Library.SysKind,
+		MajorVer: this.Library.MajorVersion,
+		MinorVer: this.Library.MinorVersion,
+		Flags:    this.Library.Flags,
+	}
+}
+
+func (this *TypeLibDump) GetTypeInfoCount() int {
+	return len(this.Types)
+}
+
+func (this *TypeLibDump) GetTypeInfo(index int) (*TypeInfo, error) {
+	if index < 0 || index >= len(this.Types) {
+		return nil, newTypeError("no type at index " + strconv.Itoa(index))
+	}
+	if this.infos == nil || this.infosArch != utils.Arch {
+		this.readTypeInfos()
+	}
+	return this.infos[index], nil
+}
+
+// readTypeInfos reads the types of the dump back. As the other sources
+// do, the super types and the types VarTypes refer to are those of the
+// library when it has them.
+func (this *TypeLibDump) readTypeInfos() {
+	ptrSize := this.ptrSize()
+	this.infos = make([]*TypeInfo, len(this.Types))
+	this.infosArch = utils.Arch
+	byGuid := make(map[GUID]*TypeInfo)
+	byName := make(map[string]*TypeInfo)
+	for n, t := range this.Types {
+		ti := t.toTypeInfo(ptrSize)
+		this.infos[n] = ti
+		if !ti.Guid.IsNull() {
+			byGuid[ti.Guid] = ti
+		}
+		name := utils.CapName(ti.Name)
+		if _, ok := byName[name]; !ok {
+			byName[name] = ti
+		}
+	}
+
+	link := func(t *VarType) {
+		for ; t != nil; t = t.RefType {
+			if t.Vt == VT_USERDEFINED {
+				t.TypeInfo = byName[t.Name]
+			}
+		}
+	}
+	var linkTypeInfo func(ti *TypeInfo)
+	linkTypeInfo = func(ti *TypeInfo) {
+		var super *TypeInfo
+		if ti.Super != nil && !ti.Super.Guid.IsNull() {
+			super = byGuid[ti.Super.Guid]
+		}
+		if super != nil && super.Kind != ti.Super.Kind {
+			super = super.DualInterface //the vtable part of a dual interface
+		}
+		if super != nil {
+			ti.Super = super
+		}
+		link(ti.RelType)
+		for _, f := range ti.Fields {
+			link(f.Type)
+		}
+		for _, f := range ti.Funcs {
+			link(f.ReturnType)
+			for _, p := range f.Params {
+				link(p.Type)
+			}
+		}
+		if ti.DualInterface != nil {
+			linkTypeInfo(ti.DualInterface)
+		}
+	}
+	for _, ti := range this.infos {
+		linkTypeInfo(ti)
+	}
+}
+
+// ptrSize is the pointer size the vtable offsets of the dump are in
+func (this *TypeLibDump) ptrSize() int {
+	return utils.ArchPtrSize(this.GetLibAttr().Arch())
+}
+
+func (this *TypeInfoDump) toTypeInfo(ptrSize int) *TypeInfo {
+	if this == nil {
+		return nil
+	}
+	guid, _ := ParseGuid(this.Guid)
+	kind, _ := parseTypeKind(this.Kind)
+	ti := &TypeInfo{
+		Name:          this.Name,
+		Doc:           this.Doc,
+		Guid:          guid,
+		Kind:          kind,
+		FuncCount:     this.FuncCount,
+		FieldCount:    this.FieldCount,
+		Flags:         TypeFlags(this.Flags),
+		RelType:       this.RelType.toVarType(),
+		Super:         this.Super.toTypeInfo(ptrSize),
+		DualInterface: this.DualInterface.toTypeInfo(ptrSize),
+		DispInterface: this.DispInterface,
+		DllName:       this.DllName,
+		Size:          this.Size,
+		Align:         this.Align,
+		SizeInstance:  this.SizeInstance,
+		Alignment:     this.Alignment,
+		CustData:      toCustData(this.CustData),
+		ImpLib:        this.ImpLib.toImpLib(),
+	}
+	for _, f := range this.Fields {
+		t := f.Type.toVarType()
+		ti.Fields = append(ti.Fields, &FieldInfo{
+			Id:       f.Id,
+			Name:     f.Name,
+			Doc:      f.Doc,
+			Flags:    FieldFlags(f.Flags),
+			Type:     t,
+			Offset:   f.Offset,
+			Value:    dumpValue(f.Value, t),
+			CustData: toCustData(f.CustData),
+		})
+	}
+	for _, f := range this.Funcs {
+		funcKind, _ := parseFuncKind(f.FuncKind)
+		fi := &FuncInfo{
+			Id:         f.Id,
+			Name:       f.Name,
+			Doc:        f.Doc,
+			Kind:       funcKind,
+			CallConv:   f.CallConv,
+			VtblOffset: f.VtblOffset / ptrSize * utils.PtrSize,
+			ParamsOpt:  f.ParamsOpt,
+			Flags:      FuncFlags(f.Flags),
+			ReturnType: f.ReturnType.toVarType(),
+			CustData:   toCustData(f.CustData),
+			DllEntry:   f.DllEntry,
+			Ordinal:    f.Ordinal,
+		}
+		for _, p := range f.Params {
+			t := p.Type.toVarType()
+			fi.Params = append(fi.Params, &ParamInfo{
+				Name:         p.Name,
+				Type:         t,
+				Flags:        ParamFlags(p.Flags),
+				CustData:     toCustData(p.CustData),
+				DefaultValue: dumpValue(p.DefaultValue, t),
+			})
+		}
+		ti.Funcs = append(ti.Funcs, fi)
+	}
+	for _, it := range this.ImplTypes {
+		guid, _ := ParseGuid(it.Guid)
+		ti.ImplTypes = append(ti.ImplTypes, &ImplType{
+			Name:          it.Name,
+			Guid:          guid,
+			Default:       it.Default,
+			Source:        it.Source,
+			DispInterface: it.DispInterface,
+			CustData:      toCustData(it.CustData),
+		})
+	}
+	return ti
+}
+
+// toCustData reads back the custom data of a dump. The type of the
+// values is not kept, numbers come back as int32 if they fit.
+func toCustData(dump []*CustDataDump) []*CustData {
+	var custData []*CustData
+	for _, cd := range dump {
+		guid, _ := ParseGuid(cd.Guid)
+		custData = append(custData, &CustData{
+			Guid:  guid,
+			Value: dumpValue(cd.Value, nil),
+		})
+	}
+	return custData
+}
+
+func (this *VarTypeDump) toVarType() *VarType {
+	if this == nil {
+		return nil
+	}
+	vt, _ := ParseVt(this.Vt)
+	var kind TYPEKIND
+	if vt == VT_USERDEFINED {
+		kind, _ = parseTypeKind(this.Kind)
+	}
+	t := &VarType{
+		Name:          this.Name,
+		Vt:            vt,
+		Kind:          kind,
+		Dims:          this.Dims,
+		TypeName:      this.TypeName,
+		Size:          this.Size,
+		Align:         this.Align,
+		Native:        this.Native,
+		Enum:          this.Enum,
+		Unsigned:      this.Unsigned,
+		Pointer:       this.Pointer,
+		Array:         this.Array,
+		Struct:        this.Struct,
+		Interface:     this.Interface,
+		DispInterface: this.DispInterface,
+		RefType:       this.RefType.toVarType(),
+		Underlying:    this.Underlying.toVarType(),
+		ImpLib:        this.ImpLib.toImpLib(),
+	}
+	t.PVarCastExpr = varCastExpr(t)
+	return t
+}
+
+// varCastExpr returns the expression taking a value of t from a VARIANT,
+// as the readers give it: that of its vt, or of the int type of an enum
+func varCastExpr(t *VarType) string {
+	if t.Enum && t.Underlying != nil {
+		return t.Name + "(" + varCastExpr(t.Underlying) + ")"
+	}
+	at, _ := newAutomationVarType(t.Vt, false)
+	return at.PVarCastExpr
+}
+
+func (this *ImpLibDump) toImpLib() *ImpLib {
+	if this == nil {
+		return nil
+	}
+	guid, _ := ParseGuid(this.Guid)
+	return &ImpLib{
+		Name:     this.Name,
+		Guid:     guid,
+		MajorVer: this.MajorVersion,
+		MinorVer: this.MinorVersion,
+		Lcid:     this.Lcid,
+	}
+}
+
+// dumpValue gives a json number the Go type the readers use for
+// a constant of the type, by its vt or that of the int type of an enum.
+func dumpValue(v interface{}, t *VarType) interface{} {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	var vt VARENUM
+	if t != nil {
+		vt = t.Vt
+		if t.Enum && t.Underlying != nil {
+			vt = t.Underlying.Vt
+		}
+	}
+	switch vt {
+	case VT_R4:
+		f, _ := n.Float64()
+		return float32(f)
+	case VT_R8, VT_DATE:
+		f, _ := n.Float64()
+		return f
+	}
+	i, err := strconv.ParseInt(n.String(), 10, 64)
+	if err != nil {
+		u, err := strconv.ParseUint(n.String(), 10, 64)
+		if err != nil {
+			f, _ := n.Float64()
+			return f
+		}
+		return u
+	}
+	switch vt {
+	case VT_I1, VT_UI1, VT_I2, VT_UI2, VT_I4, VT_INT, VT_ERROR, VT_HRESULT,
+		VT_UI4, VT_UINT, VT_I8, VT_CY, VT_UI8:
+		return convertValue(vt, uint64(i))
+	}
+	if i == int64(int32(i)) {
+		return int32(i)
+	}
+	return i
+}
@@ -0,0 +1,113 @@
+package typelib
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// roundTrip dumps lib to JSON and reads it back
+func roundTrip(t *testing.T, lib Source) ([]byte, *TypeLibDump) {
+	dump, errs := NewTypeLibDump(lib)
+	if len(errs) != 0 {
+		t.Fatal(errs)
+	}
+	data, err := json.Marshal(dump)
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := NewTypeLibDumpFromJson(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data, loaded
+}
+
+func TestDumpRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		lib  Source
+	}{
+		{"idl", readTestIdl(t)},
+		{"msft", readTestLib(t, SYS_WIN32)},
+	}
+	for _, test := range tests {
+		data, loaded := roundTrip(t, test.lib)
+
+		//dumped again, it is the same
+		data2, _ := roundTrip(t, loaded)
+		if !bytes.Equal(data, data2) {
+			t.Errorf("%s: the dump of the dump differs:\n%s\n%s", test.name, data, data2)
+		}
+		if *loaded.GetLibAttr() != *test.lib.GetLibAttr() || loaded.GetName() != test.lib.GetName() {
+			t.Errorf("%s: library %s %+v", test.name, loaded.GetName(), loaded.GetLibAttr())
+		}
+
+		//and the values have the Go types of the readers
+		if loaded.GetTypeInfoCount() != test.lib.GetTypeInfoCount() {
+			t.Fatalf("%s: %d types", test.name, loaded.GetTypeInfoCount())
+		}
+		for n := 0; n < test.lib.GetTypeInfoCount(); n++ {
+			ti, _ := test.lib.GetTypeInfo(n)
+			ti2, err := loaded.GetTypeInfo(n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(formatFuncs(ti2), formatFuncs(ti)) {
+				t.Errorf("%s: %s funcs %v, want %v", test.name, ti.Name, formatFuncs(ti2), formatFuncs(ti))
+			}
+			for m, f := range ti.Fields {
+				if v := ti2.Fields[m].Value; !reflect.DeepEqual(v, f.Value) {
+					t.Errorf("%s: %s.%s value %#v, want %#v", test.name, ti.Name, f.Name, v, f.Value)
+				}
+			}
+			for m, f := range ti.Funcs {
+				for k, p := range f.Params {
+					if v := ti2.Funcs[m].Params[k].DefaultValue; !reflect.DeepEqual(v, p.DefaultValue) {
+						t.Errorf("%s: %s.%s default %#v, want %#v", test.name, f.Name, p.Name,
+							v, p.DefaultValue)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDumpErrors(t *testing.T) {
+	dump := func(library string, types string) string {
+		return `{"schema": "go-tlbimp/typelib", "version": 1, ` + library + `"types": [` + types + `]}`
+	}
+	lib := `"library": {"name": "L", "guid": "` + testLibId.String() + `"}, `
+	guid := `"guid": "` + testLibId.String() + `"`
+	tests := []struct {
+		data string
+		err  string
+	}{
+		{`{"schema": "other", "version": 1}`, "not a go-tlbimp/typelib dump"},
+		{`{"schema": "go-tlbimp/typelib", "version": 2}`, "unsupported dump version 2"},
+		{dump("", ""), "library missing"},
+		{dump(`"library": {"name": "L", "guid": "x"}, `, ""), "library: "},
+		{dump(lib, `{"name": "T", `+guid+`, "kind": "class"}`), "T: unknown kind class"},
+		{dump(lib, `{"name": "T", `+guid+`, "kind": "record",
+			"fields": [{"name": "f", "type": {"name": "x", "vt": "nope"}}]}`), "T.f: unknown vt nope"},
+		{dump(lib, `{"name": "T", `+guid+`, "kind": "interface",
+			"funcs": [{"name": "F", "funcKind": "odd"}]}`), "T.F: unknown func kind odd"},
+		{dump(lib, `{"name": "T", `+guid+`, "kind": "interface",
+			"funcs": [{"name": "F", "funcKind": "pureVirtual",
+			"params": [{"name": "p", "type": {"name": "U", "vt": "userDefined"}}]}]}`),
+			"T.F.p: U: unknown kind"},
+		{dump(lib, `{"name": "T", `+guid+`, "kind": "alias", "custData": [{"guid": "y"}]}`), "T: "},
+		{`{"schema": "go-tlbimp/typelib", `, "unexpected EOF"},
+	}
+	for _, test := range tests {
+		_, err := NewTypeLibDumpFromJson([]byte(test.data))
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: error %v, want %q", test.data, err, test.err)
+		}
+	}
+	if _, err := NewTypeLibDumpFromJson([]byte(dump(lib, ""))); err != nil {
+		t.Errorf("an empty library: %v", err)
+	}
+}
@@ -0,0 +1,59 @@
+package typelib
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// TypeError is an error reading a type, or one of its members
+type TypeError struct {
+	Type   string
+	Member string //empty for the type itself
+	Param  int    //index of the parameter of a func, -1 if not about one
+	Hr     int32  //HRESULT of the failed call, 0 if none
+	Msg    string
+}
+
+func newTypeError(msg string) *TypeError {
+	return &TypeError{Param: -1, Msg: msg}
+}
+
+func newHrError(call string, hr int32) *TypeError {
+	return &TypeError{Param: -1, Hr: hr, Msg: call + " failed"}
+}
+
+func (this *TypeError) Error() string {
+	s := this.Type
+	if this.Member != "" {
+		s += "." + this.Member
+	}
+	if this.Param >= 0 {
+		s += " param " + strconv.Itoa(this.Param)
+	}
+	if s != "" {
+		s += ": "
+	}
+	s += this.Msg
+	if this.Hr != 0 {
+		s += fmt.Sprintf(" (hr 0x%08X)", uint32(this.Hr))
+	}
+	return s
+}
+
+// withContext fills in where err happened, keeping what it already has
+func withContext(err error, typeName string, member string, param int) error {
+	te, ok := err.(*TypeError)
+	if !ok {
+		te = newTypeError(err.Error())
+	}
+	if te.Type == "" {
+		te.Type = typeName
+	}
+	if te.Member == "" {
+		te.Member = member
+	}
+	if te.Param < 0 {
+		te.Param = param
+	}
+	return te
+}
@@ -0,0 +1,51 @@
+package typelib
+
+import (
+	"github.com/zzl/go-com/com"
+	"github.com/zzl/go-com/ole"
+	"github.com/zzl/go-win32api/v2/win32"
+)
+
+func NewFieldInfo(pTypeInfo *win32.ITypeInfo, pVarDesc *win32.VARDESC, withValue bool) (*FieldInfo, error) {
+	return newFieldInfo(nil, pTypeInfo, pVarDesc, withValue)
+}
+
+func newFieldInfo(g *comGraph, pTypeInfo *win32.ITypeInfo, pVarDesc *win32.VARDESC,
+	withValue bool) (*FieldInfo, error) {
+
+	fi := &FieldInfo{Id: pVarDesc.Memid}
+	var bsName com.BStr
+	var cNames uint32
+	hr := pTypeInfo.GetNames(pVarDesc.Memid, bsName.PBSTR(), 1, &cNames)
+	if win32.FAILED(hr) {
+		return nil, newHrError("GetNames", int32(hr))
+	}
+
+	fi.Name = bsName.ToStringAndFree()
+
+	var bsDoc com.BStr
+	hr = pTypeInfo.GetDocumentation(pVarDesc.Memid, nil, bsDoc.PBSTR(), nil, nil)
+	if win32.FAILED(hr) {
+		return nil, withContext(newHrError("GetDocumentation", int32(hr)), "", fi.Name, -1)
+	}
+	fi.Doc = bsDoc.ToStringAndFree()
+	fi.CustData = getVarCustData(pTypeInfo, pVarDesc)
+
+	fi.Flags.ReadOnly = pVarDesc.WVarFlags&win32.VARFLAG_FREADONLY != 0
+	fi.Flags.Hidden = pVarDesc.WVarFlags&win32.VARFLAG_FHIDDEN != 0
+	fi.Flags.Restricted = pVarDesc.WVarFlags&win32.VARFLAG_FRESTRICTED != 0
+
+	var err error
+	fi.Type, err = _newVarType(g, pTypeInfo, &pVarDesc.ElemdescVar.Tdesc, true)
+	if err != nil {
+		return nil, withContext(err, "", fi.Name, -1)
+	}
+	if pVarDesc.Varkind == win32.VAR_PERINSTANCE {
+		fi.Offset = int(pVarDesc.OInstVal())
+	}
+
+	if withValue {
+		fi.Value = (*ole.Variant)(pVarDesc.LpvarValueVal()).Value()
+	}
+	return fi, nil
+}
@@ -0,0 +1,106 @@
+package typelib
+
+import (
+	"fmt"
+	"github.com/zzl/go-com/com"
+	"github.com/zzl/go-tlbimp/utils"
+	"github.com/zzl/go-win32api/v2/win32"
+	"unsafe"
+)
+
+func NewFuncInfo(pTypeInfo *win32.ITypeInfo, pTypeAttr *win32.TYPEATTR,
+	pFuncDesc *win32.FUNCDESC, dispFunc bool) (*FuncInfo, error) {
+	return newFuncInfo(nil, pTypeInfo, pTypeAttr, pFuncDesc, dispFunc)
+}
+
+func newFuncInfo(g *comGraph, pTypeInfo *win32.ITypeInfo, pTypeAttr *win32.TYPEATTR,
+	pFuncDesc *win32.FUNCDESC, dispFunc bool) (*FuncInfo, error) {
+
+	var hr win32.HRESULT
+	if pTypeAttr == nil {
+		hr = pTypeInfo.GetTypeAttr(&pTypeAttr)
+		if win32.FAILED(hr) {
+			return nil, newHrError("GetTypeAttr", int32(hr))
+		}
+		defer pTypeInfo.ReleaseTypeAttr(pTypeAttr)
+	}
+
+	info := &FuncInfo{
+		Id:         pFuncDesc.Memid,
+		Kind:       FUNCKIND(pFuncDesc.Funckind),
+		CallConv:   CALLCONV(pFuncDesc.Callconv),
+		VtblOffset: int(pFuncDesc.OVft) / utils.HostPtrSize * utils.PtrSize,
+		ParamsOpt:  int(pFuncDesc.CParamsOpt),
+	}
+
+	info.Flags.PropGet = pFuncDesc.Invkind&win32.INVOKE_PROPERTYGET != 0
+	info.Flags.PropPut = pFuncDesc.Invkind&win32.INVOKE_PROPERTYPUT != 0
+	info.Flags.PropPutRef = pFuncDesc.Invkind&win32.INVOKE_PROPERTYPUTREF != 0
+	if pFuncDesc.WFuncFlags&win32.FUNCFLAG_FRESTRICTED != 0 {
+		info.Flags.Restricted = true
+	}
+	if pFuncDesc.WFuncFlags&win32.FUNCFLAG_FHIDDEN != 0 {
+		info.Flags.Hidden = true
+	}
+	if pFuncDesc.CParamsOpt == -1 {
+		info.Flags.Vararg = true
+	}
+
+	var bsName, bsDoc com.BStr
+	hr = pTypeInfo.GetDocumentation(pFuncDesc.Memid, bsName.PBSTR(), bsDoc.PBSTR(), nil, nil)
+	if win32.FAILED(hr) {
+		return nil, withContext(newHrError("GetDocumentation", int32(hr)),
+			"", fmt.Sprintf("[id(0x%08x)]", uint32(pFuncDesc.Memid)), -1)
+	}
+
+	info.Name = bsName.ToStringAndFree()
+	info.Doc = bsDoc.ToStringAndFree()
+	info.CustData = getFuncCustData(pTypeInfo, pFuncDesc, -1)
+
+	//
+	const maxNames = 64
+	var bsNames [maxNames]win32.BSTR
+	var cNames uint32
+	pTypeInfo.GetNames(pFuncDesc.Memid, &bsNames[0], maxNames, &cNames)
+	if cNames < uint32(pFuncDesc.CParams+1) {
+		bsNames[cNames] = win32.SysAllocString(win32.StrToPwstr("rhs"))
+	}
+	defer func() {
+		for n := 0; n < maxNames; n++ {
+			//bsNames[n].Free()
+			if bsNames[n] != nil {
+				win32.SysFreeString(bsNames[n])
+			}
+		}
+	}()
+
+	elemDescParams := unsafe.Slice(pFuncDesc.LprgelemdescParam, pFuncDesc.CParams)
+	cParams := int(pFuncDesc.CParams)
+	if dispFunc {
+		for n := 0; n < cParams; n++ {
+			pParamDesc := elemDescParams[n]
+			idlFlags := pParamDesc.IdldescVal().WIDLFlags
+			if idlFlags&win32.IDLFLAG_FLCID != 0 || idlFlags&win32.IDLFLAG_FRETVAL != 0 {
+				cParams = n
+				break
+			}
+		}
+	}
+
+	for n := 0; n < cParams; n++ {
+		pParamDesc := elemDescParams[n]
+		name := win32.BstrToStr(bsNames[n+1])
+		param, err := newParamInfo(g, pTypeInfo, pFuncDesc, name, pParamDesc, cParams, n)
+		if err != nil {
+			return nil, withContext(err, "", info.Name, n)
+		}
+		info.Params = append(info.Params, param)
+	}
+
+	var err error
+	info.ReturnType, err = _newVarType(g, pTypeInfo, &pFuncDesc.ElemdescFunc.Tdesc, true)
+	if err != nil {
+		return nil, withContext(err, "", info.Name, -1)
+	}
+	return info, nil
+}
@@ -0,0 +1,54 @@
+package typelib
+
+import (
+	"github.com/zzl/go-tlbimp/utils"
+)
+
+// Graph is the types of a library for the current arch, indexed. Each
+// type is a single TypeInfo, shared by the super types, dual interfaces
+// and VarTypes that refer to it.
+type Graph struct {
+	Arch   string
+	Types  []*TypeInfo //by index, nil for those that could not be read
+	Errors []error     //of the types that could not be read
+
+	byName map[string]*TypeInfo
+	byGuid map[GUID]*TypeInfo
+}
+
+// NewGraph reads the types of lib. The sources build each type once per
+// arch, so reading them again is cheap and yields the same TypeInfos.
+func NewGraph(lib Source) *Graph {
+	count := lib.GetTypeInfoCount()
+	g := &Graph{
+		Arch:   utils.Arch,
+		Types:  make([]*TypeInfo, count),
+		byName: make(map[string]*TypeInfo),
+		byGuid: make(map[GUID]*TypeInfo),
+	}
+	for n := 0; n < count; n++ {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			g.Errors = append(g.Errors, err)
+			continue
+		}
+		g.Types[n] = ti
+		if _, ok := g.byName[ti.Name]; !ok {
+			g.byName[ti.Name] = ti
+		}
+		if !ti.Guid.IsNull() {
+			g.byGuid[ti.Guid] = ti
+		}
+	}
+	return g
+}
+
+// TypeByName returns the type of the name, nil if there is none
+func (this *Graph) TypeByName(name string) *TypeInfo {
+	return this.byName[name]
+}
+
+// TypeByGuid returns the type of the guid, nil if there is none
+func (this *Graph) TypeByGuid(guid GUID) *TypeInfo {
+	return this.byGuid[guid]
+}
@@ -0,0 +1,114 @@
+package typelib
+
+import (
+	"github.com/zzl/go-tlbimp/utils"
+	"testing"
+)
+
+func TestGraphIndex(t *testing.T) {
+	bad := &typeEntry{name: "BadAlias", kind: TKIND_ALIAS, alias: vtDesc(VT_BLOB)}
+	lib, err := NewTypeLibFromBytes(writeMsft(testLib(SYS_WIN32), append(testEntries(), bad)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := NewGraph(lib)
+	if g.Arch != utils.Arch || len(g.Types) != 7 || g.Types[6] != nil || len(g.Errors) != 1 {
+		t.Fatalf("arch %s, %d types, errors %v", g.Arch, len(g.Types), g.Errors)
+	}
+	for n, ti := range g.Types[:6] {
+		again, err := lib.GetTypeInfo(n)
+		if err != nil || again != ti {
+			t.Errorf("type %d is read again as a new TypeInfo, %v", n, err)
+		}
+		if g.TypeByName(ti.Name) != ti {
+			t.Errorf("TypeByName(%q) is not the type", ti.Name)
+		}
+		if !ti.Guid.IsNull() && g.TypeByGuid(ti.Guid) != ti {
+			t.Errorf("TypeByGuid of %s is not the type", ti.Name)
+		}
+	}
+	if g.TypeByName("Missing") != nil || g.TypeByGuid(mustParseGuid("0000ffff-1111-2222-3333-444444444444")) != nil {
+		t.Error("a missing type is found")
+	}
+}
+
+func TestGraphShared(t *testing.T) {
+	lib := readTestLib(t, SYS_WIN32)
+	g := NewGraph(lib)
+	dir, point, color, app := g.Types[0], g.Types[1], g.Types[2], g.Types[3]
+	if app.DualInterface == nil || app.DualInterface.Kind != TKIND_INTERFACE {
+		t.Fatalf("dual interface %+v", app.DualInterface)
+	}
+	move := app.FuncById(2)
+	tests := []struct {
+		name      string
+		got, want *TypeInfo
+	}{
+		{"Move Direction", move.Params[0].Type.TypeInfo, dir},
+		{"Color", app.FuncById(3).ReturnType.TypeInfo, color},
+		{"dual interface", app.DualInterface.DualInterface, nil},
+		{"dual interface super", app.DualInterface.Super, app.Super},
+	}
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s: %p, want %p", test.name, test.got, test.want)
+		}
+	}
+
+	//the types are built again for another arch
+	arch := utils.Arch
+	defer utils.SetArch(arch)
+	other := "386"
+	if utils.PtrSize == 4 {
+		other = "amd64"
+	}
+	utils.SetArch(other)
+	g2 := NewGraph(lib)
+	if g2.Arch != other || g2.Types[1] == point {
+		t.Errorf("arch %s: the TypeInfos of %s are reused", g2.Arch, g.Arch)
+	}
+}
+
+func TestGraphMembersById(t *testing.T) {
+	g := NewGraph(readTestLib(t, SYS_WIN32))
+	point, app := g.Types[1], g.Types[3]
+	funcs := []struct {
+		memid   MEMBERID
+		name    string
+		propGet bool
+	}{
+		{0, "Name", true},
+		{1, "Visible", true},
+		{2, "Move", false},
+		{99, "", false},
+	}
+	for _, test := range funcs {
+		f := app.FuncById(test.memid)
+		if f == nil {
+			if test.name != "" {
+				t.Errorf("no func of memid %d", test.memid)
+			}
+			continue
+		}
+		if f.Name != test.name || f.Flags.PropGet != test.propGet {
+			t.Errorf("memid %d: %s, propget %v", test.memid, f.Name, f.Flags.PropGet)
+		}
+	}
+	fields := []struct {
+		memid MEMBERID
+		name  string
+	}{
+		{0x40000000, "x"},
+		{0x40000002, "tag"},
+		{0x40000003, ""},
+	}
+	for _, test := range fields {
+		name := ""
+		if f := point.FieldById(test.memid); f != nil {
+			name = f.Name
+		}
+		if name != test.name {
+			t.Errorf("memid %x: field %q, want %q", test.memid, name, test.name)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package typelib
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// GUID has the same layout as syscall.GUID on windows.
+type GUID struct {
+	Data1 uint32
+	Data2 uint16
+	Data3 uint16
+	Data4 [8]byte
+}
+
+// String formats the guid the way win32.GuidToStr does, without braces.
+func (me GUID) String() string {
+	return fmt.Sprintf("%08X-%04X-%04X-%02X%02X-%02X%02X%02X%02X%02X%02X",
+		me.Data1, me.Data2, me.Data3, me.Data4[0], me.Data4[1],
+		me.Data4[2], me.Data4[3], me.Data4[4], me.Data4[5], me.Data4[6], me.Data4[7])
+}
+
+func (me GUID) IsNull() bool {
+	return me == GUID{}
+}
+
+// ParseGuid parses a guid string with or without braces.
+func ParseGuid(s string) (GUID, error) {
+	var g GUID
+	s = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(s), "{"), "}")
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return g, errors.New("invalid guid: " + s)
+	}
+	b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil {
+		return g, errors.New("invalid guid: " + s)
+	}
+	g.Data1 = uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	g.Data2 = uint16(b[4])<<8 | uint16(b[5])
+	g.Data3 = uint16(b[6])<<8 | uint16(b[7])
+	copy(g.Data4[:], b[8:])
+	return g, nil
+}
+
+func mustParseGuid(s string) GUID {
+	g, err := ParseGuid(s)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
@@ -0,0 +1,544 @@
+package typelib
+
+import (
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// MIDL-generated C/C++ header frontend. The header is read by the IDL
+// parser in header mode, as a C++ compiler would see it: interfaces
+// are taken from their MIDL_INTERFACE declarations, and from the
+// vtable structs of their C declarations when there is no C++ one.
+// The attributes MIDL leaves in comments (/* [in] */) are read as
+// attributes, those with values being lost. Headers included with
+// quotes are read from the same directory; types of the headers that
+// can not be found (rpc.h, oaidl.h..) are left unresolved.
+
+// attributes that have no use without their value
+var idlValuedAttrs = map[string]bool{
+	"id": true, "uuid": true, "version": true, "helpstring": true,
+	"helpcontext": true, "defaultvalue": true, "entry": true, "dllname": true,
+}
+
+// the accessors are named get_X, put_X and putref_X in headers
+var headerAccessorPrefixes = map[INVOKEKIND]string{INVOKE_PROPERTYGET: "get_",
+	INVOKE_PROPERTYPUT: "put_", INVOKE_PROPERTYPUTREF: "putref_"}
+
+// ReadHeaderFile builds a typelib from a MIDL-generated header file.
+func ReadHeaderFile(filePath string) (*TypeLib, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return newTypeLibFromHeader(filePath, string(data))
+}
+
+// NewTypeLibFromHeader builds a typelib from a MIDL-generated header.
+// Included headers are looked up relative to the working dir.
+func NewTypeLibFromHeader(src string) (*TypeLib, error) {
+	return newTypeLibFromHeader("", src)
+}
+
+func isHeaderFile(filePath string) bool {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".h", ".hpp", ".hxx":
+		return true
+	}
+	return false
+}
+
+func newTypeLibFromHeader(filePath string, src string) (*TypeLib, error) {
+	p := newIdlParser(filePath)
+	p.header = true
+	p.defines["__cplusplus"] = "1"
+	p.hasLib = true
+	p.inLib = true
+	p.attr.SysKind = SYS_WIN32
+	if utils.PtrSize == 8 {
+		p.attr.SysKind = SYS_WIN64
+	}
+	if filePath == "" {
+		filePath = "<header>"
+	} else {
+		p.name = strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	}
+	return p.parse(filePath, src)
+}
+
+// includeHeaders reads the headers included up to the current token
+func (this *idlParser) includeHeaders() {
+	includes := this.lex.includes
+	this.lex.includes = nil
+	for _, fileName := range includes {
+		filePath := filepath.Clean(filepath.Join(this.dir, fileName))
+		if this.files[filePath] {
+			continue
+		}
+		data, err := os.ReadFile(filePath)
+		if err != nil {
+			//sdk headers
+			this.missingLibs = append(this.missingLibs, fileName)
+			continue
+		}
+		inLib := this.inLib
+		this.inLib = false
+		this.parseFile(filePath, string(data))
+		this.inLib = inLib
+	}
+}
+
+// isSalAnnotation tells if name is a source annotation of the sdk
+// headers (_In_, _Out_writes_(n), __RPC__in..)
+func isSalAnnotation(name string) bool {
+	if strings.HasPrefix(name, "__RPC__") {
+		return true
+	}
+	if len(name) > 2 && name[0] == '_' && name[1] >= 'A' && name[1] <= 'Z' &&
+		strings.HasSuffix(name, "_") {
+		return true
+	}
+	if strings.HasPrefix(name, "__") {
+		switch strings.SplitN(name[2:], "_", 2)[0] {
+		case "in", "out", "inout", "deref", "reserved", "range", "opt",
+			"ecount", "bcount", "nullterminated", "checkReturn", "callback":
+			return true
+		}
+	}
+	return false
+}
+
+func (this *idlParser) parseHeaderItem(attrs idlAttrs) {
+	switch {
+	case this.tok.is(";"):
+		this.next()
+	case this.tok.is("}") && this.externs > 0:
+		this.externs--
+		this.next()
+	case this.tok.is("extern") && this.peek(2)[1].is("{"):
+		//extern "C" {
+		this.externs++
+		this.next()
+		this.next()
+		this.next()
+	case this.tok.is("EXTERN_C"):
+		this.parseExternGuid(attrs)
+	case this.tok.is("DEFINE_GUID"), this.tok.is("MIDL_DEFINE_GUID"):
+		this.parseDefineGuid()
+	case this.tok.is("MIDL_INTERFACE"):
+		this.parseHeaderInterface(attrs)
+	case (this.tok.is("struct") || this.tok.is("interface") || this.tok.is("class")) &&
+		this.lookAhead("DECLSPEC_UUID"):
+		this.parseHeaderInterface(attrs)
+	case this.tok.is("typedef"):
+		this.parseHeaderTypedef(attrs)
+	case this.tok.is("struct"), this.tok.is("union"), this.tok.is("enum"):
+		this.parseType(attrs)
+		if this.tok.is(";") {
+			this.next()
+		} else {
+			this.skipStatement()
+		}
+	default:
+		//prototypes, the C declarations of interfaces (interface X {
+		//CONST_VTBL struct XVtbl *lpVtbl; }), cpp_quote text..
+		this.skipStatement()
+	}
+}
+
+// skipStatement skips a declaration up to the ';' ending it, or up to
+// the '}' closing the body of an inline function.
+func (this *idlParser) skipStatement() {
+	depth := 0
+	for {
+		switch {
+		case this.tok.kind == idlEOF:
+			this.fail("unexpected end of file")
+		case this.tok.is("("), this.tok.is("["), this.tok.is("{"):
+			depth++
+		case this.tok.is(")"), this.tok.is("]"):
+			depth--
+		case this.tok.is("}"):
+			if depth == 0 {
+				return
+			}
+			depth--
+			if depth == 0 {
+				this.next()
+				return
+			}
+		case this.tok.is(";") && depth == 0:
+			this.next()
+			return
+		}
+		this.next()
+	}
+}
+
+// parseExternGuid parses EXTERN_C const IID IID_X; the attributes of
+// interface X and the name of the library are taken from these.
+func (this *idlParser) parseExternGuid(attrs idlAttrs) {
+	this.next()
+	this.skip("const")
+	if this.tok.kind == idlIdent {
+		this.next()
+	}
+	if this.tok.kind == idlIdent {
+		name := this.tok.text
+		switch {
+		case strings.HasPrefix(name, "IID_"):
+			this.typeAttrs[name[4:]] = attrs
+		case strings.HasPrefix(name, "LIBID_") && this.inLib:
+			this.name = name[6:]
+		}
+	}
+	this.skipStatement()
+}
+
+// parseDefineGuid parses DEFINE_GUID(IID_X, 0x..) and
+// MIDL_DEFINE_GUID(IID, IID_X, 0x..)
+func (this *idlParser) parseDefineGuid() {
+	midl := this.tok.is("MIDL_DEFINE_GUID")
+	this.next()
+	if !this.tok.is("(") {
+		this.fail("expected '('")
+	}
+	args := strings.Split(this.lex.raw(), ",")
+	this.next()
+	this.skip(";")
+	if midl && len(args) > 0 {
+		args = args[1:]
+	}
+	if len(args) != 12 {
+		this.fail("invalid guid definition")
+	}
+	var v [11]int64
+	for n := range v {
+		v[n] = this.toInt(this.evalText(args[n+1]))
+	}
+	guid := GUID{Data1: uint32(v[0]), Data2: uint16(v[1]), Data3: uint16(v[2])}
+	for n := range guid.Data4 {
+		guid.Data4[n] = byte(v[3+n])
+	}
+	this.guids[strings.TrimSpace(args[0])] = guid
+}
+
+func (this *idlParser) parseUuidCall() GUID {
+	this.next()
+	this.expect("(")
+	if this.tok.kind != idlString {
+		this.fail("uuid expected")
+	}
+	guid, err := ParseGuid(this.tok.text)
+	if err != nil {
+		this.fail("invalid uuid " + this.tok.text)
+	}
+	this.next()
+	this.expect(")")
+	return guid
+}
+
+// parseHeaderInterface parses the C++ declaration of an interface,
+// MIDL_INTERFACE("..") X : public Base { virtual .. = 0; };
+func (this *idlParser) parseHeaderInterface(attrs idlAttrs) {
+	var guid GUID
+	if this.tok.is("MIDL_INTERFACE") {
+		guid = this.parseUuidCall()
+	} else {
+		this.next()
+		for {
+			if this.tok.is("DECLSPEC_UUID") {
+				guid = this.parseUuidCall()
+			} else if this.tok.is("DECLSPEC_NOVTABLE") {
+				this.next()
+			} else {
+				break
+			}
+		}
+	}
+	name := this.ident()
+	if this.tok.is(";") {
+		//class DECLSPEC_UUID("..") X; of a coclass
+		this.next()
+		return
+	}
+	it := this.declare(name, TKIND_INTERFACE)
+	e := it.entry
+	for attrName, value := range this.typeAttrs[name] {
+		attrs[attrName] = value
+	}
+	this.applyTypeAttrs(e, attrs)
+	e.guid = guid
+	if this.tok.is(":") {
+		this.next()
+		this.skip("public")
+		e.impls = []*implEntry{{ref: this.lookupType(this.ident())}}
+	}
+	this.expect("{")
+	for !this.tok.is("}") {
+		memberAttrs := this.parseAttrs()
+		switch {
+		case this.tok.is("public"), this.tok.is("protected"), this.tok.is("private"):
+			this.next()
+			this.expect(":")
+		case this.tok.is("BEGIN_INTERFACE"), this.tok.is("END_INTERFACE"):
+			this.next()
+		case this.tok.is("virtual"):
+			this.next()
+			for attrName, value := range this.parseAttrs() {
+				memberAttrs[attrName] = value
+			}
+			e.funcs = append(e.funcs, this.parseFunc(memberAttrs, FUNC_PUREVIRTUAL))
+		default:
+			//templates and inline helpers
+			this.skipStatement()
+		}
+	}
+	this.next()
+	this.skip(";")
+	this.define(it)
+}
+
+func (this *idlParser) parseHeaderTypedef(attrs idlAttrs) {
+	toks := this.peek(3)
+	switch {
+	case toks[0].is("interface"), toks[0].is("class"):
+		//typedef interface X X;
+		this.next()
+		isClass := this.tok.is("class")
+		this.next()
+		name := this.ident()
+		if !isClass && this.types[name] == nil && this.builtinType(name) == nil {
+			this.declare(name, TKIND_INTERFACE)
+		}
+		this.skipStatement()
+	case toks[0].is("struct") && strings.HasSuffix(toks[1].text, "Vtbl") && toks[2].is("{"):
+		this.parseVtbl(attrs)
+	case this.isFuncTypedef():
+		this.skipStatement()
+	default:
+		this.parseTypedef(attrs)
+	}
+}
+
+// isFuncTypedef tells if the typedef at hand declares a function type
+func (this *idlParser) isFuncTypedef() bool {
+	lex := *this.lex
+	lex.conds = append([]idlCond(nil), this.lex.conds...)
+	depth := 0
+	prev := this.tok
+	for tok := lex.next(); tok.kind != idlEOF && !(tok.is(";") && depth == 0); tok = lex.next() {
+		switch {
+		case tok.is("{"):
+			depth++
+		case tok.is("}"):
+			depth--
+		case tok.is("(") && depth == 0:
+			if prev.kind != idlIdent || !isSalAnnotation(prev.text) && prev.text != "SAFEARRAY" {
+				return true
+			}
+		}
+		prev = tok
+	}
+	return false
+}
+
+// parseVtbl parses the vtable struct of the C declaration of an
+// interface. Its methods are those after the ones of the base, which
+// is told by DECLSPEC_XFGVIRT(Base, Method) or else by the methods of
+// the interfaces known.
+func (this *idlParser) parseVtbl(attrs idlAttrs) {
+	this.next()
+	this.next()
+	name := strings.TrimSuffix(this.ident(), "Vtbl")
+	this.expect("{")
+	var funcs []*funcEntry
+	var owners []string
+	for !this.tok.is("}") {
+		memberAttrs := this.parseAttrs()
+		owner := ""
+		switch {
+		case this.tok.is("BEGIN_INTERFACE"), this.tok.is("END_INTERFACE"):
+			this.next()
+			continue
+		case this.tok.is("DECLSPEC_XFGVIRT"):
+			this.next()
+			if !this.tok.is("(") {
+				this.fail("expected '('")
+			}
+			owner = strings.TrimSpace(strings.SplitN(this.lex.raw(), ",", 2)[0])
+			this.next()
+			for attrName, value := range this.parseAttrs() {
+				memberAttrs[attrName] = value
+			}
+		}
+		ret, _ := this.parseType(nil)
+		f := newIdlFunc(FUNC_PUREVIRTUAL, this.parsePointers(ret))
+		this.expect("(")
+		this.parseCallConv(f)
+		this.expect("*")
+		f.name = this.ident()
+		this.expect(")")
+		this.expect("(")
+		f.params = this.parseParams()
+		this.expect(")")
+		this.expect(";")
+		if len(f.params) != 0 {
+			//This
+			f.params = f.params[1:]
+		}
+		this.applyFuncAttrs(f, memberAttrs)
+		funcs = append(funcs, f)
+		owners = append(owners, owner)
+	}
+	this.next()
+	this.skipStatement()
+	if it := this.types[name]; it != nil && it.defined {
+		//declared in C++ as well
+		return
+	}
+
+	var base *typeEntry
+	own := 0
+	if len(owners) != 0 && owners[0] != "" {
+		own = len(owners)
+		for own > 0 && owners[own-1] == name {
+			own--
+		}
+		if own > 0 {
+			base = this.lookupType(owners[own-1])
+		}
+	} else {
+		base, own = this.vtblBase(funcs)
+	}
+	it := this.declare(name, TKIND_INTERFACE)
+	e := it.entry
+	for attrName, value := range this.typeAttrs[name] {
+		attrs[attrName] = value
+	}
+	this.applyTypeAttrs(e, attrs)
+	if base != nil {
+		e.impls = []*implEntry{{ref: base}}
+	}
+	e.funcs = funcs[own:]
+	this.define(it)
+}
+
+// vtblBase returns the known interface whose methods the vtable starts
+// with, and the number of them.
+func (this *idlParser) vtblBase(funcs []*funcEntry) (*typeEntry, int) {
+	candidates := []*typeEntry{stdoleTypes[3], stdoleTypes[4]}
+	for _, it := range this.order {
+		if it.entry.kind == TKIND_INTERFACE {
+			candidates = append(candidates, it.entry)
+		}
+	}
+	var base *typeEntry
+	count := 0
+	for _, e := range candidates {
+		var names []string
+		for b := e; b != nil; b = baseOf(b) {
+			var bNames []string
+			for _, f := range b.funcs {
+				bNames = append(bNames, headerAccessorPrefixes[f.invKind]+f.name)
+			}
+			names = append(bNames, names...)
+		}
+		if len(names) <= count || len(names) > len(funcs) {
+			continue
+		}
+		match := true
+		for n, name := range names {
+			if headerAccessorPrefixes[funcs[n].invKind]+funcs[n].name != name {
+				match = false
+				break
+			}
+		}
+		if match {
+			base, count = e, len(names)
+		}
+	}
+	return base, count
+}
+
+// scanVtblSlots reads the method names of the vtable structs of the C
+// declarations in order, which the C++ ones leave out: their place is
+// the vtable slot, whether the base interfaces are known or not.
+func (this *idlParser) scanVtblSlots(filePath string, src string) {
+	defines := make(map[string]string)
+	for name, value := range this.defines {
+		if name != "__cplusplus" {
+			defines[name] = value
+		}
+	}
+	lex := newIdlLexer(filePath, src, defines)
+	var name string //of the vtable struct at hand
+	var names []string
+	var prev, prev2 idlToken
+	depth, parens := 0, 0
+	named := false //the member at hand has its name
+	for tok := lex.next(); tok.kind != idlEOF; tok = lex.next() {
+		switch {
+		case name == "":
+			if tok.is("{") && prev2.is("struct") && strings.HasSuffix(prev.text, "Vtbl") {
+				name = strings.TrimSuffix(prev.text, "Vtbl")
+				names, depth, parens, named = nil, 1, 0, false
+			}
+		case tok.is("{"):
+			depth++
+		case tok.is("}"):
+			depth--
+			if depth == 0 {
+				this.vtblSlots[name] = names
+				name = ""
+			}
+		case tok.is("("):
+			parens++
+		case tok.is(")"):
+			parens--
+		case tok.is(";"):
+			named = false
+		case depth == 1 && parens == 1 && !named && prev.is("*") && tok.kind == idlIdent:
+			//RET ( STDMETHODCALLTYPE *Name )( X * This, ..);
+			names = append(names, tok.text)
+			named = true
+		}
+		prev2, prev = prev, tok
+	}
+}
+
+// applyVtblSlots takes the vtable slots of the methods from the vtable
+// structs that have them
+func (this *idlParser) applyVtblSlots(ptrSize int) {
+	for _, it := range this.order {
+		e := it.entry
+		slots := this.vtblSlots[e.name]
+		if e.kind != TKIND_INTERFACE || slots == nil {
+			continue
+		}
+		for _, f := range e.funcs {
+			name := headerAccessorPrefixes[f.invKind] + f.name
+			for n := len(slots) - 1; n >= 0; n-- {
+				if slots[n] == name {
+					f.vtblIndex = n
+					break
+				}
+			}
+		}
+		e.sizeVft = len(slots) * ptrSize
+	}
+}
+
+// finishHeader applies the guids given by DEFINE_GUID
+func (this *idlParser) finishHeader() {
+	for _, it := range this.order {
+		e := it.entry
+		if e.kind == TKIND_INTERFACE && e.guid.IsNull() {
+			e.guid = this.guids["IID_"+e.name]
+		}
+	}
+	if guid, ok := this.guids["LIBID_"+this.name]; ok {
+		this.attr.Guid = guid
+	}
+}
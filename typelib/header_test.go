@@ -0,0 +1,326 @@
+package typelib
+
+import (
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testHeader = `/* File created by MIDL compiler version 8.01.0628 */
+#include "rpc.h"
+#include "oaidl.h"
+#include "oleidl.h"
+
+#ifndef __shapes_h__
+#define __shapes_h__
+
+typedef interface IShape IShape;
+
+typedef /* [public] */
+enum __MIDL___MIDL_itf_shapes_0000_0000_0001
+    {
+        ShapeKindNone	= 0,
+        ShapeKindCircle	= 1,
+        ShapeKindSquare	= ( ShapeKindCircle + 1 ) ,
+        ShapeKindAll	= 0xff
+    } 	ShapeKind;
+
+typedef struct __MIDL___MIDL_itf_shapes_0000_0000_0002
+    {
+    LONG x;
+    LONG y;
+    } 	ShapePoint;
+
+typedef struct tagShapeInfo
+    {
+    ShapeKind kind;
+    ShapePoint origin;
+    BSTR name;
+    BYTE tag[ 16 ];
+    } 	ShapeInfo;
+
+EXTERN_C const IID IID_IShape;
+
+#if defined(__cplusplus) && !defined(CINTERFACE)
+
+    MIDL_INTERFACE("4C7B5D42-3E0B-4E76-9A3A-1C2D6A5E7F01")
+    IShape : public IUnknown
+    {
+    public:
+        virtual /* [propget] */ HRESULT STDMETHODCALLTYPE get_Kind(
+            /* [retval][out] */ __RPC__out ShapeKind *pKind) = 0;
+
+        virtual HRESULT STDMETHODCALLTYPE Move(
+            /* [in] */ ShapePoint delta,
+            /* [annotation][in] */
+            _In_opt_  IUnknown *pContext) = 0;
+
+        virtual HRESULT STDMETHODCALLTYPE SetName(
+            /* [string][in] */ __RPC__in_string LPCWSTR pszName) = 0;
+    };
+
+#else 	/* C style interface */
+    typedef struct IShapeVtbl
+    {
+        BEGIN_INTERFACE
+        END_INTERFACE
+    } IShapeVtbl;
+#endif 	/* C style interface */
+
+#if defined(__cplusplus) && !defined(CINTERFACE)
+
+    MIDL_INTERFACE("4C7B5D42-3E0B-4E76-9A3A-1C2D6A5E7F02")
+    ICircle : public IDispatch
+    {
+    public:
+        virtual /* [propget][id] */ HRESULT STDMETHODCALLTYPE get_Radius(
+            /* [retval][out] */ __RPC__out double *pVal) = 0;
+
+        virtual /* [propput][id] */ HRESULT STDMETHODCALLTYPE put_Radius(
+            /* [in] */ double newVal) = 0;
+    };
+
+#endif
+
+#if defined(__cplusplus) && !defined(CINTERFACE)
+
+    MIDL_INTERFACE("4C7B5D42-3E0B-4E76-9A3A-1C2D6A5E7F03")
+    IWin : public IOleWindow
+    {
+    public:
+        virtual HRESULT STDMETHODCALLTYPE DoIt( void) = 0;
+    };
+
+#else 	/* C style interface */
+
+    typedef struct IWinVtbl
+    {
+        BEGIN_INTERFACE
+        HRESULT ( STDMETHODCALLTYPE *QueryInterface )(IWin * This, REFIID riid, void **ppvObject);
+        ULONG ( STDMETHODCALLTYPE *AddRef )(IWin * This);
+        ULONG ( STDMETHODCALLTYPE *Release )(IWin * This);
+        HRESULT ( STDMETHODCALLTYPE *GetWindow )(IWin * This, HWND *phwnd);
+        HRESULT ( STDMETHODCALLTYPE *ContextSensitiveHelp )(IWin * This, BOOL fEnterMode);
+        HRESULT ( STDMETHODCALLTYPE *DoIt )(IWin * This);
+        END_INTERFACE
+    } IWinVtbl;
+
+#endif 	/* C style interface */
+
+DEFINE_GUID(IID_ISquare, 0x4c7b5d42, 0x3e0b, 0x4e76, 0x9a, 0x3a, 0x1c, 0x2d, 0x6a, 0x5e, 0x7f, 0x04);
+
+typedef interface ISquare ISquare;
+
+typedef struct ISquareVtbl
+{
+    BEGIN_INTERFACE
+    HRESULT ( STDMETHODCALLTYPE *QueryInterface )( ISquare * This, REFIID riid, void **ppvObject);
+    ULONG ( STDMETHODCALLTYPE *AddRef )( ISquare * This);
+    ULONG ( STDMETHODCALLTYPE *Release )( ISquare * This);
+    HRESULT ( STDMETHODCALLTYPE *get_Kind )( ISquare * This, ShapeKind *pKind);
+    HRESULT ( STDMETHODCALLTYPE *Move )( ISquare * This, ShapePoint delta, IUnknown *pContext);
+    HRESULT ( STDMETHODCALLTYPE *SetName )( ISquare * This, LPCWSTR pszName);
+    /* [propget] */ HRESULT ( STDMETHODCALLTYPE *get_Side )( ISquare * This, /* [retval][out] */ LONG *pSide);
+    END_INTERFACE
+} ISquareVtbl;
+
+typedef struct ISquare2Vtbl
+{
+    BEGIN_INTERFACE
+    DECLSPEC_XFGVIRT(IUnknown, QueryInterface)
+    HRESULT ( STDMETHODCALLTYPE *QueryInterface )( ISquare2 * This, REFIID riid, void **ppvObject);
+    DECLSPEC_XFGVIRT(IUnknown, AddRef)
+    ULONG ( STDMETHODCALLTYPE *AddRef )( ISquare2 * This);
+    DECLSPEC_XFGVIRT(IUnknown, Release)
+    ULONG ( STDMETHODCALLTYPE *Release )( ISquare2 * This);
+    DECLSPEC_XFGVIRT(ISquare2, Resize)
+    HRESULT ( STDMETHODCALLTYPE *Resize )( ISquare2 * This, /* [in] */ LONG side, /* [out] */ ShapeInfo *pInfo);
+    END_INTERFACE
+} ISquare2Vtbl;
+
+MIDL_DEFINE_GUID(IID, LIBID_ShapesLib,0x4c7b5d42,0x3e0b,0x4e76,0x9a,0x3a,0x1c,0x2d,0x6a,0x5e,0x7f,0x00);
+
+EXTERN_C const IID LIBID_ShapesLib;
+
+class DECLSPEC_UUID("4C7B5D42-3E0B-4E76-9A3A-1C2D6A5E7F10")
+Circle;
+
+#endif
+`
+
+func TestHeaderTypes(t *testing.T) {
+	lib, err := NewTypeLibFromHeader(testHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lib.GetName() != "ShapesLib" ||
+		lib.GetLibAttr().Guid.String() != "4C7B5D42-3E0B-4E76-9A3A-1C2D6A5E7F00" {
+		t.Errorf("lib %s %v", lib.GetName(), lib.GetLibAttr().Guid)
+	}
+	tests := []struct {
+		name   string
+		kind   TYPEKIND
+		super  string
+		fields []string //name type
+		funcs  []string
+	}{
+		{"ShapeKind", TKIND_ENUM, "", []string{"ShapeKindNone int32", "ShapeKindCircle int32",
+			"ShapeKindSquare int32", "ShapeKindAll int32"}, nil},
+		{"ShapePoint", TKIND_RECORD, "", []string{"x int32", "y int32"}, nil},
+		{"ShapeInfo", TKIND_RECORD, "", []string{"kind ShapeKind", "origin ShapePoint",
+			"name win32.BSTR", "tag [16]byte"}, nil},
+		{"IShape", TKIND_INTERFACE, "IUnknown", nil, []string{
+			"Kind win32.HRESULT(*ShapeKind)", "Move win32.HRESULT(ShapePoint,*IUnknown)",
+			"SetName win32.HRESULT(win32.PWSTR)"}},
+		{"ICircle", TKIND_INTERFACE, "IDispatch", nil, []string{
+			"Radius win32.HRESULT(*float64)", "Radius win32.HRESULT(float64)"}},
+		{"IWin", TKIND_INTERFACE, "IOleWindow", nil, []string{"DoIt win32.HRESULT()"}},
+		{"ISquare", TKIND_INTERFACE, "IShape", nil, []string{"Side win32.HRESULT(*int32)"}},
+		{"ISquare2", TKIND_INTERFACE, "IUnknown", nil, []string{
+			"Resize win32.HRESULT(int32,*ShapeInfo)"}},
+	}
+	if lib.GetTypeInfoCount() != len(tests) {
+		t.Errorf("%d types, want %d", lib.GetTypeInfoCount(), len(tests))
+	}
+	for n, test := range tests {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		super := ""
+		if ti.Super != nil {
+			super = ti.Super.Name
+		}
+		if ti.Name != test.name || ti.Kind != test.kind || super != test.super {
+			t.Errorf("type %d: %s %v : %s, want %s %v : %s", n, ti.Name, ti.Kind, super,
+				test.name, test.kind, test.super)
+		}
+		var fields []string
+		for _, f := range ti.Fields {
+			fields = append(fields, f.Name+" "+f.Type.Name)
+		}
+		if !reflect.DeepEqual(fields, test.fields) {
+			t.Errorf("%s fields %q, want %q", test.name, fields, test.fields)
+		}
+		if funcs := formatFuncs(ti); !reflect.DeepEqual(funcs, test.funcs) {
+			t.Errorf("%s funcs %q, want %q", test.name, funcs, test.funcs)
+		}
+	}
+}
+
+func TestHeaderMembers(t *testing.T) {
+	lib, err := NewTypeLibFromHeader(testHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind, _ := lib.GetTypeInfo(0)
+	var values []int32
+	for _, f := range kind.Fields {
+		values = append(values, f.Value.(int32))
+	}
+	if !reflect.DeepEqual(values, []int32{0, 1, 2, 255}) {
+		t.Errorf("ShapeKind values %v", values)
+	}
+	info, _ := lib.GetTypeInfo(2)
+	//the BSTR is aligned to the pointer size
+	want := map[int][2]int{4: {32, 16}, 8: {40, 24}}[utils.PtrSize]
+	if info.Size != want[0] || info.Fields[3].Offset != want[1] {
+		t.Errorf("ShapeInfo size %d, tag at %d", info.Size, info.Fields[3].Offset)
+	}
+
+	shape, _ := lib.GetTypeInfo(3)
+	kindFunc := shape.Funcs[0]
+	if !kindFunc.Flags.PropGet || !kindFunc.Params[0].Flags.Retval || !kindFunc.Params[0].Flags.Out {
+		t.Errorf("get_Kind flags %+v, param flags %+v", kindFunc.Flags, kindFunc.Params[0].Flags)
+	}
+	circle, _ := lib.GetTypeInfo(4)
+	if !circle.Funcs[1].Flags.PropPut {
+		t.Errorf("put_Radius flags %+v", circle.Funcs[1].Flags)
+	}
+
+	//the vtable slots, IWin after the methods of the known IOleWindow
+	tests := []struct {
+		index int
+		slots []int
+	}{
+		{3, []int{3, 4, 5}},
+		{4, []int{7, 8}},
+		{5, []int{5}},
+		{6, []int{6}},
+		{7, []int{3}},
+	}
+	for _, test := range tests {
+		ti, _ := lib.GetTypeInfo(test.index)
+		var slots []int
+		for _, f := range ti.Funcs {
+			slots = append(slots, f.VtblOffset/utils.PtrSize)
+		}
+		if !reflect.DeepEqual(slots, test.slots) || len(ti.Errors) != 0 {
+			t.Errorf("%s slots %v, want %v, errors %v", ti.Name, slots, test.slots, ti.Errors)
+		}
+	}
+}
+
+func TestHeaderInclude(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"shapes.h": testHeader,
+		"extra.h": `#include "shapes.h"
+
+    MIDL_INTERFACE("4C7B5D42-3E0B-4E76-9A3A-1C2D6A5E7F05")
+    IExtra : public IShape
+    {
+    public:
+        virtual HRESULT STDMETHODCALLTYPE Fill(ShapeInfo *pInfo) = 0;
+    };
+`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lib, err := ReadTypeLibFile(filepath.Join(dir, "extra.h"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var extra *TypeInfo
+	for n := 0; n < lib.GetTypeInfoCount(); n++ {
+		if ti, _ := lib.GetTypeInfo(n); ti.Name == "IExtra" {
+			extra = ti
+		}
+	}
+	if extra == nil || extra.Super == nil || extra.Super.Name != "IShape" ||
+		extra.Funcs[0].VtblOffset != 6*utils.PtrSize {
+		t.Fatalf("IExtra %+v", extra)
+	}
+	if p := extra.Funcs[0].Params[0]; p.Type.Name != "*ShapeInfo" {
+		t.Errorf("Fill takes %s", p.Type.Name)
+	}
+}
+
+func TestHeaderErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		err  string
+	}{
+		{"unterminated", `MIDL_INTERFACE("11111111-2222-3333-4444-555555555557") IBar : public IUnknown {`,
+			"end of file"},
+		{"uuid", `MIDL_INTERFACE("1-2") IBar : public IUnknown { };`, "uuid"},
+		{"method", `MIDL_INTERFACE("11111111-2222-3333-4444-555555555557") IBar : public IUnknown {
+			virtual HRESULT STDMETHODCALLTYPE F(LONG a = 0; };`, ""},
+	}
+	for _, test := range tests {
+		_, err := NewTypeLibFromHeader(test.src)
+		if err == nil {
+			t.Errorf("%s: no error", test.name)
+		} else if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: %v, want %q", test.name, err, test.err)
+		}
+	}
+}
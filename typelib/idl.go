@@ -0,0 +1,1434 @@
+package typelib
+
+import (
+	"errors"
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// IDL/ODL frontend. The library block of the source is laid out into
+// the same descriptors the binary readers produce, the way MIDL would
+// write them into a .tlb. Types declared outside of the library block
+// are included when the library refers to them, as MIDL does.
+
+var idlBaseTypes = map[string]VARENUM{
+	"void": VT_VOID, "int": VT_INT, "long": VT_I4, "short": VT_I2,
+	"char": VT_I1, "small": VT_I1, "hyper": VT_I8, "__int64": VT_I8,
+	"float": VT_R4, "double": VT_R8, "byte": VT_UI1, "boolean": VT_UI1,
+	"wchar_t": VT_UI2, "unsigned": VT_UINT, "signed": VT_INT,
+
+	"BSTR": VT_BSTR, "VARIANT": VT_VARIANT, "VARIANTARG": VT_VARIANT,
+	"VARIANT_BOOL": VT_BOOL, "DATE": VT_DATE, "CY": VT_CY, "CURRENCY": VT_CY,
+	"DECIMAL": VT_DECIMAL, "SCODE": VT_ERROR, "HRESULT": VT_HRESULT,
+	"LPSTR": VT_LPSTR, "LPCSTR": VT_LPSTR, "LPWSTR": VT_LPWSTR,
+	"LPCWSTR": VT_LPWSTR, "LPOLESTR": VT_LPWSTR, "LPCOLESTR": VT_LPWSTR,
+
+	"BYTE": VT_UI1, "WORD": VT_UI2, "DWORD": VT_UI4, "UINT": VT_UINT,
+	"INT": VT_INT, "LONG": VT_I4, "ULONG": VT_UI4, "USHORT": VT_UI2,
+	"SHORT": VT_I2, "BOOL": VT_I4, "CHAR": VT_I1, "UCHAR": VT_UI1,
+	"WCHAR": VT_UI2, "OLECHAR": VT_UI2, "FLOAT": VT_R4, "DOUBLE": VT_R8,
+	"LONGLONG": VT_I8, "ULONGLONG": VT_UI8, "DWORDLONG": VT_UI8,
+	"INT_PTR": VT_INT_PTR, "LONG_PTR": VT_INT_PTR, "UINT_PTR": VT_UINT_PTR,
+	"ULONG_PTR": VT_UINT_PTR, "DWORD_PTR": VT_UINT_PTR, "SIZE_T": VT_UINT_PTR,
+	"LCID": VT_UI4, "VARTYPE": VT_UI2, "BOOLEAN": VT_UI1,
+	"INT32": VT_I4, "UINT32": VT_UI4, "INT64": VT_I8, "UINT64": VT_UI8,
+	"LONG64": VT_I8, "ULONG64": VT_UI8, "DWORD64": VT_UI8,
+	"WPARAM": VT_UINT_PTR, "LPARAM": VT_INT_PTR, "LRESULT": VT_INT_PTR,
+}
+
+// handle types of windows.h, taken the way MIDL writes them into a
+// typelib (wireHWND..)
+var idlHandleTypes = map[string]bool{
+	"HANDLE": true, "HWND": true, "HDC": true, "HMENU": true, "HICON": true,
+	"HCURSOR": true, "HBITMAP": true, "HBRUSH": true, "HFONT": true,
+	"HPALETTE": true, "HRGN": true, "HINSTANCE": true, "HGLOBAL": true,
+	"HKEY": true, "HMONITOR": true, "HACCEL": true, "HENHMETAFILE": true,
+}
+
+var idlCallConvs = map[string]CALLCONV{
+	"stdcall": CC_STDCALL, "cdecl": CC_CDECL,
+	"pascal": CC_PASCAL, "fastcall": CC_FASTCALL,
+	"STDMETHODCALLTYPE": CC_STDCALL, "STDAPICALLTYPE": CC_STDCALL,
+	"WINAPI": CC_STDCALL, "CALLBACK": CC_STDCALL,
+}
+
+// idlAttrs maps the attribute names to the raw text of their values.
+// The custom attributes, which may repeat, are kept as custom, custom1..
+type idlAttrs map[string]string
+
+func (this idlAttrs) has(name string) bool {
+	_, ok := this[name]
+	return ok
+}
+
+type idlType struct {
+	entry   *typeEntry
+	defined bool
+	inLib   bool
+	dispOf  *typeEntry //dispinterface X { interface I; }
+}
+
+type idlParser struct {
+	lex *idlLexer
+	tok idlToken
+	dir string
+
+	defines map[string]string
+	consts  map[string]interface{}
+	types   map[string]*idlType
+	byEntry map[*typeEntry]*idlType
+	order   []*idlType
+	known   map[string]*typeEntry
+	files   map[string]bool
+
+	inLib       bool
+	hasLib      bool
+	name        string
+	doc         string
+	helpFile    string
+	helpContext uint32
+	attr        LibAttr
+	custData    []*CustData
+
+	imports     []*TypeLib
+	missingLibs []string //imported typelibs that could not be read
+	anonCount   int
+
+	//header frontend
+	header    bool
+	externs   int //open extern "C" blocks
+	guids     map[string]GUID
+	typeAttrs map[string]idlAttrs
+	vtblSlots map[string][]string //method names of the C vtable structs
+}
+
+// ReadIdlFile builds a typelib from an IDL/ODL source file.
+func ReadIdlFile(filePath string) (*TypeLib, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return newTypeLibFromIdl(filePath, string(data))
+}
+
+// NewTypeLibFromIdl builds a typelib from IDL/ODL source. Files named
+// by import and importlib are looked up relative to the working dir.
+func NewTypeLibFromIdl(src string) (*TypeLib, error) {
+	return newTypeLibFromIdl("", src)
+}
+
+func isIdlFile(filePath string) bool {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	return ext == ".idl" || ext == ".odl"
+}
+
+func newTypeLibFromIdl(filePath string, src string) (*TypeLib, error) {
+	p := newIdlParser(filePath)
+	p.defines["__midl"] = "501"
+	if filePath == "" {
+		filePath = "<idl>"
+	}
+	return p.parse(filePath, src)
+}
+
+func newIdlParser(filePath string) *idlParser {
+	return &idlParser{
+		dir:       filepath.Dir(filePath),
+		defines:   make(map[string]string),
+		consts:    make(map[string]interface{}),
+		types:     make(map[string]*idlType),
+		byEntry:   make(map[*typeEntry]*idlType),
+		known:     make(map[string]*typeEntry),
+		files:     make(map[string]bool),
+		guids:     make(map[string]GUID),
+		typeAttrs: make(map[string]idlAttrs),
+		vtblSlots: make(map[string][]string),
+	}
+}
+
+func (this *idlParser) parse(filePath string, src string) (lib *TypeLib, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			e, ok := r.(*idlError)
+			if !ok {
+				panic(r)
+			}
+			lib, err = nil, e
+		}
+	}()
+	this.parseFile(filePath, src)
+	entries, err := this.finish()
+	if err != nil {
+		return nil, err
+	}
+	return &TypeLib{name: this.name, doc: this.doc, helpFile: this.helpFile,
+		helpContext: this.helpContext, attr: this.attr, custData: this.custData,
+		entries: entries, laidOut: true}, nil
+}
+
+func (this *idlParser) parseFile(filePath string, src string) {
+	this.files[filepath.Clean(filePath)] = true
+	lex, tok := this.lex, this.tok
+	this.lex = newIdlLexer(filePath, src, this.defines)
+	this.lex.attrComments = this.header
+	if this.header {
+		this.scanVtblSlots(filePath, src)
+	}
+	this.next()
+	for this.tok.kind != idlEOF {
+		if this.header {
+			this.includeHeaders()
+			this.parseHeaderItem(this.parseAttrs())
+		} else {
+			this.parseItem(this.parseAttrs())
+		}
+	}
+	if this.header {
+		this.includeHeaders()
+	}
+	this.lex, this.tok = lex, tok
+}
+
+func (this *idlParser) fail(msg string) {
+	panic(&idlError{file: this.lex.file, line: this.tok.line, msg: msg})
+}
+
+func (this *idlParser) next() {
+	this.tok = this.lex.next()
+}
+
+func (this *idlParser) expect(text string) {
+	if !this.tok.is(text) {
+		this.fail("expected '" + text + "', found '" + this.tok.text + "'")
+	}
+	this.next()
+}
+
+func (this *idlParser) skip(text string) {
+	if this.tok.is(text) {
+		this.next()
+	}
+}
+
+func (this *idlParser) ident() string {
+	if this.tok.kind != idlIdent {
+		this.fail("identifier expected, found '" + this.tok.text + "'")
+	}
+	name := this.tok.text
+	this.next()
+	return name
+}
+
+func (this *idlParser) parseAttrs() idlAttrs {
+	attrs := idlAttrs{}
+	for this.tok.is("[") {
+		this.next()
+		for !this.tok.is("]") {
+			name := this.ident()
+			value := ""
+			if this.tok.is("(") {
+				value = this.lex.raw()
+				this.next()
+			}
+			if name == "custom" {
+				for n := 1; attrs.has(name); n++ {
+					name = "custom" + strconv.Itoa(n)
+				}
+			}
+			if !this.header || value != "" || !idlValuedAttrs[name] {
+				attrs[name] = value
+			}
+			if !this.tok.is("]") {
+				this.expect(",")
+			}
+		}
+		this.next()
+	}
+	return attrs
+}
+
+func (this *idlParser) parseItem(attrs idlAttrs) {
+	switch {
+	case this.tok.is(";"):
+		this.next()
+	case this.tok.is("import"):
+		this.next()
+		for {
+			if this.tok.kind != idlString {
+				this.fail("file name expected")
+			}
+			fileName := this.tok.text
+			this.next()
+			this.importIdl(fileName)
+			if !this.tok.is(",") {
+				break
+			}
+			this.next()
+		}
+		this.expect(";")
+	case this.tok.is("importlib"):
+		this.next()
+		this.expect("(")
+		if this.tok.kind != idlString {
+			this.fail("file name expected")
+		}
+		fileName := this.tok.text
+		this.next()
+		this.expect(")")
+		this.skip(";")
+		this.importLib(fileName)
+	case this.tok.is("library"):
+		this.parseLibrary(attrs)
+	case this.tok.is("interface"):
+		this.parseInterface(attrs)
+	case this.tok.is("dispinterface"):
+		this.parseDispInterface(attrs)
+	case this.tok.is("coclass"):
+		this.parseCoClass(attrs)
+	case this.tok.is("module"):
+		this.parseModule(attrs)
+	case this.tok.is("typedef"):
+		this.parseTypedef(attrs)
+	case this.tok.is("const"):
+		this.parseConst()
+	case this.tok.is("struct"), this.tok.is("union"), this.tok.is("enum"):
+		this.parseType(attrs)
+		this.expect(";")
+	case this.tok.is("midl_pragma"):
+		this.next()
+		this.ident()
+		this.skipCall()
+	case this.tok.kind == idlIdent && this.lookAhead("("):
+		//cpp_quote and the like
+		this.next()
+		this.skipCall()
+	default:
+		this.fail("unexpected '" + this.tok.text + "'")
+	}
+}
+
+// lookAhead tells if the token after the current one is text
+func (this *idlParser) lookAhead(text string) bool {
+	return this.peek(1)[0].is(text)
+}
+
+// peek returns the n tokens after the current one
+func (this *idlParser) peek(n int) []idlToken {
+	lex := *this.lex
+	lex.conds = append([]idlCond(nil), this.lex.conds...)
+	var toks []idlToken
+	for len(toks) < n {
+		toks = append(toks, lex.next())
+	}
+	return toks
+}
+
+func (this *idlParser) skipCall() {
+	if !this.tok.is("(") {
+		this.fail("expected '('")
+	}
+	this.lex.raw()
+	this.next()
+	this.skip(";")
+}
+
+func (this *idlParser) importIdl(fileName string) {
+	filePath := filepath.Clean(filepath.Join(this.dir, fileName))
+	if this.files[filePath] {
+		return
+	}
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		//system idls (oaidl.idl, ocidl.idl..): the types they have that
+		//are not known builtin are left as of a library not read
+		this.missingLibs = append(this.missingLibs, fileName)
+		return
+	}
+	inLib := this.inLib
+	this.inLib = false
+	this.parseFile(filePath, string(data))
+	this.inLib = inLib
+}
+
+func (this *idlParser) importLib(fileName string) {
+	switch strings.ToLower(filepath.Base(fileName)) {
+	case "stdole2.tlb", "stdole32.tlb", "stdole.tlb":
+		return
+	}
+	for _, filePath := range []string{filepath.Join(this.dir, fileName), fileName} {
+		lib, err := ReadTypeLibFile(filePath)
+		if err == nil {
+			impLib := newImpLib(&lib.attr, fileName)
+			for _, e := range lib.entries {
+				e.impLib = impLib
+			}
+			this.imports = append(this.imports, lib)
+			return
+		}
+	}
+	this.missingLibs = append(this.missingLibs, fileName)
+}
+
+func (this *idlParser) evalText(text string) interface{} {
+	lex := newIdlLexer(this.lex.file, text, this.defines)
+	lex.line = this.tok.line
+	return evalIdlExpr(lex, func(name string) (interface{}, bool) {
+		v, ok := this.consts[name]
+		return v, ok
+	})
+}
+
+func (this *idlParser) toInt(v interface{}) int64 {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	}
+	this.fail("integer expected")
+	return 0
+}
+
+func (this *idlParser) attrInt(attrs idlAttrs, name string) (int64, bool) {
+	text, ok := attrs[name]
+	if !ok {
+		return 0, false
+	}
+	return this.toInt(this.evalText(text)), true
+}
+
+func (this *idlParser) attrStr(attrs idlAttrs, name string) string {
+	text, ok := attrs[name]
+	if !ok {
+		return ""
+	}
+	s, ok := this.evalText(text).(string)
+	if !ok {
+		this.fail(name + ": string expected")
+	}
+	return s
+}
+
+func (this *idlParser) attrGuid(attrs idlAttrs) GUID {
+	text, ok := attrs["uuid"]
+	if !ok {
+		return GUID{}
+	}
+	guid, err := ParseGuid(strings.Trim(text, "\""))
+	if err != nil {
+		this.fail("invalid uuid " + text)
+	}
+	return guid
+}
+
+// attrCustData evaluates the custom(guid, value) attributes.
+func (this *idlParser) attrCustData(attrs idlAttrs) []*CustData {
+	var custData []*CustData
+	name := "custom"
+	for n := 1; attrs.has(name); n++ {
+		parts := strings.SplitN(attrs[name], ",", 2)
+		if len(parts) != 2 {
+			this.fail("custom: guid and value expected")
+		}
+		guid, err := ParseGuid(strings.Trim(parts[0], " \t\"{}"))
+		if err != nil {
+			this.fail("invalid custom guid " + parts[0])
+		}
+		value := this.evalText(parts[1])
+		if i, ok := value.(int64); ok && i == int64(int32(i)) {
+			value = int32(i)
+		}
+		custData = append(custData, &CustData{Guid: guid, Value: value})
+		name = "custom" + strconv.Itoa(n)
+	}
+	return custData
+}
+
+func (this *idlParser) attrVersion(attrs idlAttrs) (uint16, uint16) {
+	parts := strings.SplitN(attrs["version"], ".", 2)
+	major, _ := strconv.Atoi(parts[0])
+	minor := 0
+	if len(parts) == 2 {
+		minor, _ = strconv.Atoi(parts[1])
+	}
+	return uint16(major), uint16(minor)
+}
+
+func (this *idlParser) parseLibrary(attrs idlAttrs) {
+	this.next()
+	if this.hasLib {
+		this.fail("more than one library block")
+	}
+	this.hasLib = true
+	this.name = this.ident()
+	this.doc = this.attrStr(attrs, "helpstring")
+	this.helpFile = this.attrStr(attrs, "helpfile")
+	this.custData = this.attrCustData(attrs)
+	if ctx, ok := this.attrInt(attrs, "helpcontext"); ok {
+		this.helpContext = uint32(ctx)
+	}
+	this.attr.Guid = this.attrGuid(attrs)
+	this.attr.MajorVer, this.attr.MinorVer = this.attrVersion(attrs)
+	if lcid, ok := this.attrInt(attrs, "lcid"); ok {
+		this.attr.Lcid = uint32(lcid)
+	}
+	this.attr.SysKind = SYS_WIN32
+	if utils.PtrSize == 8 {
+		this.attr.SysKind = SYS_WIN64
+	}
+	if attrs.has("restricted") {
+		this.attr.Flags |= LIBFLAG_FRESTRICTED
+	}
+	if attrs.has("control") {
+		this.attr.Flags |= LIBFLAG_FCONTROL
+	}
+	if attrs.has("hidden") {
+		this.attr.Flags |= LIBFLAG_FHIDDEN
+	}
+
+	this.expect("{")
+	this.inLib = true
+	for !this.tok.is("}") {
+		if this.tok.kind == idlEOF {
+			this.fail("missing '}'")
+		}
+		this.parseItem(this.parseAttrs())
+	}
+	this.inLib = false
+	this.next()
+	this.skip(";")
+}
+
+// lookupType returns the entry of a named type, a placeholder
+// if it is not known yet.
+func (this *idlParser) lookupType(name string) *typeEntry {
+	if it := this.types[name]; it != nil {
+		return it.entry
+	}
+	if e := this.builtinType(name); e != nil {
+		return e
+	}
+	return this.placeholder(name).entry
+}
+
+func (this *idlParser) builtinType(name string) *typeEntry {
+	for _, e := range stdoleTypes {
+		if e.name == name {
+			return e
+		}
+	}
+	switch name {
+	case "IID", "CLSID":
+		return stdoleTypes[0]
+	}
+	if idlHandleTypes[name] {
+		e := this.known[name]
+		if e == nil {
+			e = &typeEntry{name: "wire" + name, kind: TKIND_ALIAS,
+				alias: vtDesc(VT_UINT_PTR)}
+			this.known[name] = e
+		}
+		return e
+	}
+	return this.knownInterface(name)
+}
+
+func (this *idlParser) placeholder(name string) *idlType {
+	it := &idlType{entry: &typeEntry{name: name}}
+	this.types[name] = it
+	this.byEntry[it.entry] = it
+	return it
+}
+
+// declare returns the type to be defined with the name
+func (this *idlParser) declare(name string, kind TYPEKIND) *idlType {
+	it := this.types[name]
+	if it == nil {
+		it = this.placeholder(name)
+	} else if it.defined {
+		this.fail("redefinition of " + name)
+	}
+	it.entry.kind = kind
+	return it
+}
+
+func (this *idlParser) define(it *idlType) {
+	it.defined = true
+	it.inLib = this.inLib
+	this.order = append(this.order, it)
+}
+
+func (this *idlParser) applyTypeAttrs(e *typeEntry, attrs idlAttrs) {
+	e.guid = this.attrGuid(attrs)
+	e.doc = this.attrStr(attrs, "helpstring")
+	if ctx, ok := this.attrInt(attrs, "helpcontext"); ok {
+		e.helpContext = uint32(ctx)
+	}
+	e.verMajor, e.verMinor = this.attrVersion(attrs)
+	e.custData = this.attrCustData(attrs)
+	for name, flag := range map[string]TYPEFLAGS{
+		"appobject":     TYPEFLAG_FAPPOBJECT,
+		"licensed":      TYPEFLAG_FLICENSED,
+		"predeclid":     TYPEFLAG_FPREDECLID,
+		"hidden":        TYPEFLAG_FHIDDEN,
+		"control":       TYPEFLAG_FCONTROL,
+		"dual":          TYPEFLAG_FDUAL | TYPEFLAG_FOLEAUTOMATION,
+		"nonextensible": TYPEFLAG_FNONEXTENSIBLE,
+		"oleautomation": TYPEFLAG_FOLEAUTOMATION,
+		"restricted":    TYPEFLAG_FRESTRICTED,
+		"aggregatable":  TYPEFLAG_FAGGREGATABLE,
+		"replaceable":   TYPEFLAG_FREPLACEABLE,
+		"proxy":         TYPEFLAG_FPROXY,
+		"reversebind":   TYPEFLAG_FREVERSEBIND,
+	} {
+		if attrs.has(name) {
+			e.flags |= flag
+		}
+	}
+}
+
+// parseType parses a type specifier, which may define a struct, union
+// or enum inline. The defined type is returned as well.
+func (this *idlParser) parseType(attrs idlAttrs) (*typeDesc, *idlType) {
+	this.skipQualifiers()
+	name := this.tok.text
+	if this.tok.kind != idlIdent {
+		this.fail("type expected, found '" + name + "'")
+	}
+	this.next()
+	var t *typeDesc
+	switch name {
+	case "struct", "union", "enum":
+		kind := map[string]TYPEKIND{"struct": TKIND_RECORD,
+			"union": TKIND_UNION, "enum": TKIND_ENUM}[name]
+		tag := ""
+		if this.tok.kind == idlIdent {
+			tag = this.ident()
+		}
+		if this.tok.is("{") {
+			it := this.parseAggregate(kind, tag, attrs)
+			t = refDesc(it.entry)
+			this.skipQualifiers()
+			return t, it
+		}
+		if tag == "" {
+			this.fail(name + " name expected")
+		}
+		t = refDesc(this.lookupType(tag))
+	case "SAFEARRAY", "LPSAFEARRAY":
+		if name == "LPSAFEARRAY" || this.header && this.tok.is("*") {
+			//the C declaration of SAFEARRAY(VARIANT)
+			this.skip("*")
+			t = &typeDesc{vt: VT_SAFEARRAY, elem: vtDesc(VT_VARIANT)}
+			break
+		}
+		this.expect("(")
+		elem, _ := this.parseType(nil)
+		elem = this.parsePointers(elem)
+		this.expect(")")
+		t = &typeDesc{vt: VT_SAFEARRAY, elem: elem}
+	case "unsigned", "signed":
+		vt := VT_INT
+		switch {
+		case this.tok.is("char"), this.tok.is("small"):
+			vt = VT_I1
+			this.next()
+		case this.tok.is("short"):
+			vt = VT_I2
+			this.next()
+			this.skip("int")
+		case this.tok.is("long"):
+			vt = VT_I4
+			this.next()
+			if this.tok.is("long") {
+				vt = VT_I8
+				this.next()
+			}
+			this.skip("int")
+		case this.tok.is("int"):
+			this.next()
+		case this.tok.is("hyper"), this.tok.is("__int64"):
+			vt = VT_I8
+			this.next()
+		}
+		if name == "unsigned" {
+			vt = map[VARENUM]VARENUM{VT_I1: VT_UI1, VT_I2: VT_UI2,
+				VT_I4: VT_UI4, VT_I8: VT_UI8, VT_INT: VT_UINT}[vt]
+		}
+		t = vtDesc(vt)
+	case "long":
+		t = vtDesc(VT_I4)
+		if this.tok.is("long") {
+			t = vtDesc(VT_I8)
+			this.next()
+		}
+		this.skip("int")
+	case "short":
+		t = vtDesc(VT_I2)
+		this.skip("int")
+	case "LPVOID", "PVOID", "LPCVOID":
+		t = ptrDesc(vtDesc(VT_VOID))
+	case "REFIID", "REFGUID", "REFCLSID":
+		t = ptrDesc(refDesc(stdoleTypes[0]))
+	default:
+		if vt, ok := idlBaseTypes[name]; ok {
+			t = vtDesc(vt)
+		} else {
+			t = refDesc(this.lookupType(name))
+		}
+	}
+	this.skipQualifiers()
+	return t, nil
+}
+
+func (this *idlParser) skipQualifiers() {
+	for this.tok.kind == idlIdent {
+		switch this.tok.text {
+		case "const", "volatile", "__RPC_FAR", "far", "__far", "near",
+			"__RPC_unique_pointer", "__RPC_string":
+			this.next()
+			continue
+		}
+		if !this.header || !isSalAnnotation(this.tok.text) {
+			return
+		}
+		this.next()
+		if this.tok.is("(") {
+			this.lex.raw()
+			this.next()
+		}
+	}
+}
+
+func (this *idlParser) parsePointers(t *typeDesc) *typeDesc {
+	for {
+		if this.tok.is("*") {
+			t = ptrDesc(t)
+			this.next()
+			this.skipQualifiers()
+			continue
+		}
+		return t
+	}
+}
+
+// parseDeclarator parses pointers, the name and array bounds
+func (this *idlParser) parseDeclarator(t *typeDesc) (string, *typeDesc) {
+	t = this.parsePointers(t)
+	name := ""
+	if this.tok.kind == idlIdent {
+		name = this.ident()
+	}
+	var dims []int
+	for this.tok.is("[") {
+		text := this.lex.rawExpr("]")
+		this.next()
+		this.expect("]")
+		if text == "" {
+			t = ptrDesc(t)
+			continue
+		}
+		dims = append(dims, int(this.toInt(this.evalText(text))))
+	}
+	if dims != nil {
+		t = &typeDesc{vt: VT_CARRAY, elem: t, dims: dims}
+	}
+	if this.tok.is(":") {
+		this.fail("bit fields are not supported")
+	}
+	return name, t
+}
+
+// stringType applies [string] to char and wchar_t pointers
+func (this *idlParser) stringType(t *typeDesc, attrs idlAttrs) *typeDesc {
+	if !attrs.has("string") || t.vt != VT_PTR {
+		return t
+	}
+	switch t.elem.vt {
+	case VT_I1, VT_UI1:
+		return vtDesc(VT_LPSTR)
+	case VT_UI2:
+		return vtDesc(VT_LPWSTR)
+	}
+	return t
+}
+
+func (this *idlParser) parseAggregate(kind TYPEKIND, tag string, attrs idlAttrs) *idlType {
+	if tag == "" {
+		this.anonCount++
+		tag = "__MIDL___MIDL_" + strconv.Itoa(this.anonCount)
+	}
+	it := this.declare(tag, kind)
+	e := it.entry
+	this.applyTypeAttrs(e, attrs)
+	this.expect("{")
+	if kind == TKIND_ENUM {
+		this.parseEnumBody(e)
+	} else {
+		this.parseFields(e)
+	}
+	this.expect("}")
+	this.define(it)
+	return it
+}
+
+func (this *idlParser) parseEnumBody(e *typeEntry) {
+	var value int64
+	for !this.tok.is("}") {
+		attrs := this.parseAttrs()
+		name := this.ident()
+		if this.tok.is("=") {
+			text := this.lex.rawExpr(",}")
+			this.next()
+			value = this.toInt(this.evalText(text))
+		}
+		this.consts[name] = value
+		e.vars = append(e.vars, &varEntry{
+			memid:    int32(0x40000000 + len(e.vars)),
+			name:     name,
+			doc:      this.attrStr(attrs, "helpstring"),
+			typ:      vtDesc(VT_I4),
+			varKind:  VAR_CONST,
+			value:    int32(value),
+			custData: this.attrCustData(attrs),
+		})
+		value++
+		if !this.tok.is(",") {
+			break
+		}
+		this.next()
+	}
+}
+
+func (this *idlParser) parseFields(e *typeEntry) {
+	for !this.tok.is("}") {
+		attrs := this.parseAttrs()
+		t, _ := this.parseType(nil)
+		for {
+			name, ft := this.parseDeclarator(t)
+			if name == "" {
+				name = "__unnamed" + strconv.Itoa(len(e.vars))
+			}
+			e.vars = append(e.vars, &varEntry{
+				memid:    int32(0x40000000 + len(e.vars)),
+				name:     name,
+				doc:      this.attrStr(attrs, "helpstring"),
+				typ:      this.stringType(ft, attrs),
+				varKind:  VAR_PERINSTANCE,
+				custData: this.attrCustData(attrs),
+			})
+			if !this.tok.is(",") {
+				break
+			}
+			this.next()
+		}
+		this.expect(";")
+	}
+}
+
+func (this *idlParser) parseTypedef(attrs idlAttrs) {
+	this.next()
+	for name, value := range this.parseAttrs() {
+		attrs[name] = value
+	}
+	t, defined := this.parseType(attrs)
+	for {
+		name, at := this.parseDeclarator(t)
+		if name == "" {
+			this.fail("typedef name expected")
+		}
+		old := this.types[name]
+		switch {
+		case at.vt == VT_USERDEFINED && at.ref.name == name:
+			//typedef struct X X;
+		case defined != nil && at == t && (old == nil || !old.defined):
+			//typedef struct tagX {..} X; the type is named by the typedef
+			if strings.HasPrefix(defined.entry.name, "__MIDL_") {
+				delete(this.types, defined.entry.name)
+			}
+			if old != nil {
+				//referred to before
+				*old.entry = *defined.entry
+				old.entry.name = name
+			}
+			defined.entry.name = name
+			this.types[name] = defined
+		case defined != nil && at == t && old.entry.kind == TKIND_ALIAS &&
+			old.entry.alias.ref == defined.entry:
+			//typedef struct tagX X; given before
+		default:
+			it := this.declare(name, TKIND_ALIAS)
+			it.entry.alias = at
+			if defined == nil {
+				this.applyTypeAttrs(it.entry, attrs)
+			}
+			this.define(it)
+		}
+		if !this.tok.is(",") {
+			break
+		}
+		this.next()
+	}
+	this.expect(";")
+}
+
+func (this *idlParser) parseConst() *varEntry {
+	this.next()
+	t, _ := this.parseType(nil)
+	name, t := this.parseDeclarator(t)
+	if !this.tok.is("=") {
+		this.fail("expected '='")
+	}
+	value := this.evalText(this.lex.rawExpr(";"))
+	this.next()
+	this.expect(";")
+	this.consts[name] = value
+	return &varEntry{
+		name:    name,
+		typ:     t,
+		varKind: VAR_CONST,
+		value:   value,
+	}
+}
+
+func (this *idlParser) parseInterface(attrs idlAttrs) {
+	this.next()
+	name := this.ident()
+	if this.tok.is(";") {
+		this.next()
+		if this.types[name] == nil && this.builtinType(name) == nil {
+			this.declare(name, TKIND_INTERFACE)
+		}
+		return
+	}
+	it := this.declare(name, TKIND_INTERFACE)
+	e := it.entry
+	this.applyTypeAttrs(e, attrs)
+	if e.isDual() {
+		e.kind = TKIND_DISPATCH
+	}
+	if this.tok.is(":") {
+		this.next()
+		e.impls = []*implEntry{{ref: this.lookupType(this.ident())}}
+	}
+	this.expect("{")
+	for !this.tok.is("}") {
+		attrs := this.parseAttrs()
+		if this.isDeclKeyword() {
+			this.parseItem(attrs)
+			continue
+		}
+		e.funcs = append(e.funcs, this.parseFunc(attrs, FUNC_PUREVIRTUAL))
+	}
+	this.next()
+	this.skip(";")
+	this.define(it)
+}
+
+func (this *idlParser) isDeclKeyword() bool {
+	switch this.tok.text {
+	case "typedef", "const", "struct", "union", "enum", "import", "cpp_quote", "midl_pragma", ";":
+		return true
+	}
+	return false
+}
+
+func (this *idlParser) parseFunc(attrs idlAttrs, kind FUNCKIND) *funcEntry {
+	ret, _ := this.parseType(nil)
+	ret = this.parsePointers(ret)
+	f := newIdlFunc(kind, ret)
+	this.parseCallConv(f)
+	f.name = this.ident()
+	this.expect("(")
+	f.params = this.parseParams()
+	this.expect(")")
+	if this.header {
+		//virtual .. = 0;
+		this.skip("const")
+		if this.tok.is("=") {
+			this.next()
+			this.next()
+		}
+	}
+	this.expect(";")
+	this.applyFuncAttrs(f, attrs)
+	return f
+}
+
+func newIdlFunc(kind FUNCKIND, ret *typeDesc) *funcEntry {
+	return &funcEntry{
+		memid:    MEMBERID_NIL,
+		funcKind: kind,
+		invKind:  INVOKE_FUNC,
+		callConv: CC_STDCALL,
+		ret:      ret,
+	}
+}
+
+func (this *idlParser) parseCallConv(f *funcEntry) {
+	for this.tok.kind == idlIdent {
+		callConv, ok := idlCallConvs[strings.TrimLeft(this.tok.text, "_")]
+		if !ok {
+			break
+		}
+		f.callConv = callConv
+		this.next()
+	}
+}
+
+func (this *idlParser) applyFuncAttrs(f *funcEntry, attrs idlAttrs) {
+	if id, ok := this.attrInt(attrs, "id"); ok {
+		f.memid = int32(id)
+	}
+	switch {
+	case attrs.has("propget"):
+		f.invKind = INVOKE_PROPERTYGET
+	case attrs.has("propput"):
+		f.invKind = INVOKE_PROPERTYPUT
+	case attrs.has("propputref"):
+		f.invKind = INVOKE_PROPERTYPUTREF
+	}
+	if this.header {
+		f.name = strings.TrimPrefix(f.name, headerAccessorPrefixes[f.invKind])
+	}
+	f.doc = this.attrStr(attrs, "helpstring")
+	f.custData = this.attrCustData(attrs)
+	for name, flag := range map[string]FUNCFLAGS{
+		"restricted":       FUNCFLAG_FRESTRICTED,
+		"source":           FUNCFLAG_FSOURCE,
+		"bindable":         FUNCFLAG_FBINDABLE,
+		"requestedit":      FUNCFLAG_FREQUESTEDIT,
+		"displaybind":      FUNCFLAG_FDISPLAYBIND,
+		"defaultbind":      FUNCFLAG_FDEFAULTBIND,
+		"hidden":           FUNCFLAG_FHIDDEN,
+		"usesgetlasterror": FUNCFLAG_FUSESGETLASTERROR,
+		"defaultcollelem":  FUNCFLAG_FDEFAULTCOLLELEM,
+		"uidefault":        FUNCFLAG_FUIDEFAULT,
+		"nonbrowsable":     FUNCFLAG_FNONBROWSABLE,
+		"replaceable":      FUNCFLAG_FREPLACEABLE,
+		"immediatebind":    FUNCFLAG_FIMMEDIATEBIND,
+	} {
+		if attrs.has(name) {
+			f.flags |= flag
+		}
+	}
+	if attrs.has("vararg") {
+		f.cParamsOpt = -1
+	} else {
+		for _, p := range f.params {
+			if p.flags&PARAMFLAG_FOPT != 0 && p.flags&PARAMFLAG_FHASDEFAULT == 0 {
+				f.cParamsOpt++
+			}
+		}
+	}
+	if text, ok := attrs["entry"]; ok {
+		switch v := this.evalText(text).(type) {
+		case string:
+			f.dllEntry = v
+		default:
+			f.ordinal = int(this.toInt(v))
+		}
+	}
+}
+
+func (this *idlParser) parseParams() []*paramEntry {
+	var params []*paramEntry
+	for !this.tok.is(")") {
+		attrs := this.parseAttrs()
+		t, _ := this.parseType(nil)
+		if len(params) == 0 && t.vt == VT_VOID && this.tok.is(")") {
+			break
+		}
+		name, t := this.parseDeclarator(t)
+		p := &paramEntry{name: name, typ: this.stringType(t, attrs),
+			custData: this.attrCustData(attrs)}
+		for name, flag := range map[string]PARAMFLAGS{
+			"in":       PARAMFLAG_FIN,
+			"out":      PARAMFLAG_FOUT,
+			"lcid":     PARAMFLAG_FLCID,
+			"retval":   PARAMFLAG_FRETVAL,
+			"optional": PARAMFLAG_FOPT,
+		} {
+			if attrs.has(name) {
+				p.flags |= flag
+			}
+		}
+		if p.flags&(PARAMFLAG_FIN|PARAMFLAG_FOUT) == 0 {
+			p.flags |= PARAMFLAG_FIN
+		}
+		if text, ok := attrs["defaultvalue"]; ok {
+			p.flags |= PARAMFLAG_FOPT | PARAMFLAG_FHASDEFAULT
+			p.value = constValue(this.evalText(text), t)
+		}
+		params = append(params, p)
+		if !this.tok.is(",") {
+			break
+		}
+		this.next()
+	}
+	return params
+}
+
+// constValue gives a value the Go type the binary readers give
+// a constant of the type.
+func constValue(v interface{}, t *typeDesc) interface{} {
+	for t.vt == VT_USERDEFINED && t.ref != nil && t.ref.kind == TKIND_ALIAS {
+		t = t.ref.alias
+	}
+	vt := t.vt
+	if vt == VT_USERDEFINED && t.ref != nil && t.ref.kind == TKIND_ENUM {
+		vt = VT_I4
+	}
+	switch v := v.(type) {
+	case int64:
+		switch vt {
+		case VT_R4:
+			return float32(v)
+		case VT_R8, VT_DATE:
+			return float64(v)
+		case VT_I1, VT_UI1, VT_I2, VT_UI2, VT_I4, VT_UI4, VT_INT, VT_UINT,
+			VT_I8, VT_UI8, VT_BOOL, VT_ERROR, VT_HRESULT:
+			return convertValue(vt, uint64(v))
+		}
+		if v == int64(int32(v)) {
+			return int32(v)
+		}
+	case float64:
+		if vt == VT_R4 {
+			return float32(v)
+		}
+	}
+	return v
+}
+
+func (this *idlParser) parseDispInterface(attrs idlAttrs) {
+	this.next()
+	name := this.ident()
+	if this.tok.is(";") {
+		this.next()
+		if this.types[name] == nil && this.builtinType(name) == nil {
+			this.declare(name, TKIND_DISPATCH)
+		}
+		return
+	}
+	it := this.declare(name, TKIND_DISPATCH)
+	e := it.entry
+	this.applyTypeAttrs(e, attrs)
+	e.flags |= TYPEFLAG_FDISPATCHABLE
+	e.impls = []*implEntry{{ref: stdoleDispatch()}}
+	this.expect("{")
+	if this.tok.is("interface") {
+		this.next()
+		it.dispOf = this.lookupType(this.ident())
+		this.expect(";")
+	} else {
+		if this.tok.is("properties") {
+			this.next()
+			this.expect(":")
+			for !this.tok.is("methods") && !this.tok.is("}") {
+				e.vars = append(e.vars, this.parseDispProperty())
+			}
+		}
+		if this.tok.is("methods") {
+			this.next()
+			this.expect(":")
+			for !this.tok.is("}") {
+				e.funcs = append(e.funcs, this.parseFunc(this.parseAttrs(), FUNC_DISPATCH))
+			}
+		}
+	}
+	this.expect("}")
+	this.skip(";")
+	this.define(it)
+}
+
+func (this *idlParser) parseDispProperty() *varEntry {
+	attrs := this.parseAttrs()
+	t, _ := this.parseType(nil)
+	name, t := this.parseDeclarator(t)
+	this.expect(";")
+	v := &varEntry{
+		memid:    MEMBERID_NIL,
+		name:     name,
+		doc:      this.attrStr(attrs, "helpstring"),
+		typ:      this.stringType(t, attrs),
+		varKind:  VAR_DISPATCH,
+		custData: this.attrCustData(attrs),
+	}
+	if id, ok := this.attrInt(attrs, "id"); ok {
+		v.memid = int32(id)
+	}
+	for name, flag := range map[string]VARFLAGS{
+		"readonly":        VARFLAG_FREADONLY,
+		"source":          VARFLAG_FSOURCE,
+		"bindable":        VARFLAG_FBINDABLE,
+		"requestedit":     VARFLAG_FREQUESTEDIT,
+		"displaybind":     VARFLAG_FDISPLAYBIND,
+		"defaultbind":     VARFLAG_FDEFAULTBIND,
+		"hidden":          VARFLAG_FHIDDEN,
+		"restricted":      VARFLAG_FRESTRICTED,
+		"defaultcollelem": VARFLAG_FDEFAULTCOLLELEM,
+		"uidefault":       VARFLAG_FUIDEFAULT,
+		"nonbrowsable":    VARFLAG_FNONBROWSABLE,
+		"replaceable":     VARFLAG_FREPLACEABLE,
+		"immediatebind":   VARFLAG_FIMMEDIATEBIND,
+	} {
+		if attrs.has(name) {
+			v.flags |= flag
+		}
+	}
+	return v
+}
+
+func (this *idlParser) parseCoClass(attrs idlAttrs) {
+	this.next()
+	it := this.declare(this.ident(), TKIND_COCLASS)
+	e := it.entry
+	this.applyTypeAttrs(e, attrs)
+	if !attrs.has("noncreatable") {
+		e.flags |= TYPEFLAG_FCANCREATE
+	}
+	this.expect("{")
+	for !this.tok.is("}") {
+		implAttrs := this.parseAttrs()
+		if !this.tok.is("interface") && !this.tok.is("dispinterface") {
+			this.fail("interface expected, found '" + this.tok.text + "'")
+		}
+		this.next()
+		impl := &implEntry{ref: this.lookupType(this.ident()),
+			custData: this.attrCustData(implAttrs)}
+		for name, flag := range map[string]IMPLTYPEFLAGS{
+			"default":       IMPLTYPEFLAG_FDEFAULT,
+			"source":        IMPLTYPEFLAG_FSOURCE,
+			"restricted":    IMPLTYPEFLAG_FRESTRICTED,
+			"defaultvtable": IMPLTYPEFLAG_FDEFAULTVTABLE,
+		} {
+			if implAttrs.has(name) {
+				impl.flags |= flag
+			}
+		}
+		e.impls = append(e.impls, impl)
+		this.expect(";")
+	}
+	this.next()
+	this.skip(";")
+	this.define(it)
+}
+
+func (this *idlParser) parseModule(attrs idlAttrs) {
+	this.next()
+	it := this.declare(this.ident(), TKIND_MODULE)
+	e := it.entry
+	this.applyTypeAttrs(e, attrs)
+	e.dllName = this.attrStr(attrs, "dllname")
+	this.expect("{")
+	for !this.tok.is("}") {
+		memberAttrs := this.parseAttrs()
+		if this.tok.is("const") {
+			v := this.parseConst()
+			v.memid = int32(0x40000000 + len(e.vars))
+			v.doc = this.attrStr(memberAttrs, "helpstring")
+			v.custData = this.attrCustData(memberAttrs)
+			e.vars = append(e.vars, v)
+			continue
+		}
+		f := this.parseFunc(memberAttrs, FUNC_STATIC)
+		if f.dllEntry == "" && f.ordinal == 0 {
+			f.dllEntry = f.name
+		}
+		e.funcs = append(e.funcs, f)
+	}
+	this.next()
+	this.skip(";")
+	this.define(it)
+}
+
+// finish resolves the types referred to but not defined and lays out
+// the defined ones.
+func (this *idlParser) finish() ([]*typeEntry, error) {
+	if !this.hasLib {
+		return nil, errors.New("no library block found")
+	}
+	if this.header {
+		this.finishHeader()
+	}
+
+	var missing []string
+	for name, it := range this.types {
+		if it.defined || it.entry.name != name {
+			continue
+		}
+		e := this.importedType(name)
+		if e == nil {
+			e = this.builtinType(name)
+		}
+		if e != nil {
+			*it.entry = *e
+		} else if this.missingLibs != nil {
+			//from a typelib that could not be read
+			*it.entry = typeEntry{impLib: &ImpLib{Name: strings.Join(this.missingLibs, ", ")}}
+		} else {
+			missing = append(missing, name)
+		}
+	}
+	if missing != nil {
+		sort.Strings(missing)
+		return nil, errors.New("undefined types: " + strings.Join(missing, ", "))
+	}
+
+	//nested anonymous types are named after the field
+	for n := len(this.order) - 1; n >= 0; n-- {
+		e := this.order[n].entry
+		for _, v := range e.vars {
+			if v.typ.vt == VT_USERDEFINED && strings.HasPrefix(v.typ.ref.name, "__MIDL_") {
+				v.typ.ref.name = e.name + "_" + v.name
+			}
+		}
+	}
+
+	ptrSize := 4
+	if this.attr.SysKind == SYS_WIN64 {
+		ptrSize = 8
+	}
+	for _, it := range this.order {
+		e := it.entry
+		if (e.kind == TKIND_INTERFACE || e.kind == TKIND_DISPATCH && e.isDual()) && isDispatchable(e) {
+			e.flags |= TYPEFLAG_FDISPATCHABLE
+		}
+		layoutEntry(e, ptrSize)
+		if e.kind == TKIND_MODULE {
+			for _, v := range e.vars {
+				v.value = idlValue(v.typ, v.value)
+			}
+		}
+		for _, f := range e.funcs {
+			for _, p := range f.params {
+				if p.value != nil {
+					p.value = idlValue(p.typ, p.value)
+				}
+			}
+		}
+	}
+	if this.header {
+		this.applyVtblSlots(ptrSize)
+	}
+	for _, it := range this.order {
+		if it.dispOf != nil {
+			it.entry.funcs = collectDispFuncs(it.dispOf)
+		}
+	}
+	return this.libEntries(), nil
+}
+
+func (this *idlParser) importedType(name string) *typeEntry {
+	for _, lib := range this.imports {
+		for _, e := range lib.entries {
+			if e.name == name {
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+func isDispatchable(e *typeEntry) bool {
+	for base := baseOf(e); base != nil; base = baseOf(base) {
+		if base.guid == stdoleDispatch().guid {
+			return true
+		}
+	}
+	return false
+}
+
+// idlValue converts a constant to the Go type the binary readers use
+// for a value of the given type.
+func idlValue(t *typeDesc, v interface{}) interface{} {
+	vt := t.vt
+	for vt == VT_USERDEFINED && t.ref != nil {
+		if t.ref.kind == TKIND_ALIAS {
+			t = t.ref.alias
+			vt = t.vt
+		} else if t.ref.kind == TKIND_ENUM {
+			vt = VT_I4
+		} else {
+			break
+		}
+	}
+	switch v := v.(type) {
+	case float64:
+		if vt == VT_R4 {
+			return float32(v)
+		}
+		return v
+	case int64:
+		switch vt {
+		case VT_R4:
+			return float32(v)
+		case VT_R8, VT_DATE:
+			return float64(v)
+		case VT_BOOL:
+			return v != 0
+		case VT_I1, VT_UI1, VT_I2, VT_UI2, VT_I4, VT_INT, VT_ERROR, VT_HRESULT,
+			VT_UI4, VT_UINT, VT_I8, VT_CY, VT_UI8:
+			return convertValue(vt, uint64(v))
+		}
+		if v == int64(int32(v)) {
+			return int32(v)
+		}
+		return v
+	}
+	return v
+}
+
+// libEntries returns the types of the library block and the types
+// declared outside of it that they refer to, in declaration order.
+func (this *idlParser) libEntries() []*typeEntry {
+	used := make(map[*typeEntry]bool)
+	var visitEntry func(e *typeEntry)
+	var visitDesc func(d *typeDesc)
+	visitDesc = func(d *typeDesc) {
+		for ; d != nil; d = d.elem {
+			if d.ref != nil {
+				visitEntry(d.ref)
+			}
+		}
+	}
+	visitEntry = func(e *typeEntry) {
+		if used[e] {
+			return
+		}
+		used[e] = true
+		if it := this.byEntry[e]; it != nil && it.dispOf != nil {
+			visitEntry(it.dispOf)
+		}
+		visitDesc(e.alias)
+		for _, f := range e.funcs {
+			visitDesc(f.ret)
+			for _, p := range f.params {
+				visitDesc(p.typ)
+			}
+		}
+		for _, v := range e.vars {
+			visitDesc(v.typ)
+		}
+		for _, impl := range e.impls {
+			visitEntry(impl.ref)
+		}
+	}
+	for _, it := range this.order {
+		if it.inLib {
+			visitEntry(it.entry)
+		}
+	}
+	var entries []*typeEntry
+	for _, it := range this.order {
+		if used[it.entry] {
+			entries = append(entries, it.entry)
+		}
+	}
+	return entries
+}
@@ -0,0 +1,365 @@
+package typelib
+
+import (
+	"fmt"
+	"github.com/zzl/go-tlbimp/utils"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testIdl = `// Painter sample
+import "oaidl.idl";
+
+#define DISPID_COLOR 1
+#define MAX_POINTS (4 * 2)
+
+#ifdef NOT_DEFINED
+this is garbage
+#else
+cpp_quote("// comment for headers")
+#endif
+
+typedef [uuid(20000005-0000-0000-0000-000000000000), helpstring("line styles")]
+enum tagLineStyle {
+	lsSolid = 0,
+	[helpstring("dashes")] lsDash,
+	lsDot = 0x10,
+	lsAll = lsSolid | lsDash | lsDot,
+	lsNeg = -1,
+} LineStyle;
+
+typedef struct tagPOINTF {
+	float x;
+	float y;
+} POINTF;
+
+typedef struct {
+	POINTF pts[MAX_POINTS];
+	long count;
+	[string] wchar_t* label;
+	union {
+		long l;
+		double d;
+	} u;
+} Shape;
+
+interface ICanvas;
+
+[object, uuid(20000001-0000-0000-0000-000000000000), dual, helpstring("painter")]
+interface IPainter : IDispatch
+{
+	[propget, id(DISPID_COLOR)] HRESULT Color([out, retval] OLE_COLOR* pVal);
+	[propput, id(DISPID_COLOR)] HRESULT Color([in] OLE_COLOR newVal);
+	[id(2)] HRESULT Paint([in] long times, [in, optional] VARIANT label,
+		[in, defaultvalue(lsDash)] LineStyle style, [out, retval] BSTR* result);
+	[id(3)] HRESULT Move([in] POINTF pt, [in, defaultvalue(1.5)] double scale,
+		[in, defaultvalue("hi")] BSTR text, [in, defaultvalue(-1)] VARIANT_BOOL flag);
+	HRESULT GetCanvas([out, retval] ICanvas** ppCanvas);
+	HRESULT Raw([in] unsigned long a, [in] unsigned char b, [in] hyper c,
+		[in, string] char* s, [in] REFIID riid, [in] IUnknown* punk);
+};
+
+[object, uuid(20000002-0000-0000-0000-000000000000), oleautomation]
+interface ICanvas : IUnknown
+{
+	HRESULT Clear(void);
+	HRESULT Size([out] long* w, [out] long* h);
+	HRESULT Draw([in] Shape* shape);
+};
+
+[uuid(20000000-0000-0000-0000-000000000000), version(1.2), lcid(0x409),
+	helpstring("Painter Type Library")]
+library PainterLib
+{
+	importlib("stdole2.tlb");
+
+	[uuid(20000003-0000-0000-0000-000000000000)]
+	dispinterface DPainterEvents
+	{
+		properties:
+			[id(1), readonly] long Count;
+		methods:
+			[id(1)] void OnPaint([in] long times);
+			[id(2)] void OnDone();
+	};
+
+	[uuid(20000004-0000-0000-0000-000000000000), helpstring("Painter class")]
+	coclass Painter
+	{
+		[default] interface IPainter;
+		[default, source] dispinterface DPainterEvents;
+		interface ICanvas;
+	};
+
+	[dllname("painter.dll"), uuid(20000007-0000-0000-0000-000000000000)]
+	module PainterApi
+	{
+		const long MaxShapes = 100;
+		const LPSTR Title = "painter";
+		[entry("PaintAll")] HRESULT __stdcall PaintAll([in] long n);
+		[entry(5)] long __cdecl Count();
+	};
+
+	enum Quality { qLow, qHigh };
+};
+`
+
+func readTestIdl(t *testing.T) *TypeLib {
+	lib, err := NewTypeLibFromIdl(testIdl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lib
+}
+
+// formatFuncs formats the funcs of a type as "Name Ret(param types)",
+// leaving out those dispatch views inherit from IUnknown and IDispatch
+func formatFuncs(ti *TypeInfo) []string {
+	var funcs []string
+	for _, f := range ti.Funcs {
+		if ti.Kind == TKIND_DISPATCH && f.Id >= 0x60000000 && f.Id < 0x60020000 {
+			continue
+		}
+		var params []string
+		for _, p := range f.Params {
+			params = append(params, p.Type.Name)
+		}
+		funcs = append(funcs, f.Name+" "+f.ReturnType.Name+"("+strings.Join(params, ",")+")")
+	}
+	return funcs
+}
+
+func TestIdlLibAttr(t *testing.T) {
+	lib := readTestIdl(t)
+	if lib.GetName() != "PainterLib" || lib.GetDoc() != "Painter Type Library" {
+		t.Errorf("name %q, doc %q", lib.GetName(), lib.GetDoc())
+	}
+	want := LibAttr{Guid: mustParseGuid("20000000-0000-0000-0000-000000000000"),
+		Lcid: 0x409, SysKind: SYS_WIN32, MajorVer: 1, MinorVer: 2}
+	if utils.PtrSize == 8 {
+		want.SysKind = SYS_WIN64
+	}
+	if attr := *lib.GetLibAttr(); attr != want {
+		t.Errorf("attr %+v, want %+v", attr, want)
+	}
+}
+
+func TestIdlTypes(t *testing.T) {
+	lib := readTestIdl(t)
+	tests := []struct {
+		name   string
+		kind   TYPEKIND
+		fields []string //name type
+		funcs  []string
+	}{
+		{"LineStyle", TKIND_ENUM, []string{"lsSolid int32", "lsDash int32",
+			"lsDot int32", "lsAll int32", "lsNeg int32"}, nil},
+		{"POINTF", TKIND_RECORD, []string{"x float32", "y float32"}, nil},
+		{"Shape_u", TKIND_UNION, []string{"l int32", "d float64"}, nil},
+		{"Shape", TKIND_RECORD, []string{"pts [8]POINTF", "count int32",
+			"label win32.PWSTR", "u Shape_u"}, nil},
+		{"IPainter", TKIND_DISPATCH, nil, []string{"Color uint32()", "Color (uint32)",
+			"Paint win32.BSTR(int32,win32.VARIANT,LineStyle)",
+			"Move (POINTF,float64,win32.BSTR,win32.VARIANT_BOOL)",
+			"GetCanvas *ICanvas()",
+			"Raw (uint32,byte,int64,win32.PSTR,*syscall.GUID,*IUnknown)"}},
+		{"ICanvas", TKIND_INTERFACE, nil, []string{"Clear win32.HRESULT()",
+			"Size win32.HRESULT(*int32,*int32)", "Draw win32.HRESULT(*Shape)"}},
+		{"DPainterEvents", TKIND_DISPATCH, []string{"Count int32"},
+			[]string{"OnPaint (int32)", "OnDone ()"}},
+		{"Painter", TKIND_COCLASS, nil, nil},
+		{"PainterApi", TKIND_MODULE, []string{"MaxShapes int32", "Title win32.PSTR"},
+			[]string{"PaintAll win32.HRESULT(int32)", "Count int32()"}},
+		{"Quality", TKIND_ENUM, []string{"qLow int32", "qHigh int32"}, nil},
+	}
+	if lib.GetTypeInfoCount() != len(tests) {
+		t.Errorf("%d types, want %d", lib.GetTypeInfoCount(), len(tests))
+	}
+	for n, test := range tests {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if ti.Name != test.name || ti.Kind != test.kind {
+			t.Errorf("type %d: %s %v, want %s %v", n, ti.Name, ti.Kind, test.name, test.kind)
+		}
+		var fields []string
+		for _, f := range ti.Fields {
+			fields = append(fields, f.Name+" "+f.Type.Name)
+		}
+		if !reflect.DeepEqual(fields, test.fields) {
+			t.Errorf("%s fields %q, want %q", test.name, fields, test.fields)
+		}
+		if funcs := formatFuncs(ti); !reflect.DeepEqual(funcs, test.funcs) {
+			t.Errorf("%s funcs %q, want %q", test.name, funcs, test.funcs)
+		}
+	}
+}
+
+func TestIdlMembers(t *testing.T) {
+	lib := readTestIdl(t)
+
+	style, _ := lib.GetTypeInfo(0)
+	var values []string
+	for _, f := range style.Fields {
+		values = append(values, fmt.Sprint(f.Value))
+	}
+	if s := strings.Join(values, " "); s != "0 1 16 17 -1" {
+		t.Errorf("LineStyle values %s", s)
+	}
+	if style.Doc != "line styles" || style.Fields[1].Doc != "dashes" {
+		t.Errorf("LineStyle docs %q, %q", style.Doc, style.Fields[1].Doc)
+	}
+
+	painter, _ := lib.GetTypeInfo(4)
+	if painter.DualInterface == nil || painter.DualInterface.Kind != TKIND_INTERFACE ||
+		painter.Super == nil || painter.Super.Name != "IDispatch" {
+		t.Fatalf("IPainter dual %v, super %v", painter.DualInterface, painter.Super)
+	}
+	if f := painter.Funcs[7]; f.Id != 1 || !f.Flags.PropGet || f.VtblOffset != 7*utils.PtrSize {
+		t.Errorf("Color id %d, flags %+v, vtbl offset %d", f.Id, f.Flags, f.VtblOffset)
+	}
+	paint := painter.Funcs[9]
+	if paint.ParamsOpt != 1 || !paint.Params[1].Flags.Optional ||
+		!paint.Params[2].Flags.HasDefault || paint.Params[2].DefaultValue != int32(1) {
+		t.Errorf("Paint params opt %d, %+v", paint.ParamsOpt, paint.Params)
+	}
+	var defaults []interface{}
+	for _, p := range painter.Funcs[10].Params[1:] {
+		defaults = append(defaults, p.DefaultValue)
+	}
+	if want := []interface{}{1.5, "hi", true}; !reflect.DeepEqual(defaults, want) {
+		t.Errorf("Move defaults %v, want %v", defaults, want)
+	}
+
+	class, _ := lib.GetTypeInfo(7)
+	var impls []string
+	for _, it := range class.ImplTypes {
+		impls = append(impls, fmt.Sprint(it.Name, " ", it.Default, " ", it.Source))
+	}
+	if want := []string{"IPainter true false", "DPainterEvents true true",
+		"ICanvas false false"}; !reflect.DeepEqual(impls, want) {
+		t.Errorf("Painter impl types %q", impls)
+	}
+
+	mod, _ := lib.GetTypeInfo(8)
+	if mod.DllName != "painter.dll" || mod.Fields[0].Value != int32(100) ||
+		mod.Fields[1].Value != "painter" {
+		t.Errorf("PainterApi dll %s, consts %v %v", mod.DllName,
+			mod.Fields[0].Value, mod.Fields[1].Value)
+	}
+	paintAll, count := mod.Funcs[0], mod.Funcs[1]
+	if paintAll.DllEntry != "PaintAll" || paintAll.CallConv != CC_STDCALL ||
+		count.Ordinal != 5 || count.CallConv != CC_CDECL || paintAll.Kind != FUNC_STATIC {
+		t.Errorf("PainterApi funcs %+v, %+v", paintAll, count)
+	}
+}
+
+func TestIdlVtblSlots(t *testing.T) {
+	lib, err := NewTypeLibFromIdl(`
+[uuid(20000010-0000-0000-0000-000000000000)]
+library SlotLib
+{
+	importlib("stdole2.tlb");
+	importlib("nothere.tlb");
+	[object, uuid(20000011-0000-0000-0000-000000000000)]
+	interface IBase : IUnknown { HRESULT A(); HRESULT B(); };
+	[object, uuid(20000012-0000-0000-0000-000000000000)]
+	interface IDerived : IBase { HRESULT C(); };
+	[object, uuid(20000013-0000-0000-0000-000000000000)]
+	interface IDisp : IDispatch { HRESULT D(); };
+	[object, uuid(20000014-0000-0000-0000-000000000000)]
+	interface IGoneChild : IGone { HRESULT E(); };
+	[object, uuid(20000015-0000-0000-0000-000000000000)]
+	interface IUseStream : IStream { HRESULT F(); };
+	[object, uuid(20000016-0000-0000-0000-000000000000)]
+	interface IUsePersist : IPersist { HRESULT G(); };
+	[object, uuid(20000017-0000-0000-0000-000000000000)]
+	interface IUsePersistStream : IPersistStream { HRESULT H(); };
+	[object, uuid(20000018-0000-0000-0000-000000000000)]
+	interface IUseWindow : IOleWindow { HRESULT I(); };
+};`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name  string
+		slots []int
+		err   string
+	}{
+		{"IBase", []int{3, 4}, ""},
+		{"IDerived", []int{5}, ""},
+		{"IDisp", []int{7}, ""},
+		{"IGoneChild", nil, "IGoneChild.E: unknown vtable slot"},
+		//after the methods of the known bases
+		{"IUseStream", []int{14}, ""},
+		{"IUsePersist", []int{4}, ""},
+		{"IUsePersistStream", []int{8}, ""},
+		{"IUseWindow", []int{5}, ""},
+	}
+	for n, test := range tests {
+		ti, _ := lib.GetTypeInfo(n)
+		var slots []int
+		for _, f := range ti.Funcs {
+			slots = append(slots, f.VtblOffset/utils.PtrSize)
+		}
+		if ti.Name != test.name || !reflect.DeepEqual(slots, test.slots) {
+			t.Errorf("%s slots %v, want %s %v", ti.Name, slots, test.name, test.slots)
+		}
+		var errs []string
+		for _, err := range ti.Errors {
+			errs = append(errs, err.Error())
+		}
+		if s := strings.Join(errs, "\n"); !strings.HasPrefix(s, test.err) || (s == "") != (test.err == "") {
+			t.Errorf("%s errors %q, want %q", ti.Name, s, test.err)
+		}
+	}
+}
+
+func TestIdlSystemImport(t *testing.T) {
+	//ocidl.idl is not at hand, IOleInPlaceSite is taken as of a library
+	//that was not read
+	lib, err := NewTypeLibFromIdl(`import "ocidl.idl";
+[uuid(20000020-0000-0000-0000-000000000000)]
+library SiteLib
+{
+	importlib("stdole2.tlb");
+	[object, uuid(20000021-0000-0000-0000-000000000000)]
+	interface ISite : IOleInPlaceSite { HRESULT F(); };
+};`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ti, err := lib.GetTypeInfo(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ti.Super == nil || ti.Super.ImpLib == nil || ti.Super.ImpLib.Name != "ocidl.idl" ||
+		len(ti.Funcs) != 0 || len(ti.Errors) != 1 {
+		t.Errorf("ISite super %+v, funcs %d, errors %v", ti.Super, len(ti.Funcs), ti.Errors)
+	}
+}
+
+func TestIdlErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		err  string
+	}{
+		{"guid", `[uuid(1-2)] library L { };`, "uuid"},
+		{"unknown type", `library L { interface IFoo : IUnknown { HRESULT F([in] Missing* m); }; };`, "Missing"},
+		{"syntax", `library L { interface IFoo : IUnknown { HRESULT F([in] long m) }; };`, ""},
+		{"no library", `interface IFoo : IUnknown { HRESULT F(); };`, ""},
+		{"unterminated", `library L { enum E { a, b `, ""},
+	}
+	for _, test := range tests {
+		_, err := NewTypeLibFromIdl(test.src)
+		if err == nil {
+			t.Errorf("%s: no error", test.name)
+		} else if !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%s: %v, want %q", test.name, err, test.err)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package typelib
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Constant expressions of IDL sources (enum values, id(), defaultvalue()..).
+// The result is an int64, a float64 or a string.
+
+type idlExprEval struct {
+	lex    *idlLexer
+	tok    idlToken
+	lookup func(name string) (interface{}, bool)
+	depth  int
+}
+
+func evalIdlExpr(lex *idlLexer, lookup func(string) (interface{}, bool)) interface{} {
+	e := &idlExprEval{lex: lex, lookup: lookup}
+	return e.eval()
+}
+
+func (this *idlExprEval) eval() interface{} {
+	this.tok = this.lex.next()
+	v := this.binary(0)
+	if this.tok.kind != idlEOF {
+		this.lex.fail("unexpected '" + this.tok.text + "' in expression")
+	}
+	return v
+}
+
+var idlBinaryOps = [][]string{
+	{"||"}, {"&&"}, {"|"}, {"^"}, {"&"}, {"==", "!="},
+	{"<", ">", "<=", ">="}, {"<<", ">>"}, {"+", "-"}, {"*", "/", "%"},
+}
+
+func (this *idlExprEval) binary(level int) interface{} {
+	if level == len(idlBinaryOps) {
+		return this.unary()
+	}
+	v := this.binary(level + 1)
+	for this.tok.kind == idlPunct {
+		op := ""
+		for _, s := range idlBinaryOps[level] {
+			if this.tok.text == s {
+				op = s
+			}
+		}
+		if op == "" {
+			break
+		}
+		this.tok = this.lex.next()
+		v = this.apply(op, v, this.binary(level+1))
+	}
+	return v
+}
+
+func (this *idlExprEval) unary() interface{} {
+	tok := this.tok
+	switch {
+	case tok.is("-"), tok.is("+"), tok.is("~"), tok.is("!"):
+		this.tok = this.lex.next()
+		v := this.unary()
+		switch tok.text {
+		case "-":
+			if f, ok := v.(float64); ok {
+				return -f
+			}
+			return -this.toInt(v)
+		case "+":
+			return v
+		case "~":
+			return ^this.toInt(v)
+		}
+		return boolToInt(this.toInt(v) == 0)
+	case tok.is("("):
+		this.tok = this.lex.next()
+		//casts like (long)1 are skipped
+		if this.tok.kind == idlIdent && idlBaseTypes[this.tok.text] != 0 {
+			for this.tok.kind == idlIdent || this.tok.is("*") {
+				this.tok = this.lex.next()
+			}
+			this.expect(")")
+			return this.unary()
+		}
+		v := this.binary(0)
+		this.expect(")")
+		return v
+	}
+	this.tok = this.lex.next()
+	switch tok.kind {
+	case idlNumber:
+		return this.number(tok.text)
+	case idlString:
+		return tok.text
+	case idlChar:
+		if len(tok.text) == 0 {
+			return int64(0)
+		}
+		return int64(tok.text[0])
+	case idlIdent:
+		return this.ident(tok.text)
+	}
+	this.lex.fail("invalid expression")
+	return nil
+}
+
+func (this *idlExprEval) expect(text string) {
+	if !this.tok.is(text) {
+		this.lex.fail("expected '" + text + "'")
+	}
+	this.tok = this.lex.next()
+}
+
+func (this *idlExprEval) ident(name string) interface{} {
+	if this.lookup != nil {
+		if v, ok := this.lookup(name); ok {
+			return v
+		}
+	}
+	switch name {
+	case "TRUE", "true":
+		return int64(1)
+	case "FALSE", "false", "NULL":
+		return int64(0)
+	case "VARIANT_TRUE":
+		return int64(-1)
+	case "VARIANT_FALSE":
+		return int64(0)
+	}
+	if text, ok := this.lex.defines[name]; ok && this.depth < 32 {
+		e := &idlExprEval{lookup: this.lookup, depth: this.depth + 1,
+			lex: newIdlLexer(this.lex.file, text, this.lex.defines)}
+		e.lex.line = this.lex.line
+		return e.eval()
+	}
+	this.lex.fail("unknown constant '" + name + "'")
+	return nil
+}
+
+func (this *idlExprEval) number(text string) interface{} {
+	s := strings.ToLower(text)
+	isHex := strings.HasPrefix(s, "0x")
+	if !isHex && strings.ContainsAny(s, ".e") {
+		s = strings.TrimRight(s, "fl")
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			this.lex.fail("invalid number '" + text + "'")
+		}
+		return f
+	}
+	s = strings.TrimRight(s, "ul")
+	var v uint64
+	var err error
+	if isHex {
+		v, err = strconv.ParseUint(s[2:], 16, 64)
+	} else if len(s) > 1 && s[0] == '0' {
+		v, err = strconv.ParseUint(s[1:], 8, 64)
+	} else {
+		v, err = strconv.ParseUint(s, 10, 64)
+	}
+	if err != nil {
+		this.lex.fail("invalid number '" + text + "'")
+	}
+	return int64(v)
+}
+
+func (this *idlExprEval) toInt(v interface{}) int64 {
+	switch v := v.(type) {
+	case int64:
+		return v
+	case float64:
+		return int64(v)
+	}
+	this.lex.fail("integer expected")
+	return 0
+}
+
+func boolToInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func (this *idlExprEval) apply(op string, a, b interface{}) interface{} {
+	fa, aFloat := a.(float64)
+	fb, bFloat := b.(float64)
+	if aFloat || bFloat {
+		if !aFloat {
+			fa = float64(this.toInt(a))
+		}
+		if !bFloat {
+			fb = float64(this.toInt(b))
+		}
+		switch op {
+		case "+":
+			return fa + fb
+		case "-":
+			return fa - fb
+		case "*":
+			return fa * fb
+		case "/":
+			return fa / fb
+		}
+	}
+	x, y := this.toInt(a), this.toInt(b)
+	switch op {
+	case "||":
+		return boolToInt(x != 0 || y != 0)
+	case "&&":
+		return boolToInt(x != 0 && y != 0)
+	case "|":
+		return x | y
+	case "^":
+		return x ^ y
+	case "&":
+		return x & y
+	case "==":
+		return boolToInt(x == y)
+	case "!=":
+		return boolToInt(x != y)
+	case "<":
+		return boolToInt(x < y)
+	case ">":
+		return boolToInt(x > y)
+	case "<=":
+		return boolToInt(x <= y)
+	case ">=":
+		return boolToInt(x >= y)
+	case "<<":
+		return x << uint(y)
+	case ">>":
+		return x >> uint(y)
+	case "+":
+		return x + y
+	case "-":
+		return x - y
+	case "*":
+		return x * y
+	}
+	if y == 0 {
+		this.lex.fail("division by zero")
+	}
+	if op == "/" {
+		return x / y
+	}
+	return x % y
+}
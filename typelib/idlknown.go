@@ -0,0 +1,186 @@
+package typelib
+
+import (
+	"github.com/zzl/go-tlbimp/utils"
+)
+
+// Interfaces of objidl.idl, oleidl.idl and ocidl.idl that may be referred
+// to without an importlib. They are not written into the library, but
+// their methods are counted in the vtables of the interfaces derived
+// from them.
+
+var idlKnownInterfaces = map[string]string{
+	"IClassFactory":      "00000001-0000-0000-C000-000000000046",
+	"IStorage":           "0000000B-0000-0000-C000-000000000046",
+	"IStream":            "0000000C-0000-0000-C000-000000000046",
+	"IEnumUnknown":       "00000100-0000-0000-C000-000000000046",
+	"IEnumString":        "00000101-0000-0000-C000-000000000046",
+	"IPersistStream":     "00000109-0000-0000-C000-000000000046",
+	"IPersist":           "0000010C-0000-0000-C000-000000000046",
+	"IOleWindow":         "00000114-0000-0000-C000-000000000046",
+	"ISequentialStream":  "0C733A30-2A1C-11CE-ADE5-00AA0044773D",
+	"IPersistStreamInit": "7FD52380-4E07-101B-AE2D-08002B2EC713",
+}
+
+// knownInterface returns the entry of the known interface of the name,
+// nil if it is not one
+func (this *idlParser) knownInterface(name string) *typeEntry {
+	sGuid, ok := idlKnownInterfaces[name]
+	if !ok {
+		return nil
+	}
+	if e := this.known[name]; e != nil {
+		return e
+	}
+	e := &typeEntry{name: name, kind: TKIND_INTERFACE, guid: mustParseGuid(sGuid)}
+	this.known[name] = e //before the methods, which may refer to it
+
+	in, out := PARAMFLAG_FIN, PARAMFLAG_FOUT
+	ulong, dword, boolean := vtDesc(VT_UI4), vtDesc(VT_UI4), vtDesc(VT_I4)
+	uint64 := vtDesc(VT_UI8)
+	str := vtDesc(VT_LPWSTR)
+	voidPtr := ptrDesc(vtDesc(VT_VOID))
+	iid := ptrDesc(refDesc(stdoleTypes[0]))
+	ref := func(name string) *typeDesc {
+		return ptrDesc(refDesc(this.knownInterface(name)))
+	}
+	method := func(name string, params ...*paramEntry) *funcEntry {
+		return stdFunc(name, MEMBERID_NIL, VT_HRESULT, params...)
+	}
+	next := func(elem *typeDesc) *funcEntry {
+		return method("Next", stdParam("celt", ulong, in),
+			stdParam("rgelt", ptrDesc(elem), out),
+			stdParam("pceltFetched", ptrDesc(ulong), out))
+	}
+
+	base := stdoleTypes[3]
+	switch name {
+	case "IClassFactory":
+		e.funcs = []*funcEntry{
+			method("CreateInstance", stdParam("pUnkOuter", vtDesc(VT_UNKNOWN), in),
+				stdParam("riid", iid, in),
+				stdParam("ppvObject", ptrDesc(voidPtr), out)),
+			method("LockServer", stdParam("fLock", boolean, in)),
+		}
+	case "ISequentialStream":
+		e.funcs = []*funcEntry{
+			method("Read", stdParam("pv", voidPtr, out),
+				stdParam("cb", ulong, in),
+				stdParam("pcbRead", ptrDesc(ulong), out)),
+			method("Write", stdParam("pv", voidPtr, in),
+				stdParam("cb", ulong, in),
+				stdParam("pcbWritten", ptrDesc(ulong), out)),
+		}
+	case "IStream":
+		base = this.knownInterface("ISequentialStream")
+		e.funcs = []*funcEntry{
+			method("Seek", stdParam("dlibMove", vtDesc(VT_I8), in),
+				stdParam("dwOrigin", dword, in),
+				stdParam("plibNewPosition", ptrDesc(uint64), out)),
+			method("SetSize", stdParam("libNewSize", uint64, in)),
+			method("CopyTo", stdParam("pstm", ref("IStream"), in),
+				stdParam("cb", uint64, in),
+				stdParam("pcbRead", ptrDesc(uint64), out),
+				stdParam("pcbWritten", ptrDesc(uint64), out)),
+			method("Commit", stdParam("grfCommitFlags", dword, in)),
+			method("Revert"),
+			method("LockRegion", stdParam("libOffset", uint64, in),
+				stdParam("cb", uint64, in),
+				stdParam("dwLockType", dword, in)),
+			method("UnlockRegion", stdParam("libOffset", uint64, in),
+				stdParam("cb", uint64, in),
+				stdParam("dwLockType", dword, in)),
+			method("Stat", stdParam("pstatstg", voidPtr, out),
+				stdParam("grfStatFlag", dword, in)),
+			method("Clone", stdParam("ppstm", ptrDesc(ref("IStream")), out)),
+		}
+	case "IStorage":
+		e.funcs = []*funcEntry{
+			method("CreateStream", stdParam("pwcsName", str, in),
+				stdParam("grfMode", dword, in),
+				stdParam("reserved1", dword, in),
+				stdParam("reserved2", dword, in),
+				stdParam("ppstm", ptrDesc(ref("IStream")), out)),
+			method("OpenStream", stdParam("pwcsName", str, in),
+				stdParam("reserved1", voidPtr, in),
+				stdParam("grfMode", dword, in),
+				stdParam("reserved2", dword, in),
+				stdParam("ppstm", ptrDesc(ref("IStream")), out)),
+			method("CreateStorage", stdParam("pwcsName", str, in),
+				stdParam("grfMode", dword, in),
+				stdParam("reserved1", dword, in),
+				stdParam("reserved2", dword, in),
+				stdParam("ppstg", ptrDesc(ref("IStorage")), out)),
+			method("OpenStorage", stdParam("pwcsName", str, in),
+				stdParam("pstgPriority", ref("IStorage"), in),
+				stdParam("grfMode", dword, in),
+				stdParam("snbExclude", ptrDesc(str), in),
+				stdParam("reserved", dword, in),
+				stdParam("ppstg", ptrDesc(ref("IStorage")), out)),
+			method("CopyTo", stdParam("ciidExclude", dword, in),
+				stdParam("rgiidExclude", iid, in),
+				stdParam("snbExclude", ptrDesc(str), in),
+				stdParam("pstgDest", ref("IStorage"), in)),
+			method("MoveElementTo", stdParam("pwcsName", str, in),
+				stdParam("pstgDest", ref("IStorage"), in),
+				stdParam("pwcsNewName", str, in),
+				stdParam("grfFlags", dword, in)),
+			method("Commit", stdParam("grfCommitFlags", dword, in)),
+			method("Revert"),
+			method("EnumElements", stdParam("reserved1", dword, in),
+				stdParam("reserved2", voidPtr, in),
+				stdParam("reserved3", dword, in),
+				stdParam("ppenum", ptrDesc(vtDesc(VT_UNKNOWN)), out)),
+			method("DestroyElement", stdParam("pwcsName", str, in)),
+			method("RenameElement", stdParam("pwcsOldName", str, in),
+				stdParam("pwcsNewName", str, in)),
+			method("SetElementTimes", stdParam("pwcsName", str, in),
+				stdParam("pctime", ptrDesc(uint64), in),
+				stdParam("patime", ptrDesc(uint64), in),
+				stdParam("pmtime", ptrDesc(uint64), in)),
+			method("SetClass", stdParam("clsid", iid, in)),
+			method("SetStateBits", stdParam("grfStateBits", dword, in),
+				stdParam("grfMask", dword, in)),
+			method("Stat", stdParam("pstatstg", voidPtr, out),
+				stdParam("grfStatFlag", dword, in)),
+		}
+	case "IEnumUnknown", "IEnumString":
+		elem := vtDesc(VT_UNKNOWN)
+		if name == "IEnumString" {
+			elem = str
+		}
+		e.funcs = []*funcEntry{
+			next(elem),
+			method("Skip", stdParam("celt", ulong, in)),
+			method("Reset"),
+			method("Clone", stdParam("ppenum", ptrDesc(ref(name)), out)),
+		}
+	case "IPersist":
+		e.funcs = []*funcEntry{
+			method("GetClassID", stdParam("pClassID", iid, out)),
+		}
+	case "IPersistStream", "IPersistStreamInit":
+		base = this.knownInterface("IPersist")
+		e.funcs = []*funcEntry{
+			method("IsDirty"),
+			method("Load", stdParam("pStm", ref("IStream"), in)),
+			method("Save", stdParam("pStm", ref("IStream"), in),
+				stdParam("fClearDirty", boolean, in)),
+			method("GetSizeMax", stdParam("pcbSize", ptrDesc(uint64), out)),
+		}
+		if name == "IPersistStreamInit" {
+			e.funcs = append(e.funcs, method("InitNew"))
+		}
+	case "IOleWindow":
+		e.funcs = []*funcEntry{
+			method("GetWindow", stdParam("phwnd", ptrDesc(refDesc(this.builtinType("HWND"))), out)),
+			method("ContextSensitiveHelp", stdParam("fEnterMode", boolean, in)),
+		}
+	}
+	e.impls = []*implEntry{{ref: base}}
+	for _, f := range e.funcs {
+		f.flags = 0
+	}
+	layoutEntry(e, utils.PtrSize)
+	return e
+}
@@ -0,0 +1,441 @@
+package typelib
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Tokenizer for IDL/ODL sources. Comments are dropped and preprocessor
+// lines are handled here: #define records simple macros, #if/#ifdef
+// blocks are evaluated as far as possible and other directives are
+// ignored. Files named by #include are not read, but recorded for the
+// header frontend.
+
+type idlTokenKind int
+
+const (
+	idlEOF idlTokenKind = iota
+	idlIdent
+	idlNumber
+	idlString
+	idlChar
+	idlPunct
+)
+
+type idlToken struct {
+	kind idlTokenKind
+	text string
+	line int
+}
+
+func (this idlToken) is(text string) bool {
+	return (this.kind == idlIdent || this.kind == idlPunct) && this.text == text
+}
+
+type idlError struct {
+	file string
+	line int
+	msg  string
+}
+
+func (this *idlError) Error() string {
+	return this.file + ":" + strconv.Itoa(this.line) + ": " + this.msg
+}
+
+type idlLexer struct {
+	file string
+	src  string
+	pos  int
+	line int
+
+	defines map[string]string
+	conds   []idlCond
+
+	includes []string
+
+	//comments holding attributes only, as MIDL leaves them in the
+	//headers it writes (/* [in] */), are read as attributes
+	attrComments   bool
+	attrCommentEnd int
+}
+
+type idlCond struct {
+	active bool //the current branch is taken
+	taken  bool //a branch has been taken
+	outer  bool //the enclosing block is active
+}
+
+func newIdlLexer(file string, src string, defines map[string]string) *idlLexer {
+	return &idlLexer{file: file, src: src, line: 1, defines: defines}
+}
+
+func (this *idlLexer) fail(msg string) {
+	panic(&idlError{file: this.file, line: this.line, msg: msg})
+}
+
+func (this *idlLexer) active() bool {
+	return len(this.conds) == 0 || this.conds[len(this.conds)-1].active
+}
+
+func (this *idlLexer) atLineStart() bool {
+	for n := this.pos - 1; n >= 0; n-- {
+		switch this.src[n] {
+		case ' ', '\t', '\r':
+			continue
+		case '\n':
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+// skipSpace skips white space, comments, preprocessor lines and
+// inactive conditional blocks.
+func (this *idlLexer) skipSpace() {
+	for this.pos < len(this.src) {
+		c := this.src[this.pos]
+		switch {
+		case c == '\n':
+			this.line++
+			this.pos++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			this.pos++
+		case this.attrCommentEnd != 0 && this.pos == this.attrCommentEnd:
+			this.pos += 2
+			this.attrCommentEnd = 0
+		case c == '/' && strings.HasPrefix(this.src[this.pos:], "//"):
+			for this.pos < len(this.src) && this.src[this.pos] != '\n' {
+				this.pos++
+			}
+		case c == '/' && strings.HasPrefix(this.src[this.pos:], "/*"):
+			end := strings.Index(this.src[this.pos+2:], "*/")
+			if end == -1 {
+				this.fail("unterminated comment")
+			}
+			comment := this.src[this.pos : this.pos+2+end+2]
+			if this.attrComments && this.active() && isAttrComment(comment[2:len(comment)-2]) {
+				this.attrCommentEnd = this.pos + 2 + end
+				this.pos += 2
+				continue
+			}
+			this.line += strings.Count(comment, "\n")
+			this.pos += len(comment)
+		case c == '#' && this.atLineStart():
+			this.directive(this.readLine())
+		case !this.active():
+			this.readLine()
+		default:
+			return
+		}
+	}
+}
+
+// readLine returns the rest of the line, joining continued lines
+func (this *idlLexer) readLine() string {
+	var sb strings.Builder
+	for this.pos < len(this.src) {
+		c := this.src[this.pos]
+		if c == '\\' && this.pos+1 < len(this.src) &&
+			(this.src[this.pos+1] == '\n' || strings.HasPrefix(this.src[this.pos+1:], "\r\n")) {
+			this.pos = strings.IndexByte(this.src[this.pos:], '\n') + this.pos + 1
+			this.line++
+			sb.WriteByte(' ')
+			continue
+		}
+		if c == '\n' {
+			break
+		}
+		if c == '/' && strings.HasPrefix(this.src[this.pos:], "//") {
+			for this.pos < len(this.src) && this.src[this.pos] != '\n' {
+				this.pos++
+			}
+			break
+		}
+		if c == '/' && strings.HasPrefix(this.src[this.pos:], "/*") {
+			end := strings.Index(this.src[this.pos+2:], "*/")
+			if end == -1 {
+				this.fail("unterminated comment")
+			}
+			comment := this.src[this.pos : this.pos+2+end+2]
+			this.line += strings.Count(comment, "\n")
+			this.pos += len(comment)
+			sb.WriteByte(' ')
+			continue
+		}
+		sb.WriteByte(c)
+		this.pos++
+	}
+	return strings.TrimSpace(sb.String())
+}
+
+func (this *idlLexer) directive(line string) {
+	line = strings.TrimSpace(line[1:])
+	name := line
+	rest := ""
+	if pos := strings.IndexAny(line, " \t("); pos != -1 {
+		name = line[:pos]
+		rest = strings.TrimSpace(line[pos:])
+	}
+	switch name {
+	case "ifdef", "ifndef", "if":
+		outer := this.active()
+		var cond bool
+		switch name {
+		case "ifdef":
+			_, cond = this.defines[rest]
+		case "ifndef":
+			_, cond = this.defines[rest]
+			cond = !cond
+		default:
+			cond = this.evalCond(rest)
+		}
+		active := outer && cond
+		this.conds = append(this.conds, idlCond{active: active, taken: active, outer: outer})
+	case "elif", "else":
+		if len(this.conds) == 0 {
+			this.fail("#" + name + " without #if")
+		}
+		c := &this.conds[len(this.conds)-1]
+		cond := true
+		if name == "elif" && c.outer && !c.taken {
+			cond = this.evalCond(rest)
+		}
+		c.active = c.outer && !c.taken && cond
+		if c.active {
+			c.taken = true
+		}
+	case "endif":
+		if len(this.conds) == 0 {
+			this.fail("#endif without #if")
+		}
+		this.conds = this.conds[:len(this.conds)-1]
+	case "define":
+		if !this.active() {
+			return
+		}
+		macro := rest
+		value := ""
+		if pos := strings.IndexAny(rest, " \t"); pos != -1 {
+			macro = rest[:pos]
+			value = strings.TrimSpace(rest[pos:])
+		}
+		if strings.IndexByte(macro, '(') != -1 {
+			return //function-like macros are not supported
+		}
+		this.defines[macro] = value
+	case "undef":
+		if this.active() {
+			delete(this.defines, rest)
+		}
+	case "include":
+		if this.active() && strings.HasPrefix(rest, "\"") {
+			this.includes = append(this.includes, strings.Trim(rest, "\""))
+		}
+	}
+}
+
+// isAttrComment tells if a comment is a list of attributes
+func isAttrComment(text string) bool {
+	text = strings.TrimSpace(text)
+	return strings.HasPrefix(text, "[") && strings.HasSuffix(text, "]")
+}
+
+// evalCond evaluates a #if condition. Anything that can not be
+// evaluated is taken as true.
+func (this *idlLexer) evalCond(expr string) (result bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*idlError); !ok {
+				panic(r)
+			}
+			result = true
+		}
+	}()
+	for {
+		pos := strings.Index(expr, "defined")
+		if pos == -1 {
+			break
+		}
+		rest := strings.TrimSpace(expr[pos+len("defined"):])
+		paren := strings.HasPrefix(rest, "(")
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, "("))
+		end := 0
+		for end < len(rest) && isIdentChar(rest[end]) {
+			end++
+		}
+		_, ok := this.defines[rest[:end]]
+		rest = rest[end:]
+		if paren {
+			rest = strings.TrimPrefix(strings.TrimSpace(rest), ")")
+		}
+		value := "0"
+		if ok {
+			value = "1"
+		}
+		expr = expr[:pos] + value + " " + rest
+	}
+	e := &idlExprEval{lex: newIdlLexer(this.file, expr, this.defines)}
+	e.lex.line = this.line
+	v := e.eval()
+	switch v := v.(type) {
+	case int64:
+		return v != 0
+	case float64:
+		return v != 0
+	}
+	return true
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+func (this *idlLexer) next() idlToken {
+	this.skipSpace()
+	tok := idlToken{line: this.line}
+	if this.pos >= len(this.src) {
+		if len(this.conds) != 0 {
+			this.fail("missing #endif")
+		}
+		return tok
+	}
+	start := this.pos
+	c := this.src[this.pos]
+	switch {
+	case isIdentChar(c) && !(c >= '0' && c <= '9'):
+		for this.pos < len(this.src) && isIdentChar(this.src[this.pos]) {
+			this.pos++
+		}
+		tok.kind = idlIdent
+		if c == 'L' && this.pos == start+1 && this.pos < len(this.src) &&
+			(this.src[this.pos] == '"' || this.src[this.pos] == '\'') {
+			//wide string/char literal
+			return this.next()
+		}
+	case c >= '0' && c <= '9' || c == '.' && this.pos+1 < len(this.src) &&
+		this.src[this.pos+1] >= '0' && this.src[this.pos+1] <= '9':
+		for this.pos < len(this.src) {
+			c := this.src[this.pos]
+			if isIdentChar(c) || c == '.' {
+				this.pos++
+			} else if (c == '+' || c == '-') && (this.src[this.pos-1] == 'e' ||
+				this.src[this.pos-1] == 'E') && !strings.HasPrefix(this.src[start:], "0x") {
+				this.pos++
+			} else {
+				break
+			}
+		}
+		tok.kind = idlNumber
+	case c == '"':
+		tok.kind = idlString
+		tok.text = this.readQuoted('"')
+		//adjacent literals are concatenated
+		for {
+			save, saveLine := this.pos, this.line
+			this.skipSpace()
+			if this.pos < len(this.src) && this.src[this.pos] == '"' {
+				tok.text += this.readQuoted('"')
+				continue
+			}
+			this.pos, this.line = save, saveLine
+			break
+		}
+		return tok
+	case c == '\'':
+		tok.kind = idlChar
+		tok.text = this.readQuoted('\'')
+		return tok
+	default:
+		tok.kind = idlPunct
+		this.pos++
+		for _, op := range []string{"<<", ">>", "<=", ">=", "==", "!=", "&&", "||", "::", "..."} {
+			if strings.HasPrefix(this.src[start:], op) {
+				this.pos = start + len(op)
+				break
+			}
+		}
+	}
+	tok.text = this.src[start:this.pos]
+	return tok
+}
+
+func (this *idlLexer) readQuoted(quote byte) string {
+	var sb strings.Builder
+	this.pos++
+	for {
+		if this.pos >= len(this.src) || this.src[this.pos] == '\n' {
+			this.fail("unterminated literal")
+		}
+		c := this.src[this.pos]
+		this.pos++
+		if c == quote {
+			break
+		}
+		if c != '\\' || this.pos >= len(this.src) {
+			sb.WriteByte(c)
+			continue
+		}
+		c = this.src[this.pos]
+		this.pos++
+		switch c {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case '0':
+			sb.WriteByte(0)
+		case 'x':
+			end := this.pos
+			for end < len(this.src) && end < this.pos+2 && strings.IndexByte(
+				"0123456789abcdefABCDEF", this.src[end]) != -1 {
+				end++
+			}
+			v, _ := strconv.ParseUint(this.src[this.pos:end], 16, 8)
+			sb.WriteByte(byte(v))
+			this.pos = end
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// raw returns the text up to the parenthesis closing an already
+// consumed '(', and consumes it.
+func (this *idlLexer) raw() string {
+	text := this.rawExpr(")")
+	if this.pos >= len(this.src) {
+		this.fail("missing ')'")
+	}
+	this.pos++
+	return text
+}
+
+// rawExpr returns the text up to one of the terminators found outside
+// of brackets, without consuming the terminator.
+func (this *idlLexer) rawExpr(terms string) string {
+	start := this.pos
+	depth := 0
+	for this.pos < len(this.src) {
+		c := this.src[this.pos]
+		if depth == 0 && strings.IndexByte(terms, c) != -1 {
+			break
+		}
+		switch c {
+		case '"', '\'':
+			this.readQuoted(c)
+			continue
+		case '\n':
+			this.line++
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		}
+		this.pos++
+	}
+	return strings.TrimSpace(this.src[start:this.pos])
+}
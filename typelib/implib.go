@@ -0,0 +1,172 @@
+package typelib
+
+// Imported libraries. The pure-Go readers leave a type of a library other
+// than stdole as a placeholder that knows the library it is in, by LIBID
+// and version, and its index or guid there; ResolveImports fills those in
+// from the libraries at hand.
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ImpLib identifies a library whose types another one refers to
+type ImpLib struct {
+	Name     string //as recorded by the importing library, often a file name
+	Guid     GUID
+	MajorVer uint16
+	MinorVer uint16
+	Lcid     uint32
+}
+
+func (this *ImpLib) String() string {
+	if this.Guid.IsNull() {
+		return this.Name
+	}
+	s := "{" + this.Guid.String() + "} " + strconv.Itoa(int(this.MajorVer)) +
+		"." + strconv.Itoa(int(this.MinorVer))
+	if this.Name != "" {
+		s = this.Name + " " + s
+	}
+	return s
+}
+
+// Matches tells if a library of the attributes can stand for the imported
+// one, that is the same LIBID and major version, and a minor one as high
+func (this *ImpLib) Matches(attr *LibAttr) bool {
+	return !this.Guid.IsNull() && attr.Guid == this.Guid &&
+		attr.MajorVer == this.MajorVer && attr.MinorVer >= this.MinorVer
+}
+
+func newImpLib(attr *LibAttr, name string) *ImpLib {
+	return &ImpLib{Name: name, Guid: attr.Guid, MajorVer: attr.MajorVer,
+		MinorVer: attr.MinorVer, Lcid: attr.Lcid}
+}
+
+// ResolveImports looks the types of imported libraries up in libs, by
+// guid or by index, and returns the imported libraries that some of the
+// types are still missing of.
+func (this *TypeLib) ResolveImports(libs []*TypeLib) []*ImpLib {
+	var missing []*ImpLib
+	seen := make(map[ImpLib]bool)
+	this.visitImpRefs(func(e *typeEntry) {
+		if e.name != "" {
+			return
+		}
+		var target *typeEntry
+		for _, lib := range libs {
+			if !e.impLib.Matches(&lib.attr) {
+				continue
+			}
+			if target = lib.findImpEntry(e); target != nil && target.name != "" {
+				break
+			}
+		}
+		if target == nil || target.name == "" {
+			if !seen[*e.impLib] {
+				seen[*e.impLib] = true
+				missing = append(missing, e.impLib)
+			}
+			return
+		}
+		impLib, impIndex := e.impLib, e.impIndex
+		*e = *target
+		e.impLib, e.impIndex = impLib, impIndex
+	})
+	if this.laidOut {
+		ptrSize := 4
+		if this.attr.SysKind == SYS_WIN64 {
+			ptrSize = 8
+		}
+		for _, e := range this.entries {
+			if (e.kind == TKIND_INTERFACE || e.kind == TKIND_DISPATCH) && e.sizeVft < 0 {
+				layoutEntry(e, ptrSize)
+			}
+		}
+	}
+	this.graph = nil
+	return missing
+}
+
+func (this *TypeLib) findImpEntry(ref *typeEntry) *typeEntry {
+	if !ref.guid.IsNull() {
+		for _, e := range this.entries {
+			if e.guid == ref.guid {
+				return e
+			}
+		}
+		return nil
+	}
+	if ref.impIndex >= 0 && ref.impIndex < len(this.entries) {
+		return this.entries[ref.impIndex]
+	}
+	return nil
+}
+
+// visitImpRefs calls visit once with each type of another library that
+// the types of this one refer to
+func (this *TypeLib) visitImpRefs(visit func(e *typeEntry)) {
+	visited := make(map[*typeEntry]bool)
+	var visitEntry func(e *typeEntry)
+	var visitDesc func(d *typeDesc)
+	visitDesc = func(d *typeDesc) {
+		for ; d != nil; d = d.elem {
+			if d.vt == VT_USERDEFINED {
+				visitEntry(d.ref)
+			}
+		}
+	}
+	visitEntry = func(e *typeEntry) {
+		if e == nil || visited[e] {
+			return
+		}
+		visited[e] = true
+		if e.impLib != nil {
+			visit(e)
+			return
+		}
+		visitDesc(e.alias)
+		for _, f := range e.funcs {
+			visitDesc(f.ret)
+			for _, p := range f.params {
+				visitDesc(p.typ)
+			}
+		}
+		for _, v := range e.vars {
+			visitDesc(v.typ)
+		}
+		for _, impl := range e.impls {
+			visitEntry(impl.ref)
+		}
+	}
+	for _, e := range this.entries {
+		visitEntry(e)
+	}
+}
+
+// ReadTypeLibDir reads the typelibs in dir that can stand for one of imps.
+// Files that are not typelibs are skipped.
+func ReadTypeLibDir(dir string, imps []*ImpLib) []*TypeLib {
+	var libs []*TypeLib
+	fis, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	for _, fi := range fis {
+		if fi.IsDir() {
+			continue
+		}
+		lib, err := ReadTypeLibFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			continue
+		}
+		for _, imp := range imps {
+			if imp.Matches(&lib.attr) {
+				libs = append(libs, lib)
+				break
+			}
+		}
+	}
+	return libs
+}
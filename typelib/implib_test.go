@@ -0,0 +1,113 @@
+package typelib
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var baseLibId = mustParseGuid("00000030-1111-2222-3333-444444444444")
+
+var baseIfaceId = mustParseGuid("00000031-1111-2222-3333-444444444444")
+
+// writeBaseLib writes a library of the base LIBID and version, with
+// IBase and BaseRec, or BaseRec only if lacking
+func writeBaseLib(major uint16, minor uint16, lacking bool) []byte {
+	lib := testLib(SYS_WIN32)
+	lib.name = "BaseLib"
+	lib.attr.Guid, lib.attr.MajorVer, lib.attr.MinorVer = baseLibId, major, minor
+	rec := &typeEntry{name: "BaseRec", kind: TKIND_RECORD, alignment: 4, sizeInstance: 4}
+	rec.vars = []*varEntry{{memid: 0x40000000, name: "n", typ: vtDesc(VT_I4)}}
+	base := &typeEntry{name: "IBase", kind: TKIND_INTERFACE, guid: baseIfaceId, sizeVft: 3 * 4,
+		impls: []*implEntry{{ref: stdoleTypes[3]}}}
+	if lacking {
+		return writeMsft(lib, []*typeEntry{rec})
+	}
+	return writeMsft(lib, []*typeEntry{base, rec})
+}
+
+// writeUserLib writes a library of IUser, which derives from IBase of
+// the base library 2.1 and takes a BaseRec, referred to by index
+func writeUserLib() []byte {
+	imp := &ImpLib{Name: "base.tlb", Guid: baseLibId, MajorVer: 2, MinorVer: 1}
+	base := &typeEntry{kind: TKIND_INTERFACE, guid: baseIfaceId, impLib: imp}
+	rec := &typeEntry{kind: TKIND_RECORD, impLib: imp, impIndex: 1}
+	user := &typeEntry{name: "IUser", kind: TKIND_INTERFACE, sizeVft: 4 * 4,
+		guid:  mustParseGuid("00000032-1111-2222-3333-444444444444"),
+		impls: []*implEntry{{ref: base}}}
+	user.funcs = []*funcEntry{
+		{memid: 0x60010000, name: "Use", funcKind: FUNC_PUREVIRTUAL, invKind: INVOKE_FUNC,
+			callConv: CC_STDCALL, vtblIndex: 3, ret: vtDesc(VT_HRESULT),
+			params: []*paramEntry{stdParam("rec", refDesc(rec), PARAMFLAG_FIN)}},
+	}
+	return writeMsft(testLib(SYS_WIN32), []*typeEntry{user})
+}
+
+func TestResolveImports(t *testing.T) {
+	tests := []struct {
+		name     string
+		libs     [][]byte
+		resolved bool
+	}{
+		{"none", nil, false},
+		{"other major version", [][]byte{writeBaseLib(1, 5, false)}, false},
+		{"older minor version", [][]byte{writeBaseLib(2, 0, false)}, false},
+		{"newer minor version", [][]byte{writeBaseLib(2, 3, false)}, true},
+		{"lacking the types", [][]byte{writeBaseLib(2, 1, true)}, false},
+		{"lacking, then not", [][]byte{writeBaseLib(2, 1, true), writeBaseLib(2, 1, false)}, true},
+	}
+	for _, test := range tests {
+		lib, err := NewTypeLibFromBytes(writeUserLib())
+		if err != nil {
+			t.Fatal(err)
+		}
+		var libs []*TypeLib
+		for _, data := range test.libs {
+			l, err := NewTypeLibFromBytes(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			libs = append(libs, l)
+		}
+		missing := lib.ResolveImports(libs)
+		user, err := lib.GetTypeInfo(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		super, param := user.Super.Name, user.Funcs[0].Params[0].Type.Name
+		if test.resolved {
+			if len(missing) != 0 || super != "IBase" || param != "BaseRec" {
+				t.Errorf("%s: missing %v, super %q, param %q", test.name, missing, super, param)
+			}
+		} else if len(missing) != 1 || missing[0].String() != "base.tlb {"+baseLibId.String()+"} 2.1" {
+			t.Errorf("%s: missing %v", test.name, missing)
+		}
+	}
+}
+
+func TestReadTypeLibDir(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"base1.tlb": writeBaseLib(1, 0, false),
+		"base2.tlb": writeBaseLib(2, 2, false),
+		"user.tlb":  writeUserLib(),
+		"notes.txt": []byte("not a typelib"),
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lib, err := ReadTypeLibFile(filepath.Join(dir, "user.tlb"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	missing := lib.ResolveImports(nil)
+	libs := ReadTypeLibDir(dir, missing)
+	if len(libs) != 1 || libs[0].GetLibAttr().MajorVer != 2 {
+		t.Fatalf("libs %v for %v", libs, missing)
+	}
+	if missing = lib.ResolveImports(libs); len(missing) != 0 {
+		t.Errorf("still missing %v", missing)
+	}
+}
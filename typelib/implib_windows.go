@@ -0,0 +1,33 @@
+package typelib
+
+import (
+	"github.com/zzl/go-win32api/v2/win32"
+)
+
+// getImpLib returns the library of ptiRef if it is another one than the
+// library of pti, stdole aside as its types are the win32 ones
+func getImpLib(pti *win32.ITypeInfo, ptiRef *win32.ITypeInfo) *ImpLib {
+	attr, _ := getContainingLibAttr(pti)
+	refAttr, refName := getContainingLibAttr(ptiRef)
+	if attr == nil || refAttr == nil ||
+		refAttr.Guid == attr.Guid || refAttr.Guid == stdoleLibId {
+		return nil
+	}
+	return newImpLib(refAttr, refName)
+}
+
+func getContainingLibAttr(pti *win32.ITypeInfo) (*LibAttr, string) {
+	var ptl *win32.ITypeLib
+	var index uint32
+	hr := pti.GetContainingTypeLib(&ptl, &index)
+	if win32.FAILED(hr) {
+		return nil, ""
+	}
+	lib, err := NewComTypeLib(ptl)
+	if err != nil {
+		ptl.Release()
+		return nil, ""
+	}
+	defer lib.Dispose()
+	return lib.GetLibAttr(), lib.GetName()
+}
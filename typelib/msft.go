@@ -0,0 +1,507 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Reader for the MSFT binary typelib format, the format written by
+// MIDL and ICreateTypeLib2.
+
+const msftMagic = 0x5446534D //"MSFT"
+
+const (
+	msftSegTypeInfo = iota
+	msftSegImpInfo
+	msftSegImpFiles
+	msftSegRefTab
+	msftSegGuidHash
+	msftSegGuid
+	msftSegNameHash
+	msftSegName
+	msftSegString
+	msftSegTypeDesc
+	msftSegArrayDesc
+	msftSegCustData
+	msftSegCDGuids
+	msftSegRes0e
+	msftSegRes0f
+	msftSegCount
+)
+
+const msftHeaderSize = 0x54
+const msftTypeInfoSize = 0x64
+
+const msftHelpDllFlag = 0x100
+const msftImpInfoOffsetIsGuid = 0x10000
+
+var errMsftFormat = errors.New("invalid or truncated MSFT typelib")
+
+type msftSeg struct {
+	offset int
+	length int
+}
+
+type msftReader struct {
+	dataReader
+
+	segs    [msftSegCount]msftSeg
+	ptrSize int
+
+	name         string
+	doc          string
+	helpFile     string
+	helpContext  uint32
+	attr         LibAttr
+	custData     []*CustData
+	dispatchHref int32
+
+	entries  []*typeEntry
+	descs    map[int32]*typeDesc
+	refs     map[int32]*typeEntry
+	impFiles map[int]*ImpLib
+}
+
+func isMsft(data []byte) bool {
+	return len(data) >= 4 && binary.LittleEndian.Uint32(data) == msftMagic
+}
+
+func readMsft(data []byte) (*msftReader, error) {
+	r := &msftReader{
+		dataReader: dataReader{data: data},
+		descs:      make(map[int32]*typeDesc),
+		refs:       make(map[int32]*typeEntry),
+		impFiles:   make(map[int]*ImpLib),
+	}
+	if !isMsft(data) || len(data) < msftHeaderSize {
+		return nil, errMsftFormat
+	}
+	r.read()
+	if r.bad {
+		return nil, errMsftFormat
+	}
+	return r, nil
+}
+
+func (this *msftReader) read() {
+	varFlags := this.i32(0x14)
+	typeCount := int(this.i32(0x20))
+	if typeCount < 0 || typeCount > len(this.data)/msftTypeInfoSize {
+		this.bad = true
+		return
+	}
+	switch SYSKIND(varFlags & 0xf) {
+	case SYS_WIN64:
+		this.ptrSize = 8
+	default:
+		this.ptrSize = 4
+	}
+
+	segDirOffset := msftHeaderSize + typeCount*4
+	if varFlags&msftHelpDllFlag != 0 {
+		segDirOffset += 4
+	}
+	for n := 0; n < msftSegCount; n++ {
+		this.segs[n].offset = int(this.i32(segDirOffset + n*16))
+		this.segs[n].length = int(this.i32(segDirOffset + n*16 + 4))
+	}
+	if this.bad {
+		return
+	}
+
+	version := this.i32(0x18)
+	this.attr = LibAttr{
+		Guid:     this.guid(int(this.i32(0x08))),
+		Lcid:     uint32(this.i32(0x10)),
+		SysKind:  SYSKIND(varFlags & 0xf),
+		MajorVer: uint16(version),
+		MinorVer: uint16(version >> 16),
+		Flags:    LIBFLAGS(this.i32(0x1c) & 0xffff),
+	}
+	this.name = this.nameAt(int(this.i32(0x38)))
+	this.doc = this.stringAt(int(this.i32(0x24)))
+	this.helpFile = this.stringAt(int(this.i32(0x3c)))
+	this.helpContext = uint32(this.i32(0x2c))
+	this.dispatchHref = this.i32(0x4c)
+	this.custData = this.custDataList(this.i32(0x40))
+
+	this.entries = make([]*typeEntry, typeCount)
+	for n := range this.entries {
+		this.entries[n] = &typeEntry{}
+	}
+	for n, e := range this.entries {
+		this.readTypeInfo(n, e)
+		if this.bad {
+			return
+		}
+	}
+}
+
+func (this *msftReader) guid(off int) GUID {
+	if off < 0 {
+		return GUID{}
+	}
+	return this.guidAt(this.segs[msftSegGuid].offset + off)
+}
+
+func (this *msftReader) nameAt(off int) string {
+	if off < 0 {
+		return ""
+	}
+	off += this.segs[msftSegName].offset
+	size := int(this.i32(off+8) & 0xff)
+	return ansiToStr(this.bytes(off+12, size))
+}
+
+func (this *msftReader) stringAt(off int) string {
+	if off < 0 {
+		return ""
+	}
+	off += this.segs[msftSegString].offset
+	size := int(this.u16(off))
+	return ansiToStr(this.bytes(off+2, size))
+}
+
+func ansiToStr(b []byte) string {
+	runes := make([]rune, len(b))
+	for n, c := range b {
+		runes[n] = rune(c)
+	}
+	return string(runes)
+}
+
+func (this *msftReader) readTypeInfo(index int, e *typeEntry) {
+	off := this.segs[msftSegTypeInfo].offset + index*msftTypeInfoSize
+
+	typeKind := this.i32(off)
+	e.kind = TYPEKIND(typeKind & 0xf)
+	e.alignment = int(typeKind>>11) & 0x1f
+	memOffset := int(this.i32(off + 0x04))
+	cElement := uint32(this.i32(off + 0x18))
+	e.guid = this.guid(int(this.i32(off + 0x2c)))
+	e.flags = TYPEFLAGS(this.i32(off + 0x30))
+	e.name = this.nameAt(int(this.i32(off + 0x34)))
+	version := uint32(this.i32(off + 0x38))
+	e.verMajor, e.verMinor = uint16(version), uint16(version>>16)
+	e.doc = this.stringAt(int(this.i32(off + 0x3c)))
+	e.helpContext = uint32(this.i32(off + 0x44))
+	e.custData = this.custDataList(this.i32(off + 0x48))
+	cImplTypes := int(int16(this.u16(off + 0x4c)))
+	e.sizeVft = int(int16(this.u16(off + 0x4e)))
+	e.sizeInstance = int(this.i32(off + 0x50))
+	dataType1 := this.i32(off + 0x54)
+
+	switch e.kind {
+	case TKIND_ALIAS:
+		e.alias = this.typeDesc(dataType1)
+	case TKIND_MODULE:
+		e.dllName = this.stringAt(int(dataType1))
+	case TKIND_COCLASS:
+		refOff := int(dataType1)
+		for n := 0; n < cImplTypes && refOff >= 0; n++ {
+			recOff := this.segs[msftSegRefTab].offset + refOff
+			e.impls = append(e.impls, &implEntry{
+				ref:      this.refType(this.i32(recOff)),
+				flags:    IMPLTYPEFLAGS(this.i32(recOff + 4)),
+				custData: this.custDataList(this.i32(recOff + 8)),
+			})
+			refOff = int(this.i32(recOff + 12))
+			if this.bad {
+				return
+			}
+		}
+	default:
+		if dataType1 != -1 {
+			e.impls = []*implEntry{{ref: this.refType(dataType1)}}
+		}
+	}
+
+	funcCount := int(cElement & 0xffff)
+	varCount := int(cElement >> 16)
+	if funcCount+varCount > 0 {
+		this.readMembers(e, memOffset, funcCount, varCount)
+	}
+}
+
+func (this *msftReader) readMembers(e *typeEntry, offset int, funcCount int, varCount int) {
+	infoLen := int(this.i32(offset))
+	memberCount := funcCount + varCount
+	tableOffset := offset + infoLen + 4
+	memIdAt := func(n int) int32 {
+		return this.i32(tableOffset + n*4)
+	}
+	nameAt := func(n int) int32 {
+		return this.i32(tableOffset + (memberCount+n)*4)
+	}
+
+	recOffset := offset + 4
+	var prev *funcEntry
+	for n := 0; n < funcCount; n++ {
+		recLen := int(this.i32(recOffset) & 0xffff)
+		f := this.readFunc(recOffset, recLen)
+		if this.bad {
+			return
+		}
+		f.memid = memIdAt(n)
+		nameOffset := nameAt(n)
+		if nameOffset == -1 && prev != nil && isPropFunc(prev) && isPropFunc(f) {
+			f.name = prev.name
+		} else {
+			f.name = this.nameAt(int(nameOffset))
+		}
+		e.funcs = append(e.funcs, f)
+		prev = f
+		recOffset += recLen
+	}
+
+	if varCount == 0 {
+		return
+	}
+	recOffset = offset + 4 + int(this.i32(tableOffset+(memberCount*2+funcCount)*4))
+	for n := 0; n < varCount; n++ {
+		recLen := int(this.i32(recOffset) & 0xff)
+		v := this.readVar(recOffset, recLen)
+		if this.bad {
+			return
+		}
+		v.memid = memIdAt(funcCount + n)
+		v.name = this.nameAt(int(nameAt(funcCount + n)))
+		e.vars = append(e.vars, v)
+		recOffset += recLen
+	}
+}
+
+func isPropFunc(f *funcEntry) bool {
+	return f.invKind&(INVOKE_PROPERTYGET|
+		INVOKE_PROPERTYPUT|INVOKE_PROPERTYPUTREF) != 0
+}
+
+func (this *msftReader) readFunc(off int, recLen int) *funcEntry {
+	f := &funcEntry{}
+	dataType := this.i32(off + 4)
+	f.flags = FUNCFLAGS(this.i32(off + 8))
+	vtblOffset := int(int16(this.u16(off + 12)))
+	fkccic := this.i32(off + 16)
+	paramCount := int(int16(this.u16(off + 20)))
+	f.cParamsOpt = int(int16(this.u16(off + 22)))
+	if paramCount < 0 || paramCount*16 > recLen {
+		this.bad = true
+		return f
+	}
+
+	f.funcKind = FUNCKIND(fkccic & 0x7)
+	f.invKind = INVOKEKIND(fkccic >> 3 & 0xf)
+	f.callConv = CALLCONV(fkccic >> 8 & 0xf)
+	f.vtblIndex = (vtblOffset &^ 1) / this.ptrSize
+	f.ret = this.typeDesc(dataType)
+
+	hasDefaults := fkccic&0x1000 != 0
+	optional := recLen - paramCount*12
+	if hasDefaults {
+		optional -= paramCount * 4
+	}
+	if optional > 28 {
+		f.doc = this.stringAt(int(this.i32(off + 28)))
+	}
+	if optional > 32 {
+		entry := this.i32(off + 32)
+		if fkccic&0x2000 != 0 {
+			f.ordinal = int(entry & 0xffff)
+		} else {
+			f.dllEntry = this.stringAt(int(entry))
+		}
+	}
+	hasCustData := fkccic&0x80 != 0
+	if hasCustData && optional > 48 {
+		f.custData = this.custDataList(this.i32(off + 48))
+	}
+
+	paramOffset := off + recLen - paramCount*12
+	defaultOffset := paramOffset - paramCount*4
+	for n := 0; n < paramCount; n++ {
+		pOff := paramOffset + n*12
+		p := &paramEntry{}
+		p.typ = this.typeDesc(this.i32(pOff))
+		p.name = this.nameAt(int(this.i32(pOff + 4)))
+		p.flags = PARAMFLAGS(this.i32(pOff + 8))
+		if hasDefaults && p.flags&PARAMFLAG_FHASDEFAULT != 0 {
+			p.value = this.value(this.i32(defaultOffset + n*4))
+		}
+		if hasCustData && optional > 52+n*4 {
+			p.custData = this.custDataList(this.i32(off + 52 + n*4))
+		}
+		f.params = append(f.params, p)
+	}
+	return f
+}
+
+func (this *msftReader) readVar(off int, recLen int) *varEntry {
+	v := &varEntry{}
+	v.typ = this.typeDesc(this.i32(off + 4))
+	v.flags = VARFLAGS(this.i32(off + 8))
+	v.varKind = VARKIND(this.u16(off + 12))
+	offsValue := this.i32(off + 16)
+	if recLen > 24 {
+		v.doc = this.stringAt(int(this.i32(off + 24)))
+	}
+	if recLen > 32 {
+		v.custData = this.custDataList(this.i32(off + 32))
+	}
+	if v.varKind == VAR_CONST {
+		v.value = this.value(offsValue)
+	} else {
+		v.oInst = int(offsValue)
+	}
+	return v
+}
+
+func (this *msftReader) typeDesc(v int32) *typeDesc {
+	if v < 0 {
+		return &typeDesc{vt: VARENUM(v) & VT_TYPEMASK}
+	}
+	if d, ok := this.descs[v]; ok {
+		return d
+	}
+	off := this.segs[msftSegTypeDesc].offset + int(v)
+	d := &typeDesc{vt: VARENUM(this.u16(off)) & VT_TYPEMASK}
+	this.descs[v] = d
+	if this.bad {
+		return d
+	}
+	data := this.i32(off + 4)
+	switch d.vt {
+	case VT_PTR, VT_SAFEARRAY:
+		d.elem = this.typeDesc(data)
+	case VT_CARRAY:
+		aOff := this.segs[msftSegArrayDesc].offset + int(data)
+		d.elem = this.typeDesc(this.i32(aOff))
+		dimCount := int(this.u16(aOff + 4))
+		for n := 0; n < dimCount && !this.bad; n++ {
+			d.dims = append(d.dims, int(this.i32(aOff+8+n*8)))
+		}
+	case VT_USERDEFINED:
+		d.ref = this.refType(data)
+	}
+	return d
+}
+
+func (this *msftReader) refType(href int32) *typeEntry {
+	if href == -1 {
+		return nil
+	}
+	if href&3 == 0 {
+		index := int(href) / msftTypeInfoSize
+		if index < 0 || index >= len(this.entries) {
+			this.bad = true
+			return nil
+		}
+		return this.entries[index]
+	}
+	if e, ok := this.refs[href]; ok {
+		return e
+	}
+	off := this.segs[msftSegImpInfo].offset + int(href&^3)
+	flags := this.i32(off)
+	impFile := this.impFile(int(this.i32(off + 4)))
+	oGuid := this.i32(off + 8)
+
+	var guid GUID
+	index := -1
+	if flags&msftImpInfoOffsetIsGuid != 0 {
+		guid = this.guid(int(oGuid))
+	} else {
+		index = int(oGuid)
+	}
+	e := findStdoleType(impFile.Guid, guid, index)
+	if e == nil {
+		e = &typeEntry{guid: guid, kind: TYPEKIND(flags >> 24 & 0xff),
+			impLib: impFile, impIndex: index}
+	}
+	this.refs[href] = e
+	return e
+}
+
+func (this *msftReader) impFile(rel int) *ImpLib {
+	if f, ok := this.impFiles[rel]; ok {
+		return f
+	}
+	off := this.segs[msftSegImpFiles].offset + rel
+	f := &ImpLib{}
+	f.Guid = this.guid(int(this.i32(off)))
+	f.Lcid = uint32(this.i32(off + 4))
+	f.MajorVer = this.u16(off + 8)
+	f.MinorVer = this.u16(off + 10)
+	size := int(this.u16(off+12)) >> 2
+	f.Name = ansiToStr(this.bytes(off+14, size))
+	this.impFiles[rel] = f
+	return f
+}
+
+// custDataList reads a chain of CDGuids entries, each of which is
+// the offsets of the guid, of the value and of the next entry.
+func (this *msftReader) custDataList(off int32) []*CustData {
+	var list []*CustData
+	seg := this.segs[msftSegCDGuids]
+	for off >= 0 && len(list) < seg.length/12 && !this.bad {
+		entryOff := seg.offset + int(off)
+		list = append(list, &CustData{
+			Guid:  this.guid(int(this.i32(entryOff))),
+			Value: this.value(this.i32(entryOff + 4)),
+		})
+		off = this.i32(entryOff + 8)
+	}
+	return list
+}
+
+func (this *msftReader) value(v int32) interface{} {
+	if v < 0 {
+		vt := VARENUM((v & 0x7c000000) >> 26)
+		return convertValue(vt, uint64(v&0x3ffffff))
+	}
+	off := this.segs[msftSegCustData].offset + int(v)
+	vt := VARENUM(this.u16(off))
+	switch vt {
+	case VT_BSTR:
+		size := int(this.i32(off + 2))
+		if size < 0 {
+			return ""
+		}
+		return ansiToStr(this.bytes(off+6, size))
+	case VT_R8, VT_CY, VT_DATE, VT_I8, VT_UI8:
+		b := this.bytes(off+2, 8)
+		if b == nil {
+			return nil
+		}
+		return convertValue(vt, binary.LittleEndian.Uint64(b))
+	}
+	return convertValue(vt, uint64(uint32(this.i32(off+2))))
+}
+
+func convertValue(vt VARENUM, bits uint64) interface{} {
+	switch vt {
+	case VT_I1:
+		return int8(bits)
+	case VT_UI1:
+		return uint8(bits)
+	case VT_I2:
+		return int16(bits)
+	case VT_UI2:
+		return uint16(bits)
+	case VT_I4, VT_INT, VT_ERROR, VT_HRESULT:
+		return int32(bits)
+	case VT_UI4, VT_UINT:
+		return uint32(bits)
+	case VT_I8, VT_CY:
+		return int64(bits)
+	case VT_UI8:
+		return uint64(bits)
+	case VT_R4:
+		return math.Float32frombits(uint32(bits))
+	case VT_R8, VT_DATE:
+		return math.Float64frombits(bits)
+	case VT_BOOL:
+		return int16(bits) != 0
+	}
+	return nil
+}
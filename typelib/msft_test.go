@@ -0,0 +1,765 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"github.com/zzl/go-tlbimp/utils"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// msftWriter writes type entries in the MSFT format, the way MIDL lays
+// them out, for the reader to be tested on typelibs built in memory
+
+type msftBuf struct{ b []byte }
+
+func (this *msftBuf) i32(v int32) {
+	this.b = binary.LittleEndian.AppendUint32(this.b, uint32(v))
+}
+
+func (this *msftBuf) u16(v uint16) {
+	this.b = binary.LittleEndian.AppendUint16(this.b, v)
+}
+
+func (this *msftBuf) pad() {
+	for len(this.b)%4 != 0 {
+		this.b = append(this.b, 0x57)
+	}
+}
+
+type msftWriter struct {
+	segs     [msftSegCount]msftBuf
+	members  msftBuf
+	index    map[*typeEntry]int
+	names    map[string]int32
+	guids    map[GUID]int32
+	strs     map[string]int32
+	imps     map[*typeEntry]int32
+	impFiles map[GUID]int32
+}
+
+func (this *msftWriter) name(s string) int32 {
+	if s == "" {
+		return -1
+	}
+	if o, ok := this.names[s]; ok {
+		return o
+	}
+	seg := &this.segs[msftSegName]
+	o := int32(len(seg.b))
+	seg.i32(-1)
+	seg.i32(-1)
+	seg.i32(int32(len(s)) | 0x1234<<16)
+	seg.b = append(seg.b, s...)
+	seg.pad()
+	this.names[s] = o
+	return o
+}
+
+func (this *msftWriter) str(s string) int32 {
+	if s == "" {
+		return -1
+	}
+	if o, ok := this.strs[s]; ok {
+		return o
+	}
+	seg := &this.segs[msftSegString]
+	o := int32(len(seg.b))
+	seg.u16(uint16(len(s)))
+	seg.b = append(seg.b, s...)
+	seg.pad()
+	this.strs[s] = o
+	return o
+}
+
+func (this *msftWriter) guid(g GUID) int32 {
+	if o, ok := this.guids[g]; ok {
+		return o
+	}
+	seg := &this.segs[msftSegGuid]
+	o := int32(len(seg.b))
+	seg.i32(int32(g.Data1))
+	seg.u16(g.Data2)
+	seg.u16(g.Data3)
+	seg.b = append(seg.b, g.Data4[:]...)
+	seg.i32(-1)
+	seg.i32(-1)
+	this.guids[g] = o
+	return o
+}
+
+// href is the reference to a type of the library, or of stdole2.tlb or
+// the impLib of the entry
+func (this *msftWriter) href(e *typeEntry) int32 {
+	if n, ok := this.index[e]; ok {
+		return int32(n * msftTypeInfoSize)
+	}
+	if o, ok := this.imps[e]; ok {
+		return o | 1
+	}
+	lib := e.impLib
+	if lib == nil {
+		lib = &ImpLib{Name: "stdole2.tlb", Guid: stdoleLibId, MajorVer: 2}
+	}
+	impFile, ok := this.impFiles[lib.Guid]
+	if !ok {
+		seg := &this.segs[msftSegImpFiles]
+		impFile = int32(len(seg.b))
+		this.impFiles[lib.Guid] = impFile
+		seg.i32(this.guid(lib.Guid))
+		seg.i32(0)
+		seg.u16(lib.MajorVer)
+		seg.u16(lib.MinorVer)
+		seg.u16(uint16(len(lib.Name) << 2))
+		seg.b = append(seg.b, lib.Name...)
+		seg.pad()
+	}
+	seg := &this.segs[msftSegImpInfo]
+	o := int32(len(seg.b))
+	if e.guid.IsNull() {
+		seg.i32(int32(e.kind) << 24)
+		seg.i32(impFile)
+		seg.i32(int32(e.impIndex))
+	} else {
+		seg.i32(msftImpInfoOffsetIsGuid | int32(e.kind)<<24)
+		seg.i32(impFile)
+		seg.i32(this.guid(e.guid))
+	}
+	this.imps[e] = o
+	return o | 1
+}
+
+func (this *msftWriter) typeDesc(d *typeDesc) int32 {
+	var data int32
+	switch d.vt {
+	case VT_PTR, VT_SAFEARRAY:
+		data = this.typeDesc(d.elem)
+	case VT_USERDEFINED:
+		data = this.href(d.ref)
+	case VT_CARRAY:
+		seg := &this.segs[msftSegArrayDesc]
+		data = int32(len(seg.b))
+		seg.i32(this.typeDesc(d.elem))
+		seg.u16(uint16(len(d.dims)))
+		seg.u16(uint16(d.elem.vt))
+		for _, n := range d.dims {
+			seg.i32(int32(n))
+			seg.i32(0)
+		}
+	default:
+		return int32(uint32(0x80000000) | uint32(d.vt)<<16 | uint32(d.vt))
+	}
+	seg := &this.segs[msftSegTypeDesc]
+	o := int32(len(seg.b))
+	seg.i32(int32(d.vt) | 0x7ffe<<16)
+	seg.i32(data)
+	return o
+}
+
+func (this *msftWriter) value(v interface{}) int32 {
+	if i, ok := v.(int32); ok && i >= 0 && i < 0x3ffffff {
+		return int32(uint32(0x80000000) | uint32(VT_I4)<<26 | uint32(i))
+	}
+	seg := &this.segs[msftSegCustData]
+	o := int32(len(seg.b))
+	switch v := v.(type) {
+	case int32:
+		seg.u16(uint16(VT_I4))
+		seg.i32(v)
+	case string:
+		seg.u16(uint16(VT_BSTR))
+		seg.i32(int32(len(v)))
+		seg.b = append(seg.b, v...)
+	default:
+		panic(v)
+	}
+	seg.pad()
+	return o
+}
+
+func (this *msftWriter) custData(list []*CustData) int32 {
+	next := int32(-1)
+	for n := len(list) - 1; n >= 0; n-- {
+		seg := &this.segs[msftSegCDGuids]
+		o := int32(len(seg.b))
+		seg.i32(this.guid(list[n].Guid))
+		seg.i32(this.value(list[n].Value))
+		seg.i32(next)
+		next = o
+	}
+	return next
+}
+
+// memberRecs writes the func and var records of an entry, returning
+// their offset in the members block
+func (this *msftWriter) memberRecs(e *typeEntry) int32 {
+	if len(e.funcs)+len(e.vars) == 0 {
+		return -1
+	}
+	var recs msftBuf
+	var offsets []int32
+	for _, f := range e.funcs {
+		offsets = append(offsets, int32(len(recs.b)))
+		hasDefault := false
+		hasCustData := len(f.custData) > 0
+		for _, p := range f.params {
+			hasDefault = hasDefault || p.flags&PARAMFLAG_FHASDEFAULT != 0
+			hasCustData = hasCustData || len(p.custData) > 0
+		}
+		fixedSize := 32
+		if f.dllEntry != "" {
+			fixedSize = 36
+		}
+		if hasCustData {
+			fixedSize = 52 + 4*len(f.params)
+		}
+		recSize := fixedSize + len(f.params)*12
+		if hasDefault {
+			recSize += len(f.params) * 4
+		}
+		recs.i32(int32(recSize))
+		recs.i32(this.typeDesc(f.ret))
+		recs.i32(int32(f.flags))
+		recs.u16(uint16(f.vtblIndex * 4))
+		recs.u16(0)
+		kind := int32(f.funcKind) | int32(f.invKind)<<3 | int32(f.callConv)<<8
+		if hasDefault {
+			kind |= 0x1000
+		}
+		if hasCustData {
+			kind |= 0x80
+		}
+		recs.i32(kind)
+		recs.u16(uint16(len(f.params)))
+		recs.u16(uint16(f.cParamsOpt))
+		recs.i32(0)
+		recs.i32(this.str(f.doc))
+		if fixedSize >= 36 {
+			recs.i32(this.str(f.dllEntry))
+		}
+		if hasCustData {
+			recs.i32(0)
+			recs.i32(0)
+			recs.i32(0)
+			recs.i32(this.custData(f.custData))
+			for _, p := range f.params {
+				recs.i32(this.custData(p.custData))
+			}
+		}
+		if hasDefault {
+			for _, p := range f.params {
+				if p.flags&PARAMFLAG_FHASDEFAULT != 0 {
+					recs.i32(this.value(p.value))
+				} else {
+					recs.i32(-1)
+				}
+			}
+		}
+		for _, p := range f.params {
+			recs.i32(this.typeDesc(p.typ))
+			recs.i32(this.name(p.name))
+			recs.i32(int32(p.flags))
+		}
+	}
+	for _, v := range e.vars {
+		offsets = append(offsets, int32(len(recs.b)))
+		recSize := int32(28)
+		if len(v.custData) > 0 {
+			recSize = 36
+		}
+		recs.i32(recSize)
+		recs.i32(this.typeDesc(v.typ))
+		recs.i32(int32(v.flags))
+		recs.u16(uint16(v.varKind))
+		recs.u16(0)
+		if v.varKind == VAR_CONST {
+			recs.i32(this.value(v.value))
+		} else {
+			recs.i32(int32(v.oInst))
+		}
+		recs.i32(0)
+		recs.i32(this.str(v.doc))
+		if recSize == 36 {
+			recs.i32(0)
+			recs.i32(this.custData(v.custData))
+		}
+	}
+	o := int32(len(this.members.b))
+	this.members.i32(int32(len(recs.b)))
+	this.members.b = append(this.members.b, recs.b...)
+	for _, f := range e.funcs {
+		this.members.i32(f.memid)
+	}
+	for _, v := range e.vars {
+		this.members.i32(v.memid)
+	}
+	for _, f := range e.funcs {
+		this.members.i32(this.name(f.name))
+	}
+	for _, v := range e.vars {
+		this.members.i32(this.name(v.name))
+	}
+	for _, offset := range offsets {
+		this.members.i32(offset)
+	}
+	return o
+}
+
+// writeMsft writes a typelib of the entries, with the name, guid,
+// sysKind and custom data of lib
+func writeMsft(lib *msftReader, entries []*typeEntry) []byte {
+	w := &msftWriter{
+		index:    make(map[*typeEntry]int),
+		names:    make(map[string]int32),
+		guids:    make(map[GUID]int32),
+		strs:     make(map[string]int32),
+		imps:     make(map[*typeEntry]int32),
+		impFiles: make(map[GUID]int32),
+	}
+	for n, e := range entries {
+		w.index[e] = n
+	}
+	var tiRecs [][]int32
+	for _, e := range entries {
+		r := make([]int32, msftTypeInfoSize/4)
+		r[0] = int32(e.kind) | int32(e.alignment)<<11
+		r[1] = w.memberRecs(e)
+		r[6] = int32(len(e.funcs)) | int32(len(e.vars))<<16
+		r[0x2c/4] = w.guid(e.guid)
+		r[0x30/4] = int32(e.flags)
+		r[0x34/4] = w.name(e.name)
+		r[0x38/4] = int32(e.verMajor) | int32(e.verMinor)<<16
+		r[0x3c/4] = w.str(e.doc)
+		r[0x48/4] = w.custData(e.custData)
+		r[0x4c/4] = int32(len(e.impls)) | int32(e.sizeVft)<<16
+		r[0x50/4] = int32(e.sizeInstance)
+		r[0x54/4] = -1
+		switch e.kind {
+		case TKIND_ALIAS:
+			r[0x54/4] = w.typeDesc(e.alias)
+		case TKIND_MODULE:
+			r[0x54/4] = w.str(e.dllName)
+		case TKIND_COCLASS:
+			seg := &w.segs[msftSegRefTab]
+			r[0x54/4] = int32(len(seg.b))
+			for n, impl := range e.impls {
+				seg.i32(w.href(impl.ref))
+				seg.i32(int32(impl.flags))
+				seg.i32(w.custData(impl.custData))
+				if n == len(e.impls)-1 {
+					seg.i32(-1)
+				} else {
+					seg.i32(int32(len(seg.b)) + 4)
+				}
+			}
+		default:
+			if len(e.impls) > 0 {
+				r[0x54/4] = w.href(e.impls[0].ref)
+			}
+		}
+		tiRecs = append(tiRecs, r)
+	}
+	nameOffset := w.name(lib.name)
+	guidOffset := w.guid(lib.attr.Guid)
+	for _, r := range tiRecs {
+		for _, v := range r {
+			w.segs[msftSegTypeInfo].i32(v)
+		}
+	}
+
+	var out msftBuf
+	header := make([]int32, msftHeaderSize/4)
+	header[0] = msftMagic
+	header[1] = 0x00010002
+	header[2] = guidOffset
+	header[3] = int32(lib.attr.Lcid)
+	header[4] = int32(lib.attr.Lcid)
+	header[5] = int32(lib.attr.SysKind)
+	header[6] = int32(lib.attr.MajorVer) | int32(lib.attr.MinorVer)<<16
+	header[7] = int32(lib.attr.Flags)
+	header[8] = int32(len(entries))
+	header[9] = w.str(lib.doc)
+	header[0x38/4] = nameOffset
+	header[0x3c/4] = -1
+	header[0x40/4] = w.custData(lib.custData)
+	header[0x4c/4] = -1
+	for _, v := range header {
+		out.i32(v)
+	}
+	for n := range entries {
+		out.i32(int32(n * msftTypeInfoSize))
+	}
+	dataStart := len(out.b) + msftSegCount*16
+	offset := dataStart
+	for n := range w.segs {
+		w.segs[n].pad()
+		size := len(w.segs[n].b)
+		if size == 0 {
+			out.i32(-1)
+		} else {
+			out.i32(int32(offset))
+		}
+		out.i32(int32(size))
+		out.i32(-1)
+		out.i32(0x0f)
+		offset += size
+	}
+	for n := range w.segs {
+		out.b = append(out.b, w.segs[n].b...)
+	}
+	membersStart := int32(len(out.b))
+	out.b = append(out.b, w.members.b...)
+	for n := range entries {
+		p := dataStart + n*msftTypeInfoSize + 4
+		if v := int32(binary.LittleEndian.Uint32(out.b[p:])); v != -1 {
+			binary.LittleEndian.PutUint32(out.b[p:], uint32(v+membersStart))
+		}
+	}
+	return out.b
+}
+
+var testLibId = mustParseGuid("00000000-1111-2222-3333-444444444444")
+
+func testLib(sysKind SYSKIND) *msftReader {
+	return &msftReader{
+		name: "TestLib",
+		doc:  "Test library",
+		attr: LibAttr{Guid: testLibId, Lcid: 0x409, SysKind: sysKind,
+			MajorVer: 1, MinorVer: 2, Flags: LIBFLAG_FHASDISKIMAGE},
+		custData: []*CustData{{Guid: mustParseGuid("00000009-1111-2222-3333-444444444444"),
+			Value: "lib data"}},
+	}
+}
+
+// testEntries are an enum, a struct, an alias of a stdole type, a dual
+// interface, its events and their coclass
+func testEntries() []*typeEntry {
+	in, out, retval, opt := PARAMFLAG_FIN, PARAMFLAG_FOUT, PARAMFLAG_FRETVAL, PARAMFLAG_FOPT
+
+	dir := &typeEntry{name: "XlDirection", kind: TKIND_ENUM, alignment: 4, sizeInstance: 4,
+		guid: mustParseGuid("00000001-1111-2222-3333-444444444444")}
+	dir.vars = []*varEntry{
+		{memid: -1, name: "xlDown", typ: vtDesc(VT_I4), varKind: VAR_CONST, value: int32(-4121)},
+		{memid: -1, name: "xlUp", typ: vtDesc(VT_I4), varKind: VAR_CONST, value: int32(-4162)},
+		{memid: -1, name: "xlToLeft", typ: vtDesc(VT_I4), varKind: VAR_CONST, value: int32(1)},
+	}
+	point := &typeEntry{name: "TestPoint", kind: TKIND_RECORD, alignment: 4, sizeInstance: 16,
+		guid: mustParseGuid("00000002-1111-2222-3333-444444444444")}
+	point.vars = []*varEntry{
+		{memid: 0x40000000, name: "x", typ: vtDesc(VT_I4), oInst: 0},
+		{memid: 0x40000001, name: "y", typ: vtDesc(VT_I4), oInst: 4},
+		{memid: 0x40000002, name: "tag", oInst: 8,
+			typ: &typeDesc{vt: VT_CARRAY, elem: vtDesc(VT_UI1), dims: []int{8}}},
+	}
+	color := &typeEntry{name: "TestColor", kind: TKIND_ALIAS, alias: refDesc(stdoleTypes[6])}
+
+	app := &typeEntry{name: "_Application", kind: TKIND_DISPATCH, doc: "app",
+		flags: TYPEFLAG_FDUAL | TYPEFLAG_FDISPATCHABLE | TYPEFLAG_FOLEAUTOMATION,
+		guid:  mustParseGuid("00000003-1111-2222-3333-444444444444"),
+		impls: []*implEntry{{ref: stdoleDispatch()}}, sizeVft: 7 * 4}
+	method := func(name string, memid int32, invKind INVOKEKIND, slot int, params ...*paramEntry) *funcEntry {
+		return &funcEntry{memid: memid, name: name, funcKind: FUNC_DISPATCH, invKind: invKind,
+			callConv: CC_STDCALL, vtblIndex: slot, ret: vtDesc(VT_HRESULT), params: params}
+	}
+	app.funcs = []*funcEntry{
+		method("Name", 0, INVOKE_PROPERTYGET, 7, stdParam("", ptrDesc(vtDesc(VT_BSTR)), out|retval)),
+		method("Visible", 1, INVOKE_PROPERTYGET, 8, stdParam("", ptrDesc(vtDesc(VT_BOOL)), out|retval)),
+		method("Visible", 1, INVOKE_PROPERTYPUT, 9, stdParam("", vtDesc(VT_BOOL), in)),
+		method("Move", 2, INVOKE_FUNC, 10,
+			stdParam("Direction", refDesc(dir), in),
+			stdParam("Count", vtDesc(VT_VARIANT), in|opt),
+			&paramEntry{name: "Steps", typ: vtDesc(VT_I4), flags: in | opt | PARAMFLAG_FHASDEFAULT,
+				value: int32(5)},
+			stdParam("Result", ptrDesc(vtDesc(VT_I4)), out|retval)),
+		method("Color", 3, INVOKE_PROPERTYGET, 11, stdParam("", ptrDesc(refDesc(color)), out|retval)),
+	}
+	app.funcs[3].custData = []*CustData{{Guid: mustParseGuid("0000000a-1111-2222-3333-444444444444"),
+		Value: int32(-7)}}
+
+	events := &typeEntry{name: "AppEvents", kind: TKIND_DISPATCH,
+		guid:  mustParseGuid("00000004-1111-2222-3333-444444444444"),
+		impls: []*implEntry{{ref: stdoleDispatch()}}}
+	events.funcs = []*funcEntry{
+		{memid: 1, name: "OnQuit", funcKind: FUNC_DISPATCH, invKind: INVOKE_FUNC,
+			callConv: CC_STDCALL, ret: vtDesc(VT_VOID)},
+	}
+	class := &typeEntry{name: "Application", kind: TKIND_COCLASS, flags: TYPEFLAG_FCANCREATE,
+		guid: mustParseGuid("00000005-1111-2222-3333-444444444444"),
+		impls: []*implEntry{{ref: app, flags: IMPLTYPEFLAG_FDEFAULT},
+			{ref: events, flags: IMPLTYPEFLAG_FDEFAULT | IMPLTYPEFLAG_FSOURCE}}}
+	return []*typeEntry{dir, point, color, app, events, class}
+}
+
+func readTestLib(t *testing.T, sysKind SYSKIND) *TypeLib {
+	lib, err := NewTypeLibFromBytes(writeMsft(testLib(sysKind), testEntries()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return lib
+}
+
+func TestMsftLibAttr(t *testing.T) {
+	lib := readTestLib(t, SYS_WIN32)
+	if lib.GetName() != "TestLib" || lib.GetDoc() != "Test library" {
+		t.Errorf("name %q, doc %q", lib.GetName(), lib.GetDoc())
+	}
+	want := LibAttr{Guid: testLibId, Lcid: 0x409, SysKind: SYS_WIN32,
+		MajorVer: 1, MinorVer: 2, Flags: LIBFLAG_FHASDISKIMAGE}
+	if attr := *lib.GetLibAttr(); attr != want {
+		t.Errorf("attr %+v, want %+v", attr, want)
+	}
+	custData := lib.GetCustData()
+	if len(custData) != 1 || custData[0].Value != "lib data" {
+		t.Errorf("custData %v", custData)
+	}
+	if lib.GetTypeInfoCount() != 6 {
+		t.Errorf("%d types", lib.GetTypeInfoCount())
+	}
+}
+
+func TestMsftTypes(t *testing.T) {
+	lib := readTestLib(t, SYS_WIN32)
+	tests := []struct {
+		index  int
+		name   string
+		kind   TYPEKIND
+		guid   string
+		fields []string //name type
+		funcs  []string //name ret(param types), of those not inherited
+	}{
+		{0, "XlDirection", TKIND_ENUM, "00000001-1111-2222-3333-444444444444",
+			[]string{"xlDown int32", "xlUp int32", "xlToLeft int32"}, nil},
+		{1, "TestPoint", TKIND_RECORD, "00000002-1111-2222-3333-444444444444",
+			[]string{"x int32", "y int32", "tag [8]byte"}, nil},
+		{2, "TestColor", TKIND_ALIAS, "00000000-0000-0000-0000-000000000000", nil, nil},
+		{3, "_Application", TKIND_DISPATCH, "00000003-1111-2222-3333-444444444444", nil,
+			[]string{"Name win32.BSTR()", "Visible win32.VARIANT_BOOL()",
+				"Visible (win32.VARIANT_BOOL)", "Move int32(XlDirection,win32.VARIANT,int32)",
+				"Color uint32()"}},
+		{4, "AppEvents", TKIND_DISPATCH, "00000004-1111-2222-3333-444444444444", nil,
+			[]string{"OnQuit ()"}},
+		{5, "Application", TKIND_COCLASS, "00000005-1111-2222-3333-444444444444", nil, nil},
+	}
+	for _, test := range tests {
+		ti, err := lib.GetTypeInfo(test.index)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if ti.Name != test.name || ti.Kind != test.kind || ti.Guid.String() != test.guid {
+			t.Errorf("type %d: %s %v %s, want %s %v %s", test.index,
+				ti.Name, ti.Kind, ti.Guid, test.name, test.kind, test.guid)
+		}
+		var fields, funcs []string
+		for _, f := range ti.Fields {
+			fields = append(fields, f.Name+" "+f.Type.Name)
+		}
+		for _, f := range ti.Funcs {
+			if f.Id >= 0x60000000 {
+				continue
+			}
+			var params []string
+			for _, p := range f.Params {
+				params = append(params, p.Type.Name)
+			}
+			funcs = append(funcs, f.Name+" "+f.ReturnType.Name+"("+strings.Join(params, ",")+")")
+		}
+		if !reflect.DeepEqual(fields, test.fields) {
+			t.Errorf("%s fields %q, want %q", test.name, fields, test.fields)
+		}
+		if !reflect.DeepEqual(funcs, test.funcs) {
+			t.Errorf("%s funcs %q, want %q", test.name, funcs, test.funcs)
+		}
+	}
+}
+
+func TestMsftMembers(t *testing.T) {
+	lib := readTestLib(t, SYS_WIN32)
+
+	dir, _ := lib.GetTypeInfo(0)
+	if v := dir.Fields[0].Value; v != int32(-4121) {
+		t.Errorf("xlDown = %v", v)
+	}
+	color, _ := lib.GetTypeInfo(2)
+	if color.RelType == nil || color.RelType.Name != "uint32" {
+		t.Errorf("TestColor aliases %v", color.RelType)
+	}
+
+	app, _ := lib.GetTypeInfo(3)
+	if app.Doc != "app" || app.DualInterface == nil || app.DualInterface.Kind != TKIND_INTERFACE {
+		t.Errorf("_Application doc %q, dual %v", app.Doc, app.DualInterface)
+	}
+	move := app.Funcs[10]
+	if move.Name != "Move" || len(move.Params) != 3 {
+		t.Fatalf("%s, %d params", move.Name, len(move.Params))
+	}
+	steps := move.Params[2]
+	if !steps.Flags.Optional || !steps.Flags.HasDefault || steps.DefaultValue != int32(5) {
+		t.Errorf("Steps flags %+v, default %v", steps.Flags, steps.DefaultValue)
+	}
+	if len(move.CustData) != 1 || move.CustData[0].Value != int32(-7) {
+		t.Errorf("Move custData %v", move.CustData)
+	}
+
+	class, _ := lib.GetTypeInfo(5)
+	if len(class.ImplTypes) != 2 {
+		t.Fatalf("%d impl types", len(class.ImplTypes))
+	}
+	if it := class.ImplTypes[1]; it.Name != "AppEvents" || !it.Source || !it.Default {
+		t.Errorf("impl type %+v", it)
+	}
+}
+
+func TestMsftErrors(t *testing.T) {
+	data := writeMsft(testLib(SYS_WIN32), testEntries())
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"magic", append([]byte("XXXX"), data[4:]...)},
+		{"header", data[:msftHeaderSize-4]},
+		{"segments", data[:msftHeaderSize+6*4+40]},
+	}
+	for _, test := range tests {
+		if _, err := NewTypeLibFromBytes(test.data); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
+
+// funcDescEntries returns an interface whose funcs are listed out of
+// slot order with a gap, and a module of static funcs
+func funcDescEntries() []*typeEntry {
+	in, out, retval, lcid := PARAMFLAG_FIN, PARAMFLAG_FOUT, PARAMFLAG_FRETVAL, PARAMFLAG_FLCID
+	gap := &typeEntry{name: "IGap", kind: TKIND_INTERFACE, sizeVft: 7 * 4,
+		guid:  mustParseGuid("00000011-1111-2222-3333-444444444444"),
+		impls: []*implEntry{{ref: stdoleTypes[3]}}}
+	gap.funcs = []*funcEntry{
+		{memid: 0x60010002, name: "Last", funcKind: FUNC_PUREVIRTUAL, invKind: INVOKE_FUNC,
+			callConv: CC_STDCALL, vtblIndex: 6, ret: vtDesc(VT_HRESULT)},
+		{memid: 0x60010000, name: "First", funcKind: FUNC_PUREVIRTUAL, invKind: INVOKE_FUNC,
+			callConv: CC_STDCALL, vtblIndex: 3, ret: vtDesc(VT_HRESULT), params: []*paramEntry{
+				stdParam("a", vtDesc(VT_I4), in),
+				stdParam("lcid", vtDesc(VT_I4), in|lcid),
+				stdParam("r", ptrDesc(vtDesc(VT_I4)), out|retval)}},
+		{memid: 0x60010001, name: "Format", funcKind: FUNC_PUREVIRTUAL, invKind: INVOKE_FUNC,
+			callConv: CC_CDECL, vtblIndex: 5, cParamsOpt: -1, ret: vtDesc(VT_I4), params: []*paramEntry{
+				stdParam("fmt", vtDesc(VT_BSTR), in),
+				stdParam("args", ptrDesc(vtDesc(VT_VARIANT)), in)}},
+	}
+	api := &typeEntry{name: "GapApi", kind: TKIND_MODULE,
+		guid: mustParseGuid("00000012-1111-2222-3333-444444444444")}
+	api.funcs = []*funcEntry{
+		{memid: 0x60000000, name: "GapInit", funcKind: FUNC_STATIC, invKind: INVOKE_FUNC,
+			callConv: CC_STDCALL, ret: vtDesc(VT_HRESULT), dllEntry: "GapInit",
+			params: []*paramEntry{stdParam("flags", vtDesc(VT_UI4), in)}},
+	}
+	return []*typeEntry{gap, api}
+}
+
+func TestMsftFuncDesc(t *testing.T) {
+	lib, err := NewTypeLibFromBytes(writeMsft(testLib(SYS_WIN32), funcDescEntries()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	gap, _ := lib.GetTypeInfo(0)
+	api, _ := lib.GetTypeInfo(1)
+	tests := []struct {
+		f         *FuncInfo
+		id        MEMBERID
+		kind      FUNCKIND
+		callConv  CALLCONV
+		slot      int
+		paramsOpt int
+		lcid      []bool
+	}{
+		{gap.Funcs[0], 0x60010002, FUNC_PUREVIRTUAL, CC_STDCALL, 6, 0, nil},
+		{gap.Funcs[1], 0x60010000, FUNC_PUREVIRTUAL, CC_STDCALL, 3, 0, []bool{false, true, false}},
+		{gap.Funcs[2], 0x60010001, FUNC_PUREVIRTUAL, CC_CDECL, 5, -1, []bool{false, false}},
+		{api.Funcs[0], 0x60000000, FUNC_STATIC, CC_STDCALL, 0, 0, []bool{false}},
+	}
+	for _, test := range tests {
+		f := test.f
+		var lcid []bool
+		for _, p := range f.Params {
+			lcid = append(lcid, p.Flags.Lcid)
+		}
+		if f.Id != test.id || f.Kind != test.kind || f.CallConv != test.callConv ||
+			f.VtblOffset != test.slot*utils.PtrSize || f.ParamsOpt != test.paramsOpt ||
+			!reflect.DeepEqual(lcid, test.lcid) {
+			t.Errorf("%s: id %x, kind %v, callConv %v, vtbl offset %d, paramsOpt %d, lcid %v",
+				f.Name, f.Id, f.Kind, f.CallConv, f.VtblOffset, f.ParamsOpt, lcid)
+		}
+	}
+	if !gap.Funcs[2].Flags.Vararg || api.Funcs[0].DllEntry != "GapInit" {
+		t.Errorf("Format flags %+v, GapInit entry %q", gap.Funcs[2].Flags, api.Funcs[0].DllEntry)
+	}
+}
+
+// layoutEntries are a packed struct, one with gaps, a union whose size
+// rounds up to its alignment and a struct of a SAFEARRAY, as laid out
+// by MIDL for win32
+func layoutEntries() []*typeEntry {
+	packed := &typeEntry{name: "Packed", kind: TKIND_RECORD, alignment: 1, sizeInstance: 5,
+		guid: mustParseGuid("00000021-1111-2222-3333-444444444444")}
+	packed.vars = []*varEntry{
+		{memid: 0x40000000, name: "a", typ: vtDesc(VT_UI1), oInst: 0},
+		{memid: 0x40000001, name: "b", typ: vtDesc(VT_I4), oInst: 1},
+	}
+	gap := &typeEntry{name: "Gap", kind: TKIND_RECORD, alignment: 4, sizeInstance: 16,
+		guid: mustParseGuid("00000022-1111-2222-3333-444444444444")}
+	gap.vars = []*varEntry{
+		{memid: 0x40000000, name: "a", typ: vtDesc(VT_I4), oInst: 0},
+		{memid: 0x40000001, name: "b", typ: vtDesc(VT_I4), oInst: 8},
+	}
+	union := &typeEntry{name: "Union", kind: TKIND_UNION, alignment: 4, sizeInstance: 8,
+		guid: mustParseGuid("00000023-1111-2222-3333-444444444444")}
+	union.vars = []*varEntry{
+		{memid: 0x40000000, name: "c", typ: &typeDesc{vt: VT_CARRAY, elem: vtDesc(VT_UI1), dims: []int{5}}},
+		{memid: 0x40000001, name: "l", typ: vtDesc(VT_I4)},
+	}
+	list := &typeEntry{name: "List", kind: TKIND_RECORD, alignment: 4, sizeInstance: 8,
+		guid: mustParseGuid("00000024-1111-2222-3333-444444444444")}
+	list.vars = []*varEntry{
+		{memid: 0x40000000, name: "items", typ: &typeDesc{vt: VT_SAFEARRAY, elem: vtDesc(VT_I4)}, oInst: 0},
+		{memid: 0x40000001, name: "count", typ: vtDesc(VT_I4), oInst: 4},
+	}
+	return []*typeEntry{packed, gap, union, list}
+}
+
+func TestMsftLayout(t *testing.T) {
+	lib, err := NewTypeLibFromBytes(writeMsft(testLib(SYS_WIN32), layoutEntries()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ptr := utils.PtrSize
+	tests := []struct {
+		name         string
+		size, align  int
+		sizeInstance int
+		alignment    int
+		offsets      []int
+	}{
+		{"Packed", 8, 4, 5, 1, []int{0, 1}},
+		{"Gap", 8, 4, 16, 4, []int{0, 8}},
+		{"Union", 8, 4, 8, 4, []int{0, 0}},
+		{"List", 2 * ptr, ptr, 8, 4, []int{0, 4}},
+	}
+	for n, test := range tests {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var offsets []int
+		for _, f := range ti.Fields {
+			offsets = append(offsets, f.Offset)
+		}
+		if ti.Name != test.name || ti.Size != test.size || ti.Align != test.align ||
+			ti.SizeInstance != test.sizeInstance || ti.Alignment != test.alignment ||
+			!reflect.DeepEqual(offsets, test.offsets) {
+			t.Errorf("%s: size %d align %d, typelib size %d align %d, offsets %v",
+				ti.Name, ti.Size, ti.Align, ti.SizeInstance, ti.Alignment, offsets)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package typelib
+
+import (
+	"bytes"
+	"debug/pe"
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Typelibs embedded in PE images (.dll, .exe, .ocx) as TYPELIB resources.
+// The resource directory is walked without the OS loader.
+
+const peResTypeLib = "TYPELIB"
+
+type TypeLibResource struct {
+	Id   int    //0 if the resource is named
+	Name string //for named resources
+	Lang int
+	Data []byte
+}
+
+// String formats the resource the way it is selected on the command line
+func (this *TypeLibResource) String() string {
+	if this.Name != "" {
+		return this.Name
+	}
+	return strconv.Itoa(this.Id)
+}
+
+func isPE(data []byte) bool {
+	return len(data) >= 2 && data[0] == 'M' && data[1] == 'Z'
+}
+
+// SplitResourcePath splits "server.dll\2" into the file path and the
+// resource, like LoadTypeLib does. The resource is an id, or the name
+// of a named one when the file path exists, "" if there is none.
+func SplitResourcePath(filePath string) (string, string) {
+	if _, err := os.Stat(filePath); err == nil {
+		return filePath, ""
+	}
+	pos := strings.LastIndexAny(filePath, "\\/")
+	if pos == -1 || pos == len(filePath)-1 {
+		return filePath, ""
+	}
+	res := filePath[pos+1:]
+	if id, err := strconv.Atoi(res); err == nil {
+		if id <= 0 {
+			return filePath, ""
+		}
+	} else if fi, err := os.Stat(filePath[:pos]); err != nil || fi.IsDir() {
+		return filePath, ""
+	}
+	return filePath[:pos], res
+}
+
+// ListTypeLibResources returns the TYPELIB resources of a PE image.
+func ListTypeLibResources(data []byte) ([]*TypeLibResource, error) {
+	f, err := pe.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var dir pe.DataDirectory
+	switch oh := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		if oh.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_RESOURCE {
+			dir = oh.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_RESOURCE]
+		}
+	case *pe.OptionalHeader64:
+		if oh.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_RESOURCE {
+			dir = oh.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_RESOURCE]
+		}
+	}
+	if dir.VirtualAddress == 0 {
+		return nil, nil
+	}
+
+	var sec *pe.Section
+	for _, s := range f.Sections {
+		if dir.VirtualAddress >= s.VirtualAddress &&
+			dir.VirtualAddress < s.VirtualAddress+s.Size {
+			sec = s
+			break
+		}
+	}
+	if sec == nil {
+		return nil, errors.New("resource directory not found")
+	}
+	secData, err := sec.Data()
+	if err != nil {
+		return nil, err
+	}
+	r := &peResReader{
+		dataReader: dataReader{data: secData},
+		base:       int(dir.VirtualAddress - sec.VirtualAddress),
+		secRva:     sec.VirtualAddress,
+	}
+
+	var resList []*TypeLibResource
+	for _, typeEntry := range r.entries(0) {
+		if typeEntry.name != peResTypeLib || !typeEntry.isDir {
+			continue
+		}
+		for _, idEntry := range r.entries(typeEntry.offset) {
+			if !idEntry.isDir {
+				continue
+			}
+			for _, langEntry := range r.entries(idEntry.offset) {
+				if langEntry.isDir {
+					continue
+				}
+				res := &TypeLibResource{
+					Id:   idEntry.id,
+					Name: idEntry.name,
+					Lang: langEntry.id,
+					Data: r.resData(langEntry.offset),
+				}
+				if res.Data != nil {
+					resList = append(resList, res)
+				}
+				break
+			}
+		}
+	}
+	if r.bad {
+		return nil, errors.New("invalid resource directory")
+	}
+	return resList, nil
+}
+
+// ExtractTypeLibResource returns the data of the TYPELIB resource res,
+// as formatted by TypeLibResource.String, names matched regardless of
+// case. As for LoadTypeLib, it is resource 1 if res is "".
+func ExtractTypeLibResource(data []byte, res string) ([]byte, error) {
+	if res == "" {
+		res = "1"
+	}
+	resList, err := ListTypeLibResources(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resList {
+		if strings.EqualFold(r.String(), res) {
+			return r.Data, nil
+		}
+	}
+	if len(resList) == 0 {
+		return nil, errors.New("no TYPELIB resource found")
+	}
+	return nil, errors.New("TYPELIB resource " + res + " not found")
+}
+
+type peResEntry struct {
+	id     int
+	name   string
+	isDir  bool
+	offset int
+}
+
+type peResReader struct {
+	dataReader
+	base   int //offset of the resource directory in the section
+	secRva uint32
+}
+
+func (this *peResReader) entries(off int) []*peResEntry {
+	off += this.base
+	count := int(this.u16(off+12)) + int(this.u16(off+14))
+	var entries []*peResEntry
+	for n := 0; n < count && !this.bad; n++ {
+		entryOff := off + 16 + n*8
+		name := uint32(this.i32(entryOff))
+		target := uint32(this.i32(entryOff + 4))
+		e := &peResEntry{
+			isDir:  target&0x80000000 != 0,
+			offset: int(target &^ 0x80000000),
+		}
+		if name&0x80000000 != 0 {
+			e.name = this.resName(int(name &^ 0x80000000))
+		} else {
+			e.id = int(name)
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func (this *peResReader) resName(off int) string {
+	off += this.base
+	size := int(this.u16(off))
+	b := this.bytes(off+2, size*2)
+	if b == nil {
+		return ""
+	}
+	return utf16ToStr(b)
+}
+
+func (this *peResReader) resData(off int) []byte {
+	off += this.base
+	rva := uint32(this.i32(off))
+	size := int(this.i32(off + 4))
+	if rva < this.secRva {
+		this.bad = true
+		return nil
+	}
+	return this.bytes(int(rva-this.secRva), size)
+}
@@ -0,0 +1,254 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// buildPE builds a 32 bit PE image whose .rsrc section has the given
+// TYPELIB resources, the named ones first as resource directories have
+func buildPE(resList []*TypeLibResource) []byte {
+	le := binary.LittleEndian
+	put16 := func(b []byte, v int) []byte { return le.AppendUint16(b, uint16(v)) }
+	put32 := func(b []byte, v int) []byte { return le.AppendUint32(b, uint32(v)) }
+	//the high bit marks offsets of names and subdirectories
+	putHigh := func(b []byte, off int) []byte { return le.AppendUint32(b, 0x80000000|uint32(off)) }
+	dir := func(b []byte, nNamed, nId int) []byte {
+		b = append(b, make([]byte, 12)...)
+		b = put16(b, nNamed)
+		return put16(b, nId)
+	}
+	putName := func(b []byte, name string) []byte {
+		b = put16(b, len(name))
+		for _, c := range name {
+			b = put16(b, int(c))
+		}
+		return b
+	}
+
+	//root dir | TYPELIB dir | lang dir per resource | data entries | names | data
+	n, nNamed := len(resList), 0
+	for _, res := range resList {
+		if res.Name != "" {
+			nNamed++
+		}
+	}
+	tlDirOff := 16 + 8
+	langOff := tlDirOff + 16 + 8*n
+	dataEntOff := langOff + 24*n
+	nameOff := dataEntOff + 16*n
+	names := putName(nil, peResTypeLib)
+	nameOffs := make([]int, n)
+	for i, res := range resList {
+		if res.Name != "" {
+			nameOffs[i] = nameOff + len(names)
+			names = putName(names, res.Name)
+		}
+	}
+	dataOff := (nameOff + len(names) + 7) &^ 7
+
+	var rs []byte
+	rs = dir(rs, 1, 0)
+	rs = putHigh(rs, nameOff)
+	rs = putHigh(rs, tlDirOff)
+	rs = dir(rs, nNamed, n-nNamed)
+	for i, res := range resList {
+		if res.Name != "" {
+			rs = putHigh(rs, nameOffs[i])
+		} else {
+			rs = put32(rs, res.Id)
+		}
+		rs = putHigh(rs, langOff+24*i)
+	}
+	for i := range resList {
+		rs = dir(rs, 0, 1)
+		rs = put32(rs, 0x409)
+		rs = put32(rs, dataEntOff+16*i)
+	}
+	cur := dataOff
+	for _, res := range resList {
+		rs = put32(rs, peSecRva+cur)
+		rs = put32(rs, len(res.Data))
+		rs = put32(rs, 0)
+		rs = put32(rs, 0)
+		cur += (len(res.Data) + 7) &^ 7
+	}
+	rs = append(rs, names...)
+	for len(rs) < dataOff {
+		rs = append(rs, 0)
+	}
+	for _, res := range resList {
+		rs = append(rs, res.Data...)
+		for len(rs)%8 != 0 {
+			rs = append(rs, 0)
+		}
+	}
+	return peImage(".rsrc", 2, rs, len(rs))
+}
+
+const peSecRva = 0x1000
+
+// peImage builds a 32 bit PE image of one section at peSecRva, with the
+// data directory entry dir on the first dirSize bytes of the section
+func peImage(secName string, dir int, section []byte, dirSize int) []byte {
+	le := binary.LittleEndian
+	put16 := func(b []byte, v int) []byte { return le.AppendUint16(b, uint16(v)) }
+	put32 := func(b []byte, v int) []byte { return le.AppendUint32(b, uint32(v)) }
+	size := (len(section) + 0x1ff) &^ 0x1ff
+
+	var b []byte
+	b = append(b, 'M', 'Z')
+	b = append(b, make([]byte, 0x3a)...)
+	b = put32(b, 0x40)
+	b = append(b, 'P', 'E', 0, 0)
+	b = put16(b, 0x14c) //i386
+	b = put16(b, 1)
+	b = append(b, make([]byte, 12)...)
+	b = put16(b, 224)
+	b = put16(b, 0x2102)
+	oh := make([]byte, 224)
+	le.PutUint16(oh[0:], 0x10b)
+	le.PutUint32(oh[28:], 0x400000)
+	le.PutUint32(oh[32:], 0x1000)
+	le.PutUint32(oh[36:], 0x200)
+	le.PutUint32(oh[56:], uint32(peSecRva+size))
+	le.PutUint32(oh[60:], 0x200)
+	le.PutUint32(oh[92:], 16)
+	le.PutUint32(oh[96+dir*8:], peSecRva)
+	le.PutUint32(oh[96+dir*8+4:], uint32(dirSize))
+	b = append(b, oh...)
+	sh := make([]byte, 40)
+	copy(sh, secName)
+	le.PutUint32(sh[8:], uint32(size))
+	le.PutUint32(sh[12:], peSecRva)
+	le.PutUint32(sh[16:], uint32(size))
+	le.PutUint32(sh[20:], 0x200)
+	le.PutUint32(sh[36:], 0x40000040)
+	b = append(b, sh...)
+	for len(b) < 0x200 {
+		b = append(b, 0)
+	}
+	b = append(b, section...)
+	return append(b, make([]byte, size-len(section))...)
+}
+
+func testPE() []byte {
+	return buildPE([]*TypeLibResource{
+		{Id: 1, Data: writeMsft(testLib(SYS_WIN32), testEntries())},
+		{Id: 3, Data: buildSltg()},
+	})
+}
+
+// testNamedPE has a named resource before the one of id 3, and no 1
+func testNamedPE() []byte {
+	return buildPE([]*TypeLibResource{
+		{Name: "EXTRA", Data: buildSltg()},
+		{Id: 3, Data: writeMsft(testLib(SYS_WIN32), testEntries())},
+	})
+}
+
+func TestListTypeLibResources(t *testing.T) {
+	resList, err := ListTypeLibResources(testPE())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resList) != 2 {
+		t.Fatalf("%d resources", len(resList))
+	}
+	for n, id := range []int{1, 3} {
+		res := resList[n]
+		if res.Id != id || res.Name != "" || res.Lang != 0x409 || res.String() != string(rune('0'+id)) {
+			t.Errorf("resource %d: %+v", n, res)
+		}
+	}
+	resList, err = ListTypeLibResources(testNamedPE())
+	if err != nil || len(resList) != 2 {
+		t.Fatalf("%d resources, %v", len(resList), err)
+	}
+	if res := resList[0]; res.Id != 0 || res.Name != "EXTRA" || res.String() != "EXTRA" {
+		t.Errorf("named resource: %+v", res)
+	}
+	if _, err := ListTypeLibResources([]byte("MZ")); err == nil {
+		t.Error("no error for a truncated image")
+	}
+}
+
+func TestExtractTypeLibResource(t *testing.T) {
+	tests := []struct {
+		data    []byte
+		res     string
+		libName string
+	}{
+		{testPE(), "", "TestLib"},
+		{testPE(), "1", "TestLib"},
+		{testPE(), "3", "SltgLib"},
+		{testPE(), "2", ""},
+		//resource 1 if none, as for LoadTypeLib, not the first one
+		{testNamedPE(), "", ""},
+		{testNamedPE(), "3", "TestLib"},
+		{testNamedPE(), "EXTRA", "SltgLib"},
+		{testNamedPE(), "extra", "SltgLib"},
+		{testNamedPE(), "OTHER", ""},
+	}
+	for n, test := range tests {
+		tlb, err := ExtractTypeLibResource(test.data, test.res)
+		if test.libName == "" {
+			if err == nil {
+				t.Errorf("%d %q: no error", n, test.res)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d %q: %v", n, test.res, err)
+			continue
+		}
+		lib, err := NewTypeLibFromBytes(tlb)
+		if err != nil {
+			t.Errorf("%d %q: %v", n, test.res, err)
+		} else if lib.GetName() != test.libName {
+			t.Errorf("%d %q: %s, want %s", n, test.res, lib.GetName(), test.libName)
+		}
+	}
+}
+
+func TestReadTypeLibFileResource(t *testing.T) {
+	dir := t.TempDir()
+	dllPath := filepath.Join(dir, "server.dll")
+	if err := os.WriteFile(dllPath, testNamedPE(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		path     string
+		filePath string
+		res      string
+		libName  string
+	}{
+		{dllPath, dllPath, "", ""},
+		{dllPath + "\\3", dllPath, "3", "TestLib"},
+		{dllPath + "/3", dllPath, "3", "TestLib"},
+		{dllPath + "\\EXTRA", dllPath, "EXTRA", "SltgLib"},
+		{dllPath + "\\2", dllPath, "2", ""},
+		{dllPath + "\\0", dllPath + "\\0", "", ""},
+		//a name is only taken after a file
+		{filepath.Join(dir, "missing.dll") + "\\EXTRA", filepath.Join(dir, "missing.dll") + "\\EXTRA", "", ""},
+		{dir + "\\EXTRA", dir + "\\EXTRA", "", ""},
+	}
+	for _, test := range tests {
+		filePath, res := SplitResourcePath(test.path)
+		if filePath != test.filePath || res != test.res {
+			t.Errorf("%s: split to %s %q", test.path, filePath, res)
+		}
+		lib, err := ReadTypeLibFile(test.path)
+		if test.libName == "" {
+			if err == nil {
+				t.Errorf("%s: no error", test.path)
+			}
+		} else if err != nil {
+			t.Errorf("%s: %v", test.path, err)
+		} else if lib.GetName() != test.libName {
+			t.Errorf("%s: %s, want %s", test.path, lib.GetName(), test.libName)
+		}
+	}
+}
@@ -0,0 +1,644 @@
+package typelib
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Reader for the SLTG typelib format, written by the 16 bit era OLE2
+// tools and still shipped with some ActiveX controls. The layout follows
+// what is known from wine's typelib.c.
+
+const sltgMagic = 0x47544c53 //"SLTG"
+
+const (
+	sltgHeaderSize    = 0x24
+	sltgBlkEntrySize  = 8
+	sltgMagicSize     = 13
+	sltgIndexSize     = 11
+	sltgPad9Size      = 9
+	sltgMemHeaderSize = 9
+)
+
+const (
+	sltgLibBlkMagic   = 0x51cc
+	sltgTiHeaderMagic = 0x0501
+	sltgImplMagic     = 0x004a
+	sltgRefMagic      = 0xdf
+
+	sltgFuncMagic         = 0x4c
+	sltgDispFuncMagic     = 0xcb
+	sltgStaticFuncMagic   = 0x8b
+	sltgFuncFlagsPresent  = 0x20
+	sltgVarMagic          = 0x0a
+	sltgVarWithFlagsMagic = 0x2a
+)
+
+var errSltgFormat = errors.New("invalid or truncated SLTG typelib")
+
+type sltgOtherTypeInfo struct {
+	indexName string
+	nameOffs  int
+	doc       string
+	guid      GUID
+}
+
+type sltgReader struct {
+	dataReader
+
+	ptrSize   int
+	nameTable int
+
+	name        string
+	doc         string
+	helpFile    string
+	helpContext uint32
+	attr        LibAttr
+	custData    []*CustData //none, the format predates [custom]
+	entries     []*typeEntry
+
+	imports map[int]*ImpLib
+}
+
+func isSltg(data []byte) bool {
+	return len(data) >= 4 && uint32(data[0])|uint32(data[1])<<8|
+		uint32(data[2])<<16|uint32(data[3])<<24 == sltgMagic
+}
+
+func readSltg(data []byte) (*sltgReader, error) {
+	r := &sltgReader{
+		dataReader: dataReader{data: data},
+		imports:    make(map[int]*ImpLib),
+	}
+	if !isSltg(data) || len(data) < sltgHeaderSize {
+		return nil, errSltgFormat
+	}
+	r.read()
+	if r.bad {
+		return nil, errSltgFormat
+	}
+	return r, nil
+}
+
+// cstr reads a nul terminated string
+func (this *sltgReader) cstr(off int) string {
+	if off < 0 || off >= len(this.data) {
+		this.bad = true
+		return ""
+	}
+	end := off
+	for end < len(this.data) && this.data[end] != 0 {
+		end++
+	}
+	return ansiToStr(this.data[off:end])
+}
+
+// str reads a string prefixed with its byte length, 0xffff for none.
+// it returns the string and the number of bytes consumed.
+func (this *sltgReader) str(off int) (string, int) {
+	size := int(this.u16(off))
+	if size == 0xffff {
+		return "", 2
+	}
+	return ansiToStr(this.bytes(off+2, size)), size + 2
+}
+
+func (this *sltgReader) nameAt(off int) string {
+	return this.cstr(this.nameTable + off)
+}
+
+func (this *sltgReader) read() {
+	blkCount := int(this.u16(4)) - 1
+	firstBlk := int(this.u16(0x0a)) - 1
+	if blkCount < 1 || firstBlk < 0 || firstBlk >= blkCount {
+		this.bad = true
+		return
+	}
+	magicOffset := sltgHeaderSize + blkCount*sltgBlkEntrySize
+	if string(this.bytes(magicOffset+1, 7)) != "CompObj" {
+		this.bad = true
+		return
+	}
+	blkOffset := magicOffset + sltgMagicSize + blkCount*sltgIndexSize + sltgPad9Size
+
+	//blocks are laid out in the order of their chain, the library block last
+	type block struct {
+		offset    int
+		indexName string
+	}
+	var blocks []block
+	for n, order := 0, firstBlk; n < blkCount; n++ {
+		entry := sltgHeaderSize + order*sltgBlkEntrySize
+		size := int(this.i32(entry))
+		indexName := this.cstr(magicOffset + int(this.u16(entry+4)))
+		blocks = append(blocks, block{blkOffset, indexName})
+		blkOffset += size
+		next := int(this.u16(entry + 6))
+		if next == 0 || this.bad {
+			break
+		}
+		order = next - 1
+		if order >= blkCount {
+			this.bad = true
+			return
+		}
+	}
+	if this.bad || len(blocks) == 0 {
+		this.bad = true
+		return
+	}
+
+	libBlk := blocks[len(blocks)-1].offset
+	typeCount := len(blocks) - 1
+	otis := this.readLibBlk(libBlk, typeCount)
+	if this.bad {
+		return
+	}
+	this.name = this.nameAt(int(this.u16(libBlk + 4)))
+
+	otiMap := make(map[string]*sltgOtherTypeInfo)
+	for _, oti := range otis {
+		otiMap[oti.indexName] = oti
+	}
+
+	this.entries = make([]*typeEntry, typeCount)
+	for n := range this.entries {
+		this.entries[n] = &typeEntry{}
+	}
+	for n, e := range this.entries {
+		oti := otiMap[blocks[n].indexName]
+		if oti == nil {
+			oti = otis[n]
+		}
+		e.name = this.nameAt(oti.nameOffs)
+		e.doc = oti.doc
+		e.guid = oti.guid
+		this.readTypeInfo(blocks[n].offset, e)
+		if this.bad {
+			return
+		}
+	}
+}
+
+func (this *sltgReader) readLibBlk(off int, typeCount int) []*sltgOtherTypeInfo {
+	if this.u16(off) != sltgLibBlkMagic {
+		this.bad = true
+		return nil
+	}
+	ptr := off + 6
+	if w := int(this.u16(ptr)); w != 0xffff {
+		ptr += w
+	}
+	ptr += 2
+	var size int
+	this.doc, size = this.str(ptr)
+	ptr += size
+	this.helpFile, size = this.str(ptr)
+	ptr += size
+	this.helpContext = uint32(this.i32(ptr))
+	ptr += 4
+
+	this.attr.SysKind = SYSKIND(this.u16(ptr))
+	switch this.attr.SysKind {
+	case SYS_WIN16:
+		this.ptrSize = 2
+	case SYS_WIN64:
+		this.ptrSize = 8
+	default:
+		this.ptrSize = 4
+	}
+	this.attr.Lcid = uint32(this.u16(ptr + 2))
+	this.attr.Flags = LIBFLAGS(this.u16(ptr + 8))
+	this.attr.MajorVer = this.u16(ptr + 10)
+	this.attr.MinorVer = this.u16(ptr + 12)
+	this.attr.Guid = this.guidAt(ptr + 14)
+	ptr += 30
+
+	//0x40 bytes of 0xffff with small numbers interspersed
+	ptr += 0x40
+
+	otis := make([]*sltgOtherTypeInfo, typeCount)
+	for n := range otis {
+		oti := &sltgOtherTypeInfo{}
+		ptr += 2
+		oti.indexName, size = this.str(ptr)
+		ptr += size
+		_, size = this.str(ptr) //other name
+		ptr += size
+		ptr += 2
+		oti.nameOffs = int(this.u16(ptr))
+		extra := int(this.u16(ptr + 2))
+		ptr += 4
+		if extra >= 2 {
+			oti.doc, _ = this.str(ptr)
+		}
+		ptr += extra
+		ptr += 8 //res20, help context, res26
+		oti.guid = this.guidAt(ptr)
+		ptr += 16 + 2 //guid, typekind
+		if this.bad {
+			return nil
+		}
+		otis[n] = oti
+	}
+
+	//a word, then the offset of the name table relative to the lib block
+	nameTable := off + int(this.i32(ptr+2))
+	if this.u16(nameTable) == 0x0200 {
+		nameTable += 0x20
+	}
+	this.nameTable = nameTable + 0x216 + 2
+	return otis
+}
+
+func (this *sltgReader) readTypeInfo(off int, e *typeEntry) {
+	if this.u16(off) != sltgTiHeaderMagic {
+		this.bad = true
+		return
+	}
+	hrefTable := this.i32(off + 2)
+	elemTable := int(this.i32(off + 0x0a))
+	e.verMajor = this.u16(off + 0x12)
+	e.verMinor = this.u16(off + 0x14)
+	e.flags = TYPEFLAGS(int(this.u8(off+0x1a))>>3 | int(this.u8(off+0x1b))<<5)
+	kind := TYPEKIND(this.u8(off + 0x1d))
+	e.kind = kind
+	if e.isDual() {
+		e.kind = TKIND_DISPATCH
+	}
+
+	memOff := off + elemTable
+	blk := memOff + sltgMemHeaderSize
+	tail := blk + int(this.i32(memOff+5))
+	if this.bad {
+		return
+	}
+	cFuncs := int(this.u16(tail))
+	cVars := int(this.u16(tail + 2))
+	funcsOff := int(this.u16(tail + 8))
+	varsOff := int(this.u16(tail + 0x0a))
+	e.sizeInstance = int(this.u16(tail + 0x20))
+	e.alignment = int(this.u16(tail + 0x22))
+	e.sizeVft = int(this.u16(tail + 0x28))
+
+	var refs []*typeEntry
+	if hrefTable != -1 {
+		refs = this.readRefs(off + int(hrefTable))
+		if this.bad {
+			return
+		}
+	}
+
+	switch kind {
+	case TKIND_ENUM, TKIND_RECORD, TKIND_UNION:
+		if varsOff != 0xffff {
+			this.readVars(e, blk, blk+varsOff, cVars, refs)
+		}
+	case TKIND_ALIAS:
+		if this.u16(tail+0x1c) != 0 {
+			e.alias = vtDesc(VARENUM(this.u16(tail + 0x14)))
+		} else {
+			e.alias, _ = this.typeDesc(blk, blk+int(this.u16(tail+0x14)), refs)
+		}
+	case TKIND_INTERFACE, TKIND_COCLASS:
+		if this.u16(blk) == sltgImplMagic {
+			this.readImpls(e, blk, refs)
+		}
+		if kind == TKIND_INTERFACE && funcsOff != 0xffff {
+			this.readFuncs(e, blk, blk+funcsOff, cFuncs, refs)
+		}
+	case TKIND_DISPATCH, TKIND_MODULE:
+		if varsOff != 0xffff {
+			this.readVars(e, blk, blk+varsOff, cVars, refs)
+		}
+		if funcsOff != 0xffff {
+			this.readFuncs(e, blk, blk+funcsOff, cFuncs, refs)
+		}
+	}
+}
+
+// readRefs reads the href table of a type, whose entries look like
+// "*\Rxxxx*#n": xxxx is ffff for a type of this library, or else the
+// offset in the name table of the description of an imported library.
+func (this *sltgReader) readRefs(off int) []*typeEntry {
+	if this.u8(off) != sltgRefMagic {
+		this.bad = true
+		return nil
+	}
+	number := int(this.i32(off + 0x44))
+	if number < 0 || number > len(this.data) {
+		this.bad = true
+		return nil
+	}
+	count := number >> 3
+	refs := make([]*typeEntry, count)
+	ptr := off + 0x4f + number
+	for n := 0; n < count; n++ {
+		ref, size := this.str(ptr)
+		ptr += size
+		if this.bad {
+			return nil
+		}
+		refs[n] = this.resolveRef(ref)
+	}
+	return refs
+}
+
+func (this *sltgReader) resolveRef(ref string) *typeEntry {
+	var libOffs, index int64 = -1, -1
+	if strings.HasPrefix(ref, "*\\R") {
+		parts := strings.SplitN(ref[3:], "*#", 2)
+		if len(parts) == 2 {
+			libOffs, _ = strconv.ParseInt(parts[0], 16, 32)
+			index, _ = strconv.ParseInt(strings.TrimSuffix(parts[1], "#"), 16, 32)
+		}
+	}
+	if libOffs == 0xffff {
+		if index < 0 || int(index) >= len(this.entries) {
+			return nil
+		}
+		return this.entries[index]
+	}
+	if libOffs < 0 {
+		return nil
+	}
+	impLib := this.importLib(int(libOffs))
+	e := findStdoleType(impLib.Guid, GUID{}, int(index))
+	if e == nil {
+		e = &typeEntry{impLib: impLib, impIndex: int(index)}
+	}
+	return e
+}
+
+// importLib parses "*\G{guid}#maj.min#lcid#path#" in the name table
+func (this *sltgReader) importLib(offs int) *ImpLib {
+	if impLib, ok := this.imports[offs]; ok {
+		return impLib
+	}
+	s := this.nameAt(offs)
+	impLib := &ImpLib{}
+	pos := strings.IndexByte(s, '{')
+	if pos != -1 && pos+38 <= len(s) {
+		impLib.Guid, _ = ParseGuid(s[pos : pos+38])
+		parts := strings.SplitN(s[pos+38:], "#", 5)
+		if len(parts) >= 4 {
+			ver := strings.SplitN(parts[1], ".", 2)
+			if len(ver) == 2 {
+				major, _ := strconv.ParseUint(ver[0], 16, 16)
+				minor, _ := strconv.ParseUint(ver[1], 16, 16)
+				impLib.MajorVer, impLib.MinorVer = uint16(major), uint16(minor)
+			}
+			lcid, _ := strconv.ParseUint(parts[2], 16, 32)
+			impLib.Lcid = uint32(lcid)
+			impLib.Name = parts[3]
+		}
+	}
+	this.imports[offs] = impLib
+	return impLib
+}
+
+// typeDesc reads a type, returning it and the offset past it
+func (this *sltgReader) typeDesc(blk int, ptr int, refs []*typeEntry) (*typeDesc, int) {
+	var root typeDesc
+	d := &root
+	for {
+		w := this.u16(ptr)
+		if this.bad {
+			return &root, ptr
+		}
+		if w&0xe00 == 0xe00 {
+			d.vt = VT_PTR
+			d.elem = &typeDesc{}
+			d = d.elem
+		}
+		switch VARENUM(w & 0x3f) {
+		case VT_PTR:
+			d.vt = VT_PTR
+			d.elem = &typeDesc{}
+			d = d.elem
+		case VT_USERDEFINED:
+			d.vt = VT_USERDEFINED
+			ptr += 2
+			index := int(this.u16(ptr)) / 4
+			if index < len(refs) {
+				d.ref = refs[index]
+			}
+			return &root, ptr + 2
+		case VT_CARRAY:
+			//followed by the offset of a SAFEARRAY holding the bounds
+			ptr += 2
+			sa := blk + int(this.u16(ptr))
+			d.vt = VT_CARRAY
+			dimCount := int(this.u16(sa))
+			for n := 0; n < dimCount && !this.bad; n++ {
+				d.dims = append(d.dims, int(this.i32(sa+16+n*8)))
+			}
+			d.elem = &typeDesc{}
+			d = d.elem
+		case VT_SAFEARRAY:
+			ptr += 2
+			d.vt = VT_SAFEARRAY
+			d.elem = &typeDesc{}
+			d = d.elem
+		default:
+			d.vt = VARENUM(w & 0x3f)
+			return &root, ptr + 2
+		}
+		ptr += 2
+	}
+}
+
+func (this *sltgReader) elemDesc(blk int, ptr int, refs []*typeEntry) (*typeDesc, PARAMFLAGS, int) {
+	w := this.u16(ptr)
+	var flags PARAMFLAGS
+	switch {
+	case w&0xc000 == 0xc000:
+		flags = PARAMFLAG_NONE
+	case w&0x8000 != 0:
+		flags = PARAMFLAG_FIN | PARAMFLAG_FOUT
+	case w&0x4000 != 0:
+		flags = PARAMFLAG_FOUT
+	default:
+		flags = PARAMFLAG_FIN
+	}
+	if w&0x2000 != 0 {
+		flags |= PARAMFLAG_FLCID
+	}
+	if w&0x80 != 0 {
+		flags |= PARAMFLAG_FRETVAL
+	}
+	d, next := this.typeDesc(blk, ptr, refs)
+	return d, flags, next
+}
+
+func (this *sltgReader) readImpls(e *typeEntry, blk int, refs []*typeEntry) {
+	ptr := blk
+	for {
+		flags := IMPLTYPEFLAGS(this.u8(ptr + 6))
+		index := int(this.u16(ptr + 0x0a))
+		var ref *typeEntry
+		if index < len(refs) {
+			ref = refs[index]
+		}
+		e.impls = append(e.impls, &implEntry{ref: ref, flags: flags})
+		next := int(this.u16(ptr + 2))
+		if next == 0xffff || this.bad || len(e.impls) > 0xffff {
+			return
+		}
+		ptr = blk + next
+	}
+}
+
+func (this *sltgReader) readVars(e *typeEntry, blk int, ptr int, count int, refs []*typeEntry) {
+	var prevName string
+	for n := 0; n < count; n++ {
+		magic := this.u8(ptr)
+		if magic != sltgVarMagic && magic != sltgVarWithFlagsMagic {
+			this.bad = true
+			return
+		}
+		flags := this.u8(ptr + 1)
+		v := &varEntry{}
+		v.memid = this.i32(ptr + 0x0a)
+		nameOffs := int(this.u16(ptr + 4))
+		if nameOffs == 0xfffe {
+			v.name = prevName
+		} else {
+			v.name = this.nameAt(nameOffs)
+		}
+		byteOffs := int(this.u16(ptr + 6))
+		typ := ptr + 8
+		if flags&0x02 == 0 {
+			typ = blk + int(this.u16(ptr+8))
+		}
+		v.typ, _, _ = this.elemDesc(blk, typ, refs)
+
+		if flags&0x40 != 0 {
+			v.varKind = VAR_DISPATCH
+		} else if flags&0x10 != 0 {
+			v.varKind = VAR_CONST
+			v.value = this.constValue(blk, v.typ.vt, byteOffs, flags&0x08 != 0)
+		} else {
+			v.varKind = VAR_PERINSTANCE
+			v.oInst = byteOffs
+		}
+		if magic == sltgVarWithFlagsMagic {
+			v.flags = VARFLAGS(this.u16(ptr + 0x12))
+		}
+		if flags&0x80 != 0 {
+			v.flags |= VARFLAG_FREADONLY
+		}
+		if this.bad {
+			return
+		}
+		e.vars = append(e.vars, v)
+		prevName = v.name
+
+		next := int(this.u16(ptr + 2))
+		if next == 0xffff {
+			break
+		}
+		ptr = blk + next
+	}
+}
+
+func (this *sltgReader) constValue(blk int, vt VARENUM, byteOffs int, inline bool) interface{} {
+	if inline {
+		return convertValue(vt, uint64(byteOffs))
+	}
+	switch vt {
+	case VT_BSTR, VT_LPSTR, VT_LPWSTR:
+		s, _ := this.str(blk + byteOffs)
+		return s
+	}
+	v := convertValue(vt, uint64(uint32(this.i32(blk+byteOffs))))
+	if v == nil {
+		v = this.i32(blk + byteOffs)
+	}
+	return v
+}
+
+func (this *sltgReader) readFuncs(e *typeEntry, blk int, ptr int, count int, refs []*typeEntry) {
+	for n := 0; n < count; n++ {
+		magic := this.u8(ptr)
+		f := &funcEntry{}
+		switch magic &^ sltgFuncFlagsPresent {
+		case sltgFuncMagic:
+			f.funcKind = FUNC_PUREVIRTUAL
+		case sltgDispFuncMagic:
+			f.funcKind = FUNC_DISPATCH
+		case sltgStaticFuncMagic:
+			f.funcKind = FUNC_STATIC
+		default:
+			this.bad = true
+			return
+		}
+		f.name = this.nameAt(int(this.u16(ptr + 4)))
+		f.memid = this.i32(ptr + 6)
+		f.invKind = INVOKEKIND(this.u8(ptr+1) >> 4)
+		nacc := this.u8(ptr + 0x10)
+		f.callConv = CALLCONV(nacc & 0x7)
+		paramCount := int(nacc >> 3)
+		retNextOpt := this.u8(ptr + 0x11)
+		f.cParamsOpt = int(retNextOpt&0x7e) >> 1
+		f.vtblIndex = int(this.u16(ptr+0x14)&^1) / this.ptrSize
+		if magic&sltgFuncFlagsPresent != 0 {
+			f.flags = FUNCFLAGS(this.u16(ptr + 0x16))
+		}
+		ret := ptr + 0x12
+		if retNextOpt&0x80 == 0 {
+			ret = blk + int(this.u16(ptr+0x12))
+		}
+		f.ret, _, _ = this.elemDesc(blk, ret, refs)
+
+		arg := blk + int(this.u16(ptr+0x0e))
+		for i := 0; i < paramCount && !this.bad; i++ {
+			p := &paramEntry{}
+			nameOffs := int(this.u16(arg))
+			//the name offset points to the 2nd letter of the name when the
+			//type follows inline, else the next word is the type offset
+			haveOffs := false
+			named := true
+			if nameOffs == 0xffff {
+				named = false
+			} else if nameOffs == 0xfffe {
+				named = false
+				haveOffs = true
+			} else if c := this.u8(this.nameTable + nameOffs - 1); c != 0 && !isAlnum(c) {
+				haveOffs = true
+			}
+			arg += 2
+			if haveOffs {
+				p.typ, p.flags, _ = this.elemDesc(blk, blk+int(this.u16(arg)), refs)
+				arg += 2
+			} else {
+				if named {
+					nameOffs--
+				}
+				p.typ, p.flags, arg = this.elemDesc(blk, arg, refs)
+			}
+			if paramCount-i <= f.cParamsOpt {
+				p.flags |= PARAMFLAG_FOPT
+			}
+			if named {
+				p.name = this.nameAt(nameOffs)
+			}
+			f.params = append(f.params, p)
+		}
+		if this.bad {
+			return
+		}
+		e.funcs = append(e.funcs, f)
+
+		next := int(this.u16(ptr + 2))
+		if next == 0xffff {
+			break
+		}
+		ptr = blk + next
+	}
+}
+
+func isAlnum(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
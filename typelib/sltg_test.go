@@ -0,0 +1,431 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// sltgWriter builds SLTG typelibs in memory, laid out the way wine's
+// typelib.c reads them, for the reader tests
+
+type sltgWriter struct {
+	names     msftBuf
+	nameIndex map[string]int
+}
+
+func (this *sltgWriter) name(s string) int {
+	if o, ok := this.nameIndex[s]; ok {
+		return o
+	}
+	o := len(this.names.b)
+	this.names.b = append(this.names.b, s...)
+	this.names.b = append(this.names.b, 0)
+	this.nameIndex[s] = o
+	return o
+}
+
+func sltgStr(b *msftBuf, s string) {
+	if s == "" {
+		b.u16(0xffff)
+		return
+	}
+	b.u16(uint16(len(s)))
+	b.b = append(b.b, s...)
+}
+
+type sltgTypeBlock struct {
+	b    msftBuf
+	kind TYPEKIND
+	name string
+	guid GUID
+}
+
+// sltgTypeHeader writes the header of a type block, with the ref list of the
+// refs given, and returns the func writing its members and tail
+func sltgTypeHeader(kind TYPEKIND, flags TYPEFLAGS, refs []string) (*msftBuf, func(members msftBuf, tail []uint16)) {
+	b := &msftBuf{}
+	b.u16(sltgTiHeaderMagic)
+	hrefPos := len(b.b)
+	b.i32(-1)
+	b.i32(-1)
+	elemPos := len(b.b)
+	b.i32(0)
+	b.i32(-1)
+	b.u16(1)
+	b.u16(0)
+	b.i32(0)
+	b.b = append(b.b, byte(0x02|(int(flags)&0x1f)<<3), byte(int(flags)>>5), 2, byte(kind))
+	b.i32(0)
+	if refs != nil {
+		binary.LittleEndian.PutUint32(b.b[hrefPos:], uint32(len(b.b)))
+		start := len(b.b)
+		b.b = append(b.b, sltgRefMagic, 0)
+		for len(b.b)-start < 0x42 {
+			b.b = append(b.b, 0xff)
+		}
+		b.u16(0xffff)
+		b.i32(int32(len(refs) * 8))
+		for range refs {
+			b.u16(1)
+			b.b = append(b.b, 2, 0)
+			b.u16(0xffff)
+			b.u16(0)
+		}
+		b.u16(0xffff)
+		b.b = append(b.b, 1)
+		b.i32(0)
+		for _, ref := range refs {
+			sltgStr(b, ref)
+		}
+		b.b = append(b.b, sltgRefMagic)
+	}
+	return b, func(members msftBuf, tail []uint16) {
+		binary.LittleEndian.PutUint32(b.b[elemPos:], uint32(len(b.b)))
+		b.u16(1)
+		b.u16(0xffff)
+		b.b = append(b.b, 1)
+		b.i32(int32(len(members.b)))
+		b.b = append(b.b, members.b...)
+		for _, v := range tail {
+			b.u16(v)
+		}
+	}
+}
+
+func sltgTypeTail(funcCount, varCount, implCount, funcsOffset, varsOffset,
+	size, align, sizeVft int) []uint16 {
+	tail := make([]uint16, 0x36/2)
+	tail[0], tail[1], tail[2] = uint16(funcCount), uint16(varCount), uint16(implCount)
+	tail[4], tail[5], tail[6] = uint16(funcsOffset), uint16(varsOffset), 0xffff
+	tail[0x20/2], tail[0x22/2], tail[0x28/2] = uint16(size), uint16(align), uint16(sizeVft)
+	return tail
+}
+
+func sltgImpl(members *msftBuf, flags IMPLTYPEFLAGS) {
+	members.u16(sltgImplMagic)
+	members.u16(0xffff)
+	members.u16(0xffff)
+	members.b = append(members.b, byte(flags), 0x80)
+	members.u16(0x12)
+	members.u16(0)
+	members.u16(0x4000)
+	members.u16(0xfffe)
+	members.u16(0xffff)
+	members.u16(0x1d)
+	members.u16(0)
+}
+
+// buildSltg builds a library of an enum, a struct, a dual interface and
+// a coclass implementing it
+func buildSltg() []byte {
+	w := &sltgWriter{nameIndex: make(map[string]int)}
+	w.name("")
+	stdole := w.name("*\\G{00020430-0000-0000-C000-000000000046}#2.0#0#stdole2.tlb#")
+	var blocks []*sltgTypeBlock
+
+	{
+		b, finish := sltgTypeHeader(TKIND_ENUM, 0, nil)
+		var members msftBuf
+		values := []struct {
+			name  string
+			value int32
+		}{{"colRed", 1}, {"colGreen", 2}, {"colNeg", -5}}
+		itemSize := 0x12
+		for n, v := range values {
+			members.b = append(members.b, sltgVarMagic)
+			flags := byte(0x02 | 0x10)
+			inline := v.value >= 0
+			if inline {
+				flags |= 0x08
+			}
+			members.b = append(members.b, flags)
+			if n == len(values)-1 {
+				members.u16(0xffff)
+			} else {
+				members.u16(uint16((n + 1) * itemSize))
+			}
+			members.u16(uint16(w.name(v.name)))
+			if inline {
+				members.u16(uint16(v.value))
+			} else {
+				members.u16(uint16(itemSize * len(values))) //after the items
+			}
+			members.u16(uint16(VT_I4))
+			members.i32(-1)
+			members.u16(0xfffe)
+			members.u16(0xffff)
+		}
+		members.i32(-5)
+		finish(members, sltgTypeTail(0, 3, 0, 0xffff, 0, 4, 4, 0))
+		blocks = append(blocks, &sltgTypeBlock{*b, TKIND_ENUM, "SColor",
+			mustParseGuid("10000001-0000-0000-0000-000000000000")})
+	}
+	{
+		b, finish := sltgTypeHeader(TKIND_RECORD, 0, nil)
+		var members msftBuf
+		fields := []struct {
+			name   string
+			vt     VARENUM
+			offset int
+		}{{"x", VT_I4, 0}, {"flag", VT_I2, 4}, {"label", VT_BSTR, 8}}
+		for n, f := range fields {
+			members.b = append(members.b, sltgVarMagic, 0x02)
+			if n == len(fields)-1 {
+				members.u16(0xffff)
+			} else {
+				members.u16(uint16((n + 1) * 0x12))
+			}
+			members.u16(uint16(w.name(f.name)))
+			members.u16(uint16(f.offset))
+			members.u16(uint16(f.vt))
+			members.i32(int32(0x40000000 + n))
+			members.u16(0xfffe)
+			members.u16(0xffff)
+		}
+		finish(members, sltgTypeTail(0, 3, 0, 0xffff, 0, 12, 4, 0))
+		blocks = append(blocks, &sltgTypeBlock{*b, TKIND_RECORD, "SRect",
+			mustParseGuid("10000002-0000-0000-0000-000000000000")})
+	}
+	{
+		//ref 0 is IDispatch of stdole, ref 1 the enum
+		refs := []string{fmt.Sprintf("*\\R%x*#4", stdole), "*\\Rffff*#0"}
+		b, finish := sltgTypeHeader(TKIND_INTERFACE, TYPEFLAG_FDUAL|TYPEFLAG_FDISPATCHABLE, refs)
+		var members msftBuf
+		sltgImpl(&members, 0)
+		funcsOffset := len(members.b)
+		paramName := func(s string) uint16 { return uint16(w.name(s) + 1) }
+		funcs := []struct {
+			name      string
+			memid     int32
+			invKind   INVOKEKIND
+			params    [][]uint16 //name and type words
+			optParams int
+		}{
+			{"Color", 1, INVOKE_PROPERTYGET, [][]uint16{
+				{paramName("pColor"), 0x4000 | 0x80 | uint16(VT_PTR), uint16(VT_USERDEFINED), 1 * 4}}, 0},
+			{"Color", 1, INVOKE_PROPERTYPUT, [][]uint16{
+				{0xffff, uint16(VT_USERDEFINED), 1 * 4}}, 0},
+			{"Paint", 2, INVOKE_FUNC, [][]uint16{
+				{paramName("Times"), uint16(VT_I4)},
+				{paramName("Label"), uint16(VT_VARIANT)},
+				{paramName("Result"), 0x4000 | 0x80 | uint16(VT_PTR), uint16(VT_BSTR)}}, 1},
+		}
+		pos := funcsOffset
+		for n, f := range funcs {
+			var rec msftBuf
+			rec.b = append(rec.b, sltgFuncMagic, byte(f.invKind)<<4|2)
+			rec.u16(0)
+			rec.u16(uint16(w.name(f.name)))
+			rec.i32(f.memid)
+			rec.u16(0xfffe)
+			rec.u16(0xffff)
+			rec.u16(uint16(pos + 0x16))
+			rec.b = append(rec.b, byte(CC_STDCALL)|byte(len(f.params))<<3, 0x80|byte(f.optParams)<<1)
+			rec.u16(uint16(VT_HRESULT))
+			rec.u16(uint16(7 + n*4))
+			for _, p := range f.params {
+				for _, v := range p {
+					rec.u16(v)
+				}
+			}
+			pos += len(rec.b)
+			if n == len(funcs)-1 {
+				binary.LittleEndian.PutUint16(rec.b[2:], 0xffff)
+			} else {
+				binary.LittleEndian.PutUint16(rec.b[2:], uint16(pos))
+			}
+			members.b = append(members.b, rec.b...)
+		}
+		finish(members, sltgTypeTail(3, 0, 1, funcsOffset, 0xffff, 4, 4, 10*4))
+		blocks = append(blocks, &sltgTypeBlock{*b, TKIND_INTERFACE, "ISPainter",
+			mustParseGuid("10000003-0000-0000-0000-000000000000")})
+	}
+	{
+		b, finish := sltgTypeHeader(TKIND_COCLASS, TYPEFLAG_FCANCREATE, []string{"*\\Rffff*#2"})
+		var members msftBuf
+		sltgImpl(&members, IMPLTYPEFLAG_FDEFAULT)
+		finish(members, sltgTypeTail(0, 0, 1, 0xffff, 0xffff, 0, 0, 0))
+		blocks = append(blocks, &sltgTypeBlock{*b, TKIND_COCLASS, "SPainter",
+			mustParseGuid("10000004-0000-0000-0000-000000000000")})
+	}
+
+	libName := w.name("SltgLib")
+	var lib msftBuf
+	lib.u16(sltgLibBlkMagic)
+	lib.u16(3)
+	lib.u16(uint16(libName))
+	lib.u16(0xffff)
+	sltgStr(&lib, "Sltg test library")
+	sltgStr(&lib, "")
+	lib.i32(0)
+	lib.u16(uint16(SYS_WIN32))
+	lib.u16(0x409)
+	lib.i32(0)
+	lib.u16(0)
+	lib.u16(3)
+	lib.u16(1)
+	libId := mustParseGuid("10000000-0000-0000-0000-000000000000")
+	lib.i32(int32(libId.Data1))
+	lib.u16(libId.Data2)
+	lib.u16(libId.Data3)
+	lib.b = append(lib.b, libId.Data4[:]...)
+	for n := 0; n < 0x40; n++ {
+		lib.b = append(lib.b, 0xff)
+	}
+	indexName := func(n int) string { return string(rune('A'+n)) + "AAAAAAAAA" }
+	for n, block := range blocks {
+		lib.u16(uint16(n))
+		sltgStr(&lib, indexName(n))
+		sltgStr(&lib, "")
+		lib.u16(0xffff)
+		lib.u16(uint16(w.name(block.name)))
+		lib.u16(0)
+		lib.u16(0xffff)
+		lib.i32(0)
+		lib.u16(0xffff)
+		lib.i32(int32(block.guid.Data1))
+		lib.u16(block.guid.Data2)
+		lib.u16(block.guid.Data3)
+		lib.b = append(lib.b, block.guid.Data4[:]...)
+		lib.u16(uint16(block.kind))
+	}
+	lib.u16(3)
+	lib.i32(int32(len(lib.b) + 4))
+	lib.u16(0xffff)
+	lib.b = append(lib.b, make([]byte, 0x214)...)
+	lib.u16(0)
+	lib.b = append(lib.b, w.names.b...)
+
+	var out msftBuf
+	count := len(blocks) + 1
+	out.i32(sltgMagic)
+	out.u16(uint16(count + 1))
+	out.u16(9)
+	out.u16(0)
+	out.u16(1)
+	out.i32(0x000204ff)
+	out.i32(0)
+	out.i32(0xc0)
+	out.i32(0x46000000)
+	out.i32(0x44)
+	out.i32(-65536)
+	for n := 0; n < count; n++ {
+		size := len(lib.b)
+		if n < len(blocks) {
+			size = len(blocks[n].b.b)
+		}
+		out.i32(int32(size))
+		out.u16(uint16(sltgMagicSize + n*sltgIndexSize))
+		if n == count-1 {
+			out.u16(0)
+		} else {
+			out.u16(uint16(n + 2))
+		}
+	}
+	out.b = append(out.b, 1)
+	out.b = append(out.b, "CompObj\x00dir\x00"...)
+	for n := 0; n < count; n++ {
+		out.b = append(out.b, indexName(n)+"\x00"...)
+	}
+	out.b = append(out.b, make([]byte, sltgPad9Size)...)
+	for _, block := range blocks {
+		out.b = append(out.b, block.b.b...)
+	}
+	out.b = append(out.b, lib.b...)
+	return out.b
+}
+
+func TestSltg(t *testing.T) {
+	lib, err := NewTypeLibFromBytes(buildSltg())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lib.GetName() != "SltgLib" || lib.GetDoc() != "Sltg test library" {
+		t.Errorf("name %q, doc %q", lib.GetName(), lib.GetDoc())
+	}
+	attr := lib.GetLibAttr()
+	if attr.Guid.String() != "10000000-0000-0000-0000-000000000000" ||
+		attr.SysKind != SYS_WIN32 || attr.MajorVer != 3 || attr.MinorVer != 1 {
+		t.Errorf("attr %+v", attr)
+	}
+
+	tests := []struct {
+		name    string
+		kind    TYPEKIND
+		members []string
+	}{
+		{"SColor", TKIND_ENUM, []string{"colRed = 1", "colGreen = 2", "colNeg = -5"}},
+		{"SRect", TKIND_RECORD, []string{"x int32 @0", "flag int16 @4", "label win32.BSTR @8"}},
+		{"ISPainter", TKIND_DISPATCH, []string{
+			"Color 1 get SColor()", "Color 1 put (SColor)",
+			"Paint 2 func win32.BSTR(int32, win32.VARIANT) opt 1"}},
+		{"SPainter", TKIND_COCLASS, []string{"ISPainter default"}},
+	}
+	if lib.GetTypeInfoCount() != len(tests) {
+		t.Fatalf("%d types", lib.GetTypeInfoCount())
+	}
+	for n, test := range tests {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if ti.Name != test.name || ti.Kind != test.kind {
+			t.Errorf("type %d: %s %v, want %s %v", n, ti.Name, ti.Kind, test.name, test.kind)
+		}
+		var members []string
+		for _, f := range ti.Fields {
+			if ti.Kind == TKIND_ENUM {
+				members = append(members, fmt.Sprintf("%s = %v", f.Name, f.Value))
+			} else {
+				members = append(members, fmt.Sprintf("%s %s @%d", f.Name, f.Type.Name, f.Offset))
+			}
+		}
+		for _, f := range ti.Funcs {
+			if f.Id >= 0x60000000 {
+				continue //of IUnknown and IDispatch
+			}
+			var params []string
+			for _, p := range f.Params {
+				params = append(params, p.Type.Name)
+			}
+			invKind := "func"
+			if f.Flags.PropGet {
+				invKind = "get"
+			} else if f.Flags.PropPut {
+				invKind = "put"
+			}
+			ret := ""
+			if f.ReturnType != nil && f.ReturnType.Vt != VT_HRESULT && f.ReturnType.Vt != VT_VOID {
+				ret = f.ReturnType.Name
+			}
+			s := fmt.Sprintf("%s %d %s %s(%s)", f.Name, f.Id, invKind, ret, strings.Join(params, ", "))
+			if f.ParamsOpt != 0 {
+				s += fmt.Sprintf(" opt %d", f.ParamsOpt)
+			}
+			members = append(members, s)
+		}
+		for _, it := range ti.ImplTypes {
+			s := it.Name
+			if it.Default {
+				s += " default"
+			}
+			members = append(members, s)
+		}
+		if !reflect.DeepEqual(members, test.members) {
+			t.Errorf("%s members %q, want %q", test.name, members, test.members)
+		}
+	}
+}
+
+func TestSltgErrors(t *testing.T) {
+	data := buildSltg()
+	for _, size := range []int{4, sltgHeaderSize, sltgHeaderSize + 3*sltgBlkEntrySize, len(data) / 2} {
+		if _, err := NewTypeLibFromBytes(data[:size]); err == nil {
+			t.Errorf("no error for %d of %d bytes", size, len(data))
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package typelib
+
+// Well known types of stdole2.tlb, used by the pure-Go readers to resolve
+// references into the OLE Automation library without loading it.
+
+import "github.com/zzl/go-tlbimp/utils"
+
+var stdoleLibId = mustParseGuid("00020430-0000-0000-C000-000000000046")
+
+var stdoleTypes []*typeEntry
+var stdoleTypeMap map[GUID]*typeEntry
+
+func vtDesc(vt VARENUM) *typeDesc {
+	return &typeDesc{vt: vt}
+}
+
+func ptrDesc(elem *typeDesc) *typeDesc {
+	return &typeDesc{vt: VT_PTR, elem: elem}
+}
+
+func refDesc(ref *typeEntry) *typeDesc {
+	return &typeDesc{vt: VT_USERDEFINED, ref: ref}
+}
+
+func stdFunc(name string, memid int32, ret VARENUM, params ...*paramEntry) *funcEntry {
+	return &funcEntry{
+		memid:    memid,
+		name:     name,
+		funcKind: FUNC_PUREVIRTUAL,
+		invKind:  INVOKE_FUNC,
+		callConv: CC_STDCALL,
+		flags:    FUNCFLAG_FRESTRICTED,
+		ret:      vtDesc(ret),
+		params:   params,
+	}
+}
+
+func stdParam(name string, typ *typeDesc, flags PARAMFLAGS) *paramEntry {
+	return &paramEntry{name: name, typ: typ, flags: flags}
+}
+
+func stdVar(name string, typ *typeDesc) *varEntry {
+	return &varEntry{name: name, typ: typ, memid: -1}
+}
+
+func init() {
+	in, out := PARAMFLAG_FIN, PARAMFLAG_FOUT
+
+	guid := &typeEntry{name: "GUID", kind: TKIND_RECORD,
+		sizeInstance: 16, alignment: 4}
+	guid.vars = []*varEntry{
+		stdVar("Data1", vtDesc(VT_UI4)),
+		stdVar("Data2", vtDesc(VT_UI2)),
+		stdVar("Data3", vtDesc(VT_UI2)),
+		stdVar("Data4", &typeDesc{vt: VT_CARRAY,
+			elem: vtDesc(VT_UI1), dims: []int{8}}),
+	}
+
+	dispParams := &typeEntry{name: "DISPPARAMS", kind: TKIND_RECORD}
+	dispParams.vars = []*varEntry{
+		stdVar("rgvarg", ptrDesc(vtDesc(VT_VARIANT))),
+		stdVar("rgdispidNamedArgs", ptrDesc(vtDesc(VT_I4))),
+		stdVar("cArgs", vtDesc(VT_UINT)),
+		stdVar("cNamedArgs", vtDesc(VT_UINT)),
+	}
+
+	excepInfo := &typeEntry{name: "EXCEPINFO", kind: TKIND_RECORD}
+	excepInfo.vars = []*varEntry{
+		stdVar("wCode", vtDesc(VT_UI2)),
+		stdVar("wReserved", vtDesc(VT_UI2)),
+		stdVar("bstrSource", vtDesc(VT_BSTR)),
+		stdVar("bstrDescription", vtDesc(VT_BSTR)),
+		stdVar("bstrHelpFile", vtDesc(VT_BSTR)),
+		stdVar("dwHelpContext", vtDesc(VT_UI4)),
+		stdVar("pvReserved", ptrDesc(vtDesc(VT_VOID))),
+		stdVar("pfnDeferredFillIn", ptrDesc(vtDesc(VT_VOID))),
+		stdVar("scode", vtDesc(VT_ERROR)),
+	}
+
+	unknown := &typeEntry{name: "IUnknown", kind: TKIND_INTERFACE,
+		guid:  mustParseGuid("00000000-0000-0000-C000-000000000046"),
+		flags: TYPEFLAG_FHIDDEN}
+	unknown.funcs = []*funcEntry{
+		stdFunc("QueryInterface", 0x60000000, VT_HRESULT,
+			stdParam("riid", ptrDesc(refDesc(guid)), in),
+			stdParam("ppvObj", ptrDesc(ptrDesc(vtDesc(VT_VOID))), out)),
+		stdFunc("AddRef", 0x60000001, VT_UI4),
+		stdFunc("Release", 0x60000002, VT_UI4),
+	}
+
+	dispatch := &typeEntry{name: "IDispatch", kind: TKIND_INTERFACE,
+		guid:  mustParseGuid("00020400-0000-0000-C000-000000000046"),
+		flags: TYPEFLAG_FRESTRICTED,
+		impls: []*implEntry{{ref: unknown}}}
+	dispatch.funcs = []*funcEntry{
+		stdFunc("GetTypeInfoCount", 0x60010000, VT_HRESULT,
+			stdParam("pctinfo", ptrDesc(vtDesc(VT_UINT)), out)),
+		stdFunc("GetTypeInfo", 0x60010001, VT_HRESULT,
+			stdParam("itinfo", vtDesc(VT_UINT), in),
+			stdParam("lcid", vtDesc(VT_UI4), in),
+			stdParam("pptinfo", ptrDesc(ptrDesc(vtDesc(VT_VOID))), out)),
+		stdFunc("GetIDsOfNames", 0x60010002, VT_HRESULT,
+			stdParam("riid", ptrDesc(refDesc(guid)), in),
+			stdParam("rgszNames", ptrDesc(ptrDesc(vtDesc(VT_I1))), in),
+			stdParam("cNames", vtDesc(VT_UINT), in),
+			stdParam("lcid", vtDesc(VT_UI4), in),
+			stdParam("rgdispid", ptrDesc(vtDesc(VT_I4)), out)),
+		stdFunc("Invoke", 0x60010003, VT_HRESULT,
+			stdParam("dispidMember", vtDesc(VT_I4), in),
+			stdParam("riid", ptrDesc(refDesc(guid)), in),
+			stdParam("lcid", vtDesc(VT_UI4), in),
+			stdParam("wFlags", vtDesc(VT_UI2), in),
+			stdParam("pdispparams", ptrDesc(refDesc(dispParams)), in),
+			stdParam("pvarResult", ptrDesc(vtDesc(VT_VARIANT)), out),
+			stdParam("pexcepinfo", ptrDesc(refDesc(excepInfo)), out),
+			stdParam("puArgErr", ptrDesc(vtDesc(VT_UINT)), out)),
+	}
+
+	enumVariant := &typeEntry{name: "IEnumVARIANT", kind: TKIND_INTERFACE,
+		guid:  mustParseGuid("00020404-0000-0000-C000-000000000046"),
+		flags: TYPEFLAG_FHIDDEN,
+		impls: []*implEntry{{ref: unknown}}}
+	enumVariant.funcs = []*funcEntry{
+		stdFunc("Next", 0x60010000, VT_HRESULT,
+			stdParam("celt", vtDesc(VT_UI4), in),
+			stdParam("rgvar", ptrDesc(vtDesc(VT_VARIANT)), in),
+			stdParam("pceltFetched", ptrDesc(vtDesc(VT_UI4)), out)),
+		stdFunc("Skip", 0x60010001, VT_HRESULT,
+			stdParam("celt", vtDesc(VT_UI4), in)),
+		stdFunc("Reset", 0x60010002, VT_HRESULT),
+		stdFunc("Clone", 0x60010003, VT_HRESULT,
+			stdParam("ppenum", ptrDesc(ptrDesc(refDesc(enumVariant))), out)),
+	}
+
+	oleColor := &typeEntry{name: "OLE_COLOR", kind: TKIND_ALIAS,
+		guid:  mustParseGuid("66504301-BE0F-101A-8BBB-00AA00300CAB"),
+		alias: vtDesc(VT_UI4)}
+
+	font := &typeEntry{name: "IFont", kind: TKIND_INTERFACE,
+		guid:  mustParseGuid("BEF6E002-A874-101A-8BBA-00AA00300CAB"),
+		impls: []*implEntry{{ref: unknown}}}
+	fontDisp := &typeEntry{name: "IFontDisp", kind: TKIND_DISPATCH,
+		guid:  mustParseGuid("BEF6E003-A874-101A-8BBA-00AA00300CAB"),
+		impls: []*implEntry{{ref: dispatch}}}
+	picture := &typeEntry{name: "IPicture", kind: TKIND_INTERFACE,
+		guid:  mustParseGuid("7BF80980-BF32-101A-8BBB-00AA00300CAB"),
+		impls: []*implEntry{{ref: unknown}}}
+	pictureDisp := &typeEntry{name: "IPictureDisp", kind: TKIND_DISPATCH,
+		guid:  mustParseGuid("7BF80981-BF32-101A-8BBB-00AA00300CAB"),
+		impls: []*implEntry{{ref: dispatch}}}
+
+	//in stdole2.tlb order
+	stdoleTypes = []*typeEntry{guid, dispParams, excepInfo,
+		unknown, dispatch, enumVariant, oleColor,
+		font, fontDisp, picture, pictureDisp}
+
+	stdoleTypeMap = make(map[GUID]*typeEntry)
+	for _, e := range stdoleTypes {
+		layoutEntry(e, utils.PtrSize)
+		if e.guid != (GUID{}) {
+			stdoleTypeMap[e.guid] = e
+		}
+	}
+}
+
+func findStdoleType(libId GUID, guid GUID, index int) *typeEntry {
+	if e := stdoleTypeMap[guid]; e != nil {
+		return e
+	}
+	//only the leading types have a known index
+	if libId == stdoleLibId && index >= 0 && index < 6 {
+		return stdoleTypes[index]
+	}
+	return nil
+}
+
+func stdoleDispatch() *typeEntry {
+	return stdoleTypes[4]
+}
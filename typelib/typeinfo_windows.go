@@ -0,0 +1,315 @@
+package typelib
+
+import (
+	"fmt"
+	"github.com/zzl/go-com/com"
+	"github.com/zzl/go-tlbimp/utils"
+	"github.com/zzl/go-win32api/v2/win32"
+)
+
+// comGraph holds the TypeInfos read through ITypeInfo for an arch, each
+// read once: the super types, dual interfaces and VarTypes that refer to
+// a type share its TypeInfo
+type comGraph struct {
+	arch  string
+	lib   GUID //of the library read, the types of others get their ImpLib
+	infos map[comGraphKey]*comGraphEntry
+}
+
+// a type by its library and index there, with the kind as a dual
+// dispinterface is also seen as an interface
+type comGraphKey struct {
+	lib   GUID
+	index uint32
+	kind  TYPEKIND
+}
+
+type comGraphEntry struct {
+	info *TypeInfo
+	err  error
+}
+
+func newComGraph(lib GUID) *comGraph {
+	return &comGraph{
+		arch:  utils.Arch,
+		lib:   lib,
+		infos: make(map[comGraphKey]*comGraphEntry),
+	}
+}
+
+// NewTypeInfo reads the type p describes. The members that cannot be
+// read are left out, with their errors in Errors; an error is returned
+// if the type itself cannot be.
+func NewTypeInfo(p *win32.ITypeInfo) (*TypeInfo, error) {
+	var lib GUID
+	if attr, _ := getContainingLibAttr(p); attr != nil {
+		lib = attr.Guid
+	}
+	return newComGraph(lib).typeInfo(p)
+}
+
+// typeInfo returns the TypeInfo of p, reading it the first time. It is
+// registered before its members are read, for the types referring back
+// to it.
+func (this *comGraph) typeInfo(p *win32.ITypeInfo) (*TypeInfo, error) {
+	key, ok := getComGraphKey(p)
+	if !ok {
+		info := &TypeInfo{}
+		if err := this.readTypeInfo(p, info); err != nil {
+			return nil, err
+		}
+		return info, nil
+	}
+	if entry, ok := this.infos[key]; ok {
+		return entry.info, entry.err
+	}
+	info := &TypeInfo{}
+	entry := &comGraphEntry{info: info}
+	this.infos[key] = entry
+	if err := this.readTypeInfo(p, info); err != nil {
+		entry.info, entry.err = nil, err
+		return nil, err
+	}
+	if key.lib != this.lib && key.lib != stdoleLibId {
+		if attr, name := getContainingLibAttr(p); attr != nil {
+			info.ImpLib = newImpLib(attr, name)
+		}
+	}
+	return info, nil
+}
+
+func getComGraphKey(p *win32.ITypeInfo) (comGraphKey, bool) {
+	var ptl *win32.ITypeLib
+	var index uint32
+	hr := p.GetContainingTypeLib(&ptl, &index)
+	if win32.FAILED(hr) {
+		return comGraphKey{}, false
+	}
+	defer ptl.Release()
+	var pLibAttr *win32.TLIBATTR
+	hr = ptl.GetLibAttr(&pLibAttr)
+	if win32.FAILED(hr) {
+		return comGraphKey{}, false
+	}
+	defer ptl.ReleaseTLibAttr(pLibAttr)
+	var pAttr *win32.TYPEATTR
+	hr = p.GetTypeAttr(&pAttr)
+	if win32.FAILED(hr) {
+		return comGraphKey{}, false
+	}
+	defer p.ReleaseTypeAttr(pAttr)
+	return comGraphKey{GUID(pLibAttr.Guid), index, TYPEKIND(pAttr.Typekind)}, true
+}
+
+func (this *comGraph) readTypeInfo(p *win32.ITypeInfo, info *TypeInfo) error {
+	var bsName, bsDoc com.BStr
+	hr := p.GetDocumentation(win32.MEMBERID_NIL, bsName.PBSTR(), bsDoc.PBSTR(), nil, nil)
+	if win32.FAILED(hr) {
+		return newHrError("GetDocumentation", int32(hr))
+	}
+
+	info.Name = bsName.ToStringAndFree()
+	info.Doc = bsDoc.ToStringAndFree()
+	info.CustData = getTypeCustData(p)
+
+	var pAttr *win32.TYPEATTR
+	hr = p.GetTypeAttr(&pAttr)
+	if win32.FAILED(hr) {
+		return withContext(newHrError("GetTypeAttr", int32(hr)), info.Name, "", -1)
+	}
+	defer p.ReleaseTypeAttr(pAttr)
+
+	info.Guid = GUID(pAttr.Guid)
+	info.Kind = TYPEKIND(pAttr.Typekind)
+	info.SizeInstance = int(pAttr.CbSizeInstance)
+	info.Alignment = int(pAttr.CbAlignment)
+
+	if pAttr.WTypeFlags&uint16(win32.TYPEFLAG_FHIDDEN) != 0 {
+		info.Flags.Hidden = true
+	}
+	if pAttr.WTypeFlags&uint16(win32.TYPEFLAG_FDUAL) != 0 {
+		info.Flags.Dual = true
+	}
+	if pAttr.WTypeFlags&uint16(win32.TYPEFLAG_FOLEAUTOMATION) != 0 {
+		info.Flags.OleAutomation = true
+	}
+	if pAttr.WTypeFlags&uint16(win32.TYPEFLAG_FRESTRICTED) != 0 {
+		info.Flags.Restricted = true
+	}
+
+	var err error
+	if info.Kind == TKIND_ALIAS {
+		info.RelType, err = _newVarType(this, p, &pAttr.TdescAlias, true)
+	}
+
+	//
+	if info.Kind == TKIND_ENUM {
+		this.addFields(p, pAttr, info)
+	} else if info.Kind == TKIND_RECORD {
+		this.addFields(p, pAttr, info)
+		info.Size, info.Align, err = getStructSize(p, pAttr)
+	} else if info.Kind == TKIND_UNION {
+		this.addFields(p, pAttr, info)
+		info.Size, info.Align, err = getUnionSize(p, pAttr)
+	}
+
+	if info.Kind == TKIND_INTERFACE {
+		if pAttr.CImplTypes > 0 {
+			var ptiImpl *win32.ITypeInfo
+			ptiImpl, err = getImplTypeInfo(p, 0)
+			if err == nil {
+				info.Super, err = this.typeInfo(ptiImpl)
+				ptiImpl.Release()
+			}
+		} else {
+			//iunknown?
+		}
+		this.addFuncs(p, pAttr, info, false)
+	}
+
+	if info.Kind == TKIND_DISPATCH {
+		if pAttr.CImplTypes > 0 {
+			var ptiImpl *win32.ITypeInfo
+			ptiImpl, err = getImplTypeInfo(p, 0)
+			if err == nil {
+				info.Super, err = this.typeInfo(ptiImpl)
+				ptiImpl.Release()
+			}
+		} else {
+			//iunknown?
+		}
+
+		info.DispInterface = true
+		if info.Flags.Dual && err == nil {
+			var pti *win32.ITypeInfo
+			pti, err = getImplTypeInfo(p, ^uint32(0))
+			if err == nil {
+				info.DualInterface, err = this.typeInfo(pti)
+				pti.Release()
+			}
+		}
+
+		this.addFields(p, pAttr, info)
+		this.addFuncs(p, pAttr, info, true)
+	}
+
+	if info.Kind == TKIND_MODULE {
+		this.addFields(p, pAttr, info)
+		this.addFuncs(p, pAttr, info, false)
+	}
+
+	//
+	if info.Kind == TKIND_COCLASS {
+
+		var implType win32.IMPLTYPEFLAGS
+		var ptiImpl *win32.ITypeInfo
+		var bsName com.BStr
+		var pImplAttr *win32.TYPEATTR
+
+		for n := uint32(0); n < uint32(pAttr.CImplTypes); n++ {
+			hr = p.GetImplTypeFlags(n, &implType)
+			if win32.FAILED(hr) {
+				break
+			}
+			ptiImpl, err = getImplTypeInfo(p, n)
+			if err != nil {
+				break
+			}
+
+			ptiImpl.GetDocumentation(win32.MEMBERID_NIL, bsName.PBSTR(), nil, nil, nil)
+			hr = ptiImpl.GetTypeAttr(&pImplAttr)
+			if win32.FAILED(hr) {
+				ptiImpl.Release()
+				err = newHrError("GetTypeAttr", int32(hr))
+				break
+			}
+
+			intf := &ImplType{
+				Name:          bsName.ToStringAndFree(),
+				Guid:          GUID(pImplAttr.Guid),
+				Default:       implType&win32.IMPLTYPEFLAG_FDEFAULT != 0,
+				Source:        implType&win32.IMPLTYPEFLAG_FSOURCE != 0,
+				DispInterface: pImplAttr.Typekind == win32.TKIND_DISPATCH,
+				CustData:      getImplTypeCustData(p, n),
+			}
+
+			info.ImplTypes = append(info.ImplTypes, intf)
+			ptiImpl.ReleaseTypeAttr(pImplAttr)
+			ptiImpl.Release()
+		}
+	}
+	if err != nil {
+		return withContext(err, info.Name, "", -1)
+	}
+	info.FuncCount = len(info.Funcs)
+	info.FieldCount = len(info.Fields)
+	return nil
+}
+
+// getImplTypeInfo returns the implemented type of index, -1 for the
+// interface of a dual dispinterface. The caller releases it.
+func getImplTypeInfo(p *win32.ITypeInfo, index uint32) (*win32.ITypeInfo, error) {
+	var hRefType win32.HREFTYPE
+	hr := p.GetRefTypeOfImplType(index, &hRefType)
+	if win32.FAILED(hr) {
+		return nil, newHrError("GetRefTypeOfImplType", int32(hr))
+	}
+	var ptiImpl *win32.ITypeInfo
+	hr = p.GetRefTypeInfo(hRefType, &ptiImpl)
+	if win32.FAILED(hr) {
+		return nil, newHrError("GetRefTypeInfo", int32(hr))
+	}
+	return ptiImpl, nil
+}
+
+// addFields reads the vars of the type, with the values of the constants
+func (this *comGraph) addFields(p *win32.ITypeInfo, pAttr *win32.TYPEATTR, info *TypeInfo) {
+	for n := 0; n < int(pAttr.CVars); n++ {
+		var pVarDesc *win32.VARDESC
+		hr := p.GetVarDesc(uint32(n), &pVarDesc)
+		if win32.FAILED(hr) {
+			info.addError(newHrError("GetVarDesc", int32(hr)), fmt.Sprintf("var %d", n))
+			continue
+		}
+
+		field, err := newFieldInfo(this, p, pVarDesc, pVarDesc.Varkind == win32.VAR_CONST)
+		p.ReleaseVarDesc(pVarDesc)
+		if err != nil {
+			info.addError(err, fmt.Sprintf("var %d", n))
+			continue
+		}
+
+		info.Fields = append(info.Fields, field)
+	}
+}
+
+// addFuncs reads the funcs of the type, with the dll entries of a module
+func (this *comGraph) addFuncs(p *win32.ITypeInfo, pAttr *win32.TYPEATTR, info *TypeInfo, dispFunc bool) {
+	for n := 0; n < int(pAttr.CFuncs); n++ {
+		var pFuncDesc *win32.FUNCDESC
+		hr := p.GetFuncDesc(uint32(n), &pFuncDesc)
+		if win32.FAILED(hr) {
+			info.addError(newHrError("GetFuncDesc", int32(hr)), fmt.Sprintf("func %d", n))
+			continue
+		}
+
+		fi, err := newFuncInfo(this, p, pAttr, pFuncDesc, dispFunc)
+		if err == nil && info.Kind == TKIND_MODULE {
+			var bsDllName, bsEntry com.BStr
+			var ordinal uint16
+			hr = p.GetDllEntry(pFuncDesc.Memid, pFuncDesc.Invkind,
+				bsDllName.PBSTR(), bsEntry.PBSTR(), &ordinal)
+			if win32.SUCCEEDED(hr) {
+				info.DllName = bsDllName.ToStringAndFree()
+				fi.DllEntry = bsEntry.ToStringAndFree()
+				fi.Ordinal = int(ordinal)
+			}
+		}
+		p.ReleaseFuncDesc(pFuncDesc)
+		if err != nil {
+			info.addError(err, fmt.Sprintf("func %d", n))
+			continue
+		}
+		info.Funcs = append(info.Funcs, fi)
+	}
+}
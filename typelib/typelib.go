@@ -1,54 +1,186 @@
 package typelib
 
 import (
-	"github.com/zzl/go-com/com"
-	"github.com/zzl/go-win32api/v2/win32"
+	"errors"
+	"github.com/zzl/go-tlbimp/utils"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 )
 
+// Source is what the code generator consumes. It is implemented by
+// TypeLib for the pure-Go readers and by ComTypeLib on windows.
+type Source interface {
+	GetName() string
+	GetDoc() string
+	GetHelpFile() string
+	GetHelpContext() uint32
+	GetCustData() []*CustData
+	GetLibAttr() *LibAttr
+	GetTypeInfoCount() int
+	GetTypeInfo(index int) (*TypeInfo, error)
+}
+
+type LibAttr struct {
+	Guid     GUID
+	Lcid     uint32
+	SysKind  SYSKIND
+	MajorVer uint16
+	MinorVer uint16
+	Flags    LIBFLAGS
+}
+
+// Arch returns the GOARCH the library was built for
+func (this *LibAttr) Arch() string {
+	if this.SysKind == SYS_WIN64 {
+		return "amd64"
+	}
+	return "386"
+}
+
+// TypeLib is a typelib decoded in memory, without the OS loader.
 type TypeLib struct {
-	p *win32.ITypeLib
+	name        string
+	doc         string
+	helpFile    string
+	helpContext uint32
+	attr        LibAttr
+	custData    []*CustData
+	entries     []*typeEntry
+	laidOut     bool //by layoutEntry, for sources without a layout
+
+	graph *typeGraph //the TypeInfos built for the current arch
 }
 
-func NewTypeLibFromFile(filePath string) (*TypeLib, error) {
-	var p *win32.ITypeLib
-	hr := win32.LoadTypeLib(win32.StrToPwstr(filePath), &p)
-	if win32.FAILED(hr) {
-		return nil, com.NewError(hr)
+func isDumpFile(filePath string) bool {
+	return strings.ToLower(filepath.Ext(filePath)) == ".json"
+}
+
+// readSourceFile reads a typelib, an idl source, a MIDL header, a winmd
+// file or a json dump without going through the OS loader.
+func readSourceFile(filePath string) (Source, error) {
+	if isDumpFile(filePath) {
+		dump, err := ReadTypeLibDumpFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return dump, nil
+	}
+	lib, err := ReadTypeLibFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return lib, nil
+}
+
+// ReadTypeLibFile reads a typelib file without going through the OS loader.
+// It may also be a PE image with an optional resource id suffix (server.dll\2),
+// an IDL/ODL source, a MIDL-generated header or a Windows metadata file.
+func ReadTypeLibFile(filePath string) (*TypeLib, error) {
+	if isIdlFile(filePath) {
+		return ReadIdlFile(filePath)
+	}
+	if isWinmdFile(filePath) {
+		return ReadWinmdFile(filePath)
+	}
+	if isHeaderFile(filePath) {
+		return ReadHeaderFile(filePath)
 	}
-	return NewTypeLib(p), nil
+	filePath, res := SplitResourcePath(filePath)
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	if isPE(data) {
+		data, err = ExtractTypeLibResource(data, res)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return NewTypeLibFromBytes(data)
 }
 
-func NewTypeLib(p *win32.ITypeLib) *TypeLib {
-	return &TypeLib{p: p}
+func NewTypeLibFromReader(r io.ReaderAt, size int64) (*TypeLib, error) {
+	data := make([]byte, size)
+	_, err := r.ReadAt(data, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	return NewTypeLibFromBytes(data)
 }
 
-func (this *TypeLib) Dispose() {
-	if this.p == nil {
-		return
+// NewTypeLibFromBytes decodes a MSFT or SLTG typelib.
+func NewTypeLibFromBytes(data []byte) (*TypeLib, error) {
+	if isMsft(data) {
+		r, err := readMsft(data)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeLib{name: r.name, doc: r.doc, helpFile: r.helpFile,
+			helpContext: r.helpContext, attr: r.attr, custData: r.custData,
+			entries: r.entries}, nil
+	}
+	if isSltg(data) {
+		r, err := readSltg(data)
+		if err != nil {
+			return nil, err
+		}
+		return &TypeLib{name: r.name, doc: r.doc, helpFile: r.helpFile,
+			helpContext: r.helpContext, attr: r.attr, custData: r.custData,
+			entries: r.entries}, nil
 	}
-	this.p.Release()
+	if isPE(data) {
+		tlbData, err := ExtractTypeLibResource(data, "")
+		if err != nil {
+			return nil, err
+		}
+		return NewTypeLibFromBytes(tlbData)
+	}
+	return nil, errors.New("unrecognized typelib format")
 }
 
 func (this *TypeLib) GetName() string {
-	var bs com.BStr
-	this.p.GetDocumentation(win32.MEMBERID_NIL, bs.PBSTR(), nil, nil, nil)
-	return bs.ToStringAndFree()
+	return this.name
 }
 
 func (this *TypeLib) GetDoc() string {
-	var bs com.BStr
-	this.p.GetDocumentation(win32.MEMBERID_NIL, bs.PBSTR(), nil, nil, nil)
-	return bs.ToStringAndFree()
+	return this.doc
+}
+
+func (this *TypeLib) GetHelpFile() string {
+	return this.helpFile
+}
+
+func (this *TypeLib) GetHelpContext() uint32 {
+	return this.helpContext
+}
+
+func (this *TypeLib) GetCustData() []*CustData {
+	return this.custData
+}
+
+func (this *TypeLib) GetLibAttr() *LibAttr {
+	attr := this.attr
+	return &attr
 }
 
 func (this *TypeLib) GetTypeInfoCount() int {
-	count := this.p.GetTypeInfoCount()
-	return int(count)
+	return len(this.entries)
 }
 
-func (this *TypeLib) GetTypeInfo(index int) *TypeInfo {
-	var pti *win32.ITypeInfo
-	hr := this.p.GetTypeInfo(uint32(index), &pti)
-	win32.ASSERT_SUCCEEDED(hr)
-	return NewTypeInfo(pti)
+func (this *TypeLib) GetTypeInfo(index int) (*TypeInfo, error) {
+	if index < 0 || index >= len(this.entries) {
+		return nil, newTypeError("no type at index " + strconv.Itoa(index))
+	}
+	if this.graph == nil || this.graph.arch != utils.Arch {
+		this.graph = newTypeGraph()
+	}
+	e := this.entries[index]
+	info := this.graph.typeInfo(e, e.kind)
+	if err := this.graph.typeInfoErr(e, e.kind); err != nil {
+		return nil, err
+	}
+	return info, nil
 }
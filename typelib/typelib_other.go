@@ -0,0 +1,9 @@
+//go:build !windows
+
+package typelib
+
+// NewTypeLibFromFile reads the typelib with the pure-Go readers,
+// there being no oleaut32 to load it.
+func NewTypeLibFromFile(filePath string) (Source, error) {
+	return readSourceFile(filePath)
+}
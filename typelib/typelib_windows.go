@@ -0,0 +1,117 @@
+package typelib
+
+import (
+	"github.com/zzl/go-com/com"
+	"github.com/zzl/go-tlbimp/utils"
+	"github.com/zzl/go-win32api/v2/win32"
+	"strconv"
+	"unsafe"
+)
+
+// ComTypeLib wraps a typelib loaded by oleaut32.
+type ComTypeLib struct {
+	p     *win32.ITypeLib
+	attr  LibAttr
+	graph *comGraph //the TypeInfos read for the current arch
+}
+
+// NewTypeLibFromFile loads a typelib with LoadTypeLib.
+// IDL/ODL sources, MIDL headers, winmd files and json dumps are read by
+// the pure-Go code.
+func NewTypeLibFromFile(filePath string) (Source, error) {
+	if isIdlFile(filePath) || isHeaderFile(filePath) || isWinmdFile(filePath) ||
+		isDumpFile(filePath) {
+		return readSourceFile(filePath)
+	}
+	var p *win32.ITypeLib
+	hr := win32.LoadTypeLib(win32.StrToPwstr(filePath), &p)
+	if win32.FAILED(hr) {
+		return nil, com.NewError(hr)
+	}
+	lib, err := NewComTypeLib(p)
+	if err != nil {
+		p.Release()
+		return nil, err
+	}
+	return lib, nil
+}
+
+// NewComTypeLib wraps p, taking over the reference to it on success
+func NewComTypeLib(p *win32.ITypeLib) (*ComTypeLib, error) {
+	var pAttr *win32.TLIBATTR
+	hr := p.GetLibAttr(&pAttr)
+	if win32.FAILED(hr) {
+		return nil, newHrError("GetLibAttr", int32(hr))
+	}
+	defer p.ReleaseTLibAttr(pAttr)
+	return &ComTypeLib{p: p, attr: LibAttr{
+		Guid:     GUID(pAttr.Guid),
+		Lcid:     pAttr.Lcid,
+		SysKind:  SYSKIND(pAttr.Syskind),
+		MajorVer: pAttr.WMajorVerNum,
+		MinorVer: pAttr.WMinorVerNum,
+		Flags:    LIBFLAGS(pAttr.WLibFlags),
+	}}, nil
+}
+
+func (this *ComTypeLib) Dispose() {
+	this.p.Release()
+}
+
+func (this *ComTypeLib) GetName() string {
+	var bs com.BStr
+	this.p.GetDocumentation(win32.MEMBERID_NIL, bs.PBSTR(), nil, nil, nil)
+	return bs.ToStringAndFree()
+}
+
+func (this *ComTypeLib) GetDoc() string {
+	var bs com.BStr
+	this.p.GetDocumentation(win32.MEMBERID_NIL, nil, bs.PBSTR(), nil, nil)
+	return bs.ToStringAndFree()
+}
+
+func (this *ComTypeLib) GetHelpFile() string {
+	var bs com.BStr
+	this.p.GetDocumentation(win32.MEMBERID_NIL, nil, nil, nil, bs.PBSTR())
+	return bs.ToStringAndFree()
+}
+
+func (this *ComTypeLib) GetHelpContext() uint32 {
+	var ctx uint32
+	this.p.GetDocumentation(win32.MEMBERID_NIL, nil, nil, &ctx, nil)
+	return ctx
+}
+
+func (this *ComTypeLib) GetCustData() []*CustData {
+	var p2 *win32.ITypeLib2
+	hr := this.p.QueryInterface(&win32.IID_ITypeLib2, unsafe.Pointer(&p2))
+	if win32.FAILED(hr) {
+		return nil
+	}
+	defer p2.Release()
+	var custData win32.CUSTDATA
+	return custDataList(p2.GetAllCustData(&custData), &custData)
+}
+
+func (this *ComTypeLib) GetLibAttr() *LibAttr {
+	attr := this.attr
+	return &attr
+}
+
+func (this *ComTypeLib) GetTypeInfoCount() int {
+	count := this.p.GetTypeInfoCount()
+	return int(count)
+}
+
+func (this *ComTypeLib) GetTypeInfo(index int) (*TypeInfo, error) {
+	var pti *win32.ITypeInfo
+	hr := this.p.GetTypeInfo(uint32(index), &pti)
+	if win32.FAILED(hr) {
+		return nil, newHrError("GetTypeInfo("+strconv.Itoa(index)+")", int32(hr))
+	}
+	defer pti.Release()
+	if this.graph == nil || this.graph.arch != utils.Arch {
+		this.graph = newComGraph(this.attr.Guid)
+	}
+	return this.graph.typeInfo(pti)
+}
@@ -1,21 +1,26 @@
 package typelib
 
 import (
-	"github.com/zzl/go-com/com"
 	"github.com/zzl/go-tlbimp/utils"
-	"github.com/zzl/go-win32api/v2/win32"
 	"strconv"
-	"strings"
-	"syscall"
-	"unsafe"
 )
 
+// VarType is a type as the typelib has it: its Vt, the kind of the type
+// VT_USERDEFINED refers to, and the types it is built from, with the Go
+// type it is named by in Name. Code should decide by the Vt, as the names
+// are those of GoTypeNames, which may be changed.
 type VarType struct {
 	Name  string
 	Size  int
 	Align int
 
+	Vt       VARENUM  //of an alias, that of the aliased type
+	Kind     TYPEKIND //of the type of VT_USERDEFINED
+	Dims     []int    //of VT_CARRAY
+	TypeName string   //of a user defined type or alias, as the typelib has it
+
 	Native        bool //numbers,uintptr
+	Enum          bool //named, with its int type in Underlying
 	Unsigned      bool
 	Pointer       bool //*,unsafe.Pointer
 	Array         bool //[]
@@ -23,361 +28,252 @@ type VarType struct {
 	Interface     bool //com
 	DispInterface bool //com
 
-	RefType *VarType
+	RefType    *VarType //pointee of pointers, element of VT_CARRAY
+	Underlying *VarType //for enum
+
+	TypeInfo *TypeInfo //the user defined type, if the library has it
 
 	PVarCastExpr string
+
+	ImpLib *ImpLib //for a user defined type of another library
 }
 
-func NewVarType(pTypeInfo *win32.ITypeInfo, pTypeDesc *win32.TYPEDESC) *VarType {
-	return _newVarType(pTypeInfo, pTypeDesc, true)
+// Pointee returns the type a pointer points to: that of a VT_PTR, the
+// interface of VT_UNKNOWN and VT_DISPATCH or the chars of VT_BSTR; nil
+// if it is not a pointer or the pointee is not resolved
+func (this *VarType) Pointee() *VarType {
+	if !this.Pointer {
+		return nil
+	}
+	return this.RefType
 }
 
-func _newVarType(pTypeInfo *win32.ITypeInfo, pTypeDesc *win32.TYPEDESC,
-	resolveIndirectRefType bool) *VarType {
+// Elem returns the element type of a VT_CARRAY, nil if it is not one
+func (this *VarType) Elem() *VarType {
+	if this.Vt != VT_CARRAY {
+		return nil
+	}
+	return this.RefType
+}
 
-	var t VarType
-	switch win32.VARENUM(pTypeDesc.Vt) {
-	case win32.VT_I2:
-		t.Name = "int16"
+// IsUserDefined tells if the type is a VT_USERDEFINED of kind
+func (this *VarType) IsUserDefined(kind TYPEKIND) bool {
+	return this.Vt == VT_USERDEFINED && this.Kind == kind
+}
+
+// GoTypeNames maps the automation VTs to the Go types they are named by.
+// Pointers and arrays are named after their element types, and user
+// defined types after their own names. It may be changed before the
+// types are read.
+var GoTypeNames = map[VARENUM]string{
+	VT_I2:        "int16",
+	VT_I4:        "int32",
+	VT_R4:        "float32",
+	VT_R8:        "float64",
+	VT_CY:        "win32.CY",
+	VT_DATE:      "ole.Date",
+	VT_BSTR:      "win32.BSTR",
+	VT_DISPATCH:  "*win32.IDispatch",
+	VT_ERROR:     "win32.HRESULT",
+	VT_BOOL:      "win32.VARIANT_BOOL",
+	VT_VARIANT:   "win32.VARIANT",
+	VT_UNKNOWN:   "*win32.IUnknown",
+	VT_DECIMAL:   "win32.DECIMAL",
+	VT_I1:        "int8",
+	VT_UI1:       "byte",
+	VT_UI2:       "uint16",
+	VT_UI4:       "uint32",
+	VT_I8:        "int64",
+	VT_UI8:       "uint64",
+	VT_INT:       "int32",
+	VT_UINT:      "uint32",
+	VT_VOID:      "",
+	VT_HRESULT:   "win32.HRESULT",
+	VT_PTR:       "unsafe.Pointer", //to void, or unresolved
+	VT_SAFEARRAY: "*win32.SAFEARRAY",
+	VT_LPSTR:     "win32.PSTR",
+	VT_LPWSTR:    "win32.PWSTR",
+	VT_INT_PTR:   "uintptr",
+	VT_UINT_PTR:  "uintptr",
+}
+
+// newAutomationVarType returns the type of vt, false if it is built from
+// other types or unknown
+func newAutomationVarType(vt VARENUM, resolveIndirectRefType bool) (VarType, bool) {
+	t := VarType{Vt: vt, Name: GoTypeNames[vt]}
+	switch vt {
+	case VT_I2:
 		t.Size = 2
 		t.Native = true
 		t.PVarCastExpr = "$.IValVal()"
-	case win32.VT_I4:
-		t.Name = "int32"
+	case VT_I4, VT_INT:
 		t.Size = 4
 		t.Native = true
 		t.PVarCastExpr = "$.LValVal()"
-	case win32.VT_R4:
-		t.Name = "float32"
+	case VT_R4:
 		t.Size = 4
 		t.Native = true
 		t.PVarCastExpr = "$.FltValVal()"
-	case win32.VT_R8:
-		t.Name = "float64"
+	case VT_R8:
 		t.Size = 8
 		t.Native = true
 		t.PVarCastExpr = "$.DblValVal()"
-	case win32.VT_CY:
-		t.Name = "win32.CY"
+	case VT_CY:
 		t.Size = 8
 		t.Struct = true
 		t.PVarCastExpr = "$.CyValVal()"
-	case win32.VT_DATE:
-		t.Name = "ole.Date"
+	case VT_DATE:
 		t.Size = 8
 		t.Native = true
 		t.PVarCastExpr = "ole.Date($.DateVal())"
-	case win32.VT_BSTR:
-		t.Name = "win32.BSTR"
+	case VT_BSTR:
 		t.Pointer = true
 		t.Size = utils.PtrSize
 		if resolveIndirectRefType {
 			t.RefType = &VarType{
-				Name:   "uint16",
+				Name:   GoTypeNames[VT_UI2],
+				Vt:     VT_UI2,
 				Native: true,
 			}
 		}
 		t.PVarCastExpr = "$.BstrValVal()"
-	case win32.VT_DISPATCH:
-		t.Name = "*win32.IDispatch"
+	case VT_DISPATCH:
 		t.Pointer = true
 		t.Size = utils.PtrSize
 		if resolveIndirectRefType {
 			t.RefType = &VarType{
 				Name:      "win32.IDispatch",
+				Vt:        VT_USERDEFINED,
+				Kind:      TKIND_INTERFACE,
 				Interface: true,
 			}
 		}
 		t.PVarCastExpr = "$.PdispValVal()"
-	case win32.VT_ERROR:
-		t.Name = "win32.HRESULT"
+	case VT_ERROR:
 		t.Size = 4
 		t.Native = true
 		t.PVarCastExpr = "$.ScodeVal()"
-	case win32.VT_BOOL:
-		t.Name = "win32.VARIANT_BOOL"
+	case VT_BOOL:
 		t.Size = 2
 		t.Native = true
 		t.PVarCastExpr = "$.BoolValVal()"
-	case win32.VT_VARIANT:
-		t.Name = "win32.VARIANT"
-		v := win32.VARIANT{}
-		t.Size = int(unsafe.Sizeof(v))
-		t.Align = int(unsafe.Alignof(v))
+	case VT_VARIANT:
+		t.Size, t.Align = variantSize()
 		t.Struct = true
 		t.PVarCastExpr = "*$"
-	case win32.VT_UNKNOWN:
-		t.Name = "*win32.IUnknown"
+	case VT_UNKNOWN:
 		t.Size = utils.PtrSize
 		t.Pointer = true
 		if resolveIndirectRefType {
 			t.RefType = &VarType{
 				Name:      "win32.IUnknown",
+				Vt:        VT_USERDEFINED,
+				Kind:      TKIND_INTERFACE,
 				Interface: true,
 			}
 		}
 		t.PVarCastExpr = "$.PunkValVal()"
-	case win32.VT_DECIMAL:
-		t.Name = "win32.DECIMAL"
-		d := win32.DECIMAL{}
-		t.Size = int(unsafe.Sizeof(d))
-		t.Align = int(unsafe.Alignof(d))
+	case VT_DECIMAL:
+		t.Size, t.Align = decimalSize()
 		t.Struct = true
 		t.PVarCastExpr = "$.DecValVal()"
-	case win32.VT_I1:
-		t.Name = "int8"
+	case VT_I1:
 		t.Size = 1
 		t.Native = true
 		t.PVarCastExpr = "int8($.CValVal())"
-	case win32.VT_UI1:
-		t.Name = "byte"
+	case VT_UI1:
 		t.Size = 1
 		t.Unsigned = true
 		t.Native = true
 		t.PVarCastExpr = "$.BValVal()"
-	case win32.VT_UI2:
-		t.Name = "uint16"
+	case VT_UI2:
 		t.Size = 2
 		t.Unsigned = true
 		t.Native = true
 		t.PVarCastExpr = "$.UiValVal()"
-	case win32.VT_UI4:
-		t.Name = "uint32"
+	case VT_UI4:
 		t.Size = 4
 		t.Unsigned = true
 		t.Native = true
 		t.PVarCastExpr = "$.UintValVal()"
-	case win32.VT_I8:
-		t.Name = "int64"
+	case VT_I8:
 		t.Size = 8
 		t.Native = true
 		t.PVarCastExpr = "$.LlValVal()"
-	case win32.VT_UI8:
-		t.Name = "uint64"
+	case VT_UI8:
 		t.Size = 8
 		t.Unsigned = true
 		t.Native = true
 		t.PVarCastExpr = "$.UllValVal()"
-	case win32.VT_INT:
-		t.Name = "int32"
-		t.Size = 4
-		t.Native = true
-		t.PVarCastExpr = "$.LValVal()"
-	case win32.VT_UINT:
-		t.Name = "uint32"
+	case VT_UINT:
 		t.Size = 4
 		t.Native = true
 		t.PVarCastExpr = "$.UintValVal()"
-	case win32.VT_VOID:
-		t.Name = ""
+	case VT_VOID:
 		t.Size = 0
-	case win32.VT_HRESULT:
-		t.Name = "win32.HRESULT"
+	case VT_HRESULT:
 		t.Size = 4
 		t.PVarCastExpr = "$.ScodeVal()"
-	case win32.VT_PTR:
-		if resolveIndirectRefType {
-			t.RefType = NewVarType(pTypeInfo, pTypeDesc.LptdescVal())
-			if t.RefType.Name == "" { //void
-				t.Name = "unsafe.Pointer"
-			} else if t.RefType.Name == "unsafe.Pointer" {
-				t.Name = "unsafe.Pointer"
-			} else {
-				t.Name = "*" + t.RefType.Name
-			}
-		} else {
-			t.Name = "unsafe.Pointer"
-		}
+	case VT_SAFEARRAY:
 		t.Pointer = true
 		t.Size = utils.PtrSize
-		//t.PVarCastExpr = "??"
-	case win32.VT_CARRAY:
-		t.RefType = _newVarType(pTypeInfo, &pTypeDesc.LpadescVal().TdescElem, resolveIndirectRefType)
-		t.Array = true
-		dimCount := int(pTypeDesc.LpadescVal().CDims)
-		bounds := unsafe.Slice((*win32.SAFEARRAYBOUND)(
-			unsafe.Pointer(&pTypeDesc.LpadescVal().Rgbounds)), dimCount)
-		t.Name = ""
-		totalElemCount := 0
-		for n, b := range bounds {
-			elemCount := int(b.CElements)
-			if n == 0 {
-				totalElemCount = elemCount
-			} else {
-				totalElemCount *= elemCount
-			}
-			t.Name += "[" + strconv.Itoa(elemCount) + "]"
-		}
-		t.Name += t.RefType.Name
-		t.Size = totalElemCount * t.RefType.Size
-		t.Align = t.RefType.Align
-	case win32.VT_SAFEARRAY:
-		t.Name = "*win32.SAFEARRAY"
-		a := win32.SAFEARRAY{}
-		t.Size = int(unsafe.Sizeof(a))
-		t.Align = int(unsafe.Alignof(a))
-		t.Struct = true
 		t.PVarCastExpr = "$.ParrayVal()"
-	case win32.VT_USERDEFINED:
-		var ptiRef *win32.ITypeInfo
-		hr := pTypeInfo.GetRefTypeInfo(pTypeDesc.HreftypeVal(), &ptiRef)
-		defer ptiRef.Release()
-		win32.ASSERT_SUCCEEDED(hr)
-
-		var bs com.BStr
-		hr = ptiRef.GetDocumentation(win32.MEMBERID_NIL, bs.PBSTR(), nil, nil, nil)
-		win32.ASSERT_SUCCEEDED(hr)
-		//
-		t.Name = utils.CapName(bs.ToStringAndFree())
-
-		if strings.HasPrefix(t.Name, "MIDL_IWinTypes") {
-			t.Native = true
-			t.Name = "uintptr"
-			t.Unsigned = true
-			t.Size = utils.PtrSize
-			break
-		}
-
-		if strings.HasPrefix(t.Name, "Wire") { //?
-			t.Name = "win32." + t.Name[4:]
-			t.Native = true
-			t.Unsigned = true
-			t.Size = utils.PtrSize
-			break
-		}
-
-		var ptaRef *win32.TYPEATTR
-		ptiRef.GetTypeAttr(&ptaRef)
-		defer ptiRef.ReleaseTypeAttr(ptaRef)
-
-		switch ptaRef.Typekind {
-		case win32.TKIND_ENUM:
-			//t.Native = true
-			var pVarDesc *win32.VARDESC
-			ptiRef.GetVarDesc(0, &pVarDesc)
-			t = *NewVarType(ptiRef, &pVarDesc.ElemdescVar.Tdesc)
-		case win32.TKIND_RECORD:
-			if t.Name == "GUID" {
-				t.Name = "syscall.GUID"
-				t.Struct = true
-				g := syscall.GUID{}
-				t.Size = int(unsafe.Sizeof(g))
-				t.Align = int(unsafe.Alignof(g))
-				break
-			}
-			t.Struct = true
-			t.Size, t.Align = getStructSize(ptiRef, ptaRef)
-		case win32.TKIND_COCLASS: //?
-			t.Interface = true
-			var pta *win32.TYPEATTR
-			ptiRef.GetTypeAttr(&pta)
-			for n := uint32(0); n < uint32(pta.CImplTypes); n++ {
-				var implType win32.IMPLTYPEFLAGS
-				hr = ptiRef.GetImplTypeFlags(n, &implType)
-				win32.ASSERT_SUCCEEDED(hr)
-				var hRefType win32.HREFTYPE
-				hr = ptiRef.GetRefTypeOfImplType(n, &hRefType)
-				win32.ASSERT_SUCCEEDED(hr)
-				var ptiImpl *win32.ITypeInfo
-				hr = ptiRef.GetRefTypeInfo(hRefType, &ptiImpl)
-				win32.ASSERT_SUCCEEDED(hr)
-				var ptaImpl *win32.TYPEATTR
-				ptiImpl.GetTypeAttr(&ptaImpl)
-				if implType&win32.IMPLTYPEFLAG_FDEFAULT != 0 &&
-					implType&win32.IMPLTYPEFLAG_FSOURCE == 0 {
-					t.DispInterface = ptaImpl.Typekind == win32.TKIND_DISPATCH
-				}
-				ptiImpl.ReleaseTypeAttr(ptaImpl)
-				ptiImpl.Release()
-			}
-			ptiRef.ReleaseTypeAttr(pta)
-		case win32.TKIND_INTERFACE:
-			t.Interface = true
-		case win32.TKIND_DISPATCH:
-			t.Interface = true
-			t.DispInterface = true
-		case win32.TKIND_ALIAS:
-			if t.Name == "GUID" {
-				t.Name = "syscall.GUID"
-				t.Struct = true
-				g := syscall.GUID{}
-				t.Size = int(unsafe.Sizeof(g))
-				t.Align = int(unsafe.Alignof(g))
-				break
-			}
-			name0 := t.Name
-			t.RefType = _newVarType(ptiRef, &ptaRef.TdescAlias, resolveIndirectRefType)
-			t = *t.RefType
-			if t.Native {
-				//
-			} else {
-				t.Name = name0
-			}
-		case win32.TKIND_UNION:
-			t.Struct = true
-			t.Size, t.Align = getUnionSize(ptiRef, ptaRef)
-		}
-	case win32.VT_LPSTR:
-		t.Name = "win32.PSTR"
-		t.Pointer = true
-		t.Size = utils.PtrSize
-	case win32.VT_LPWSTR:
-		t.Name = "win32.PWSTR"
+	case VT_LPSTR, VT_LPWSTR:
 		t.Pointer = true
 		t.Size = utils.PtrSize
-	case win32.VT_INT_PTR:
-		t.Name = "uintptr"
-		t.Native = true
-		t.Size = utils.PtrSize
-	case win32.VT_UINT_PTR:
-		t.Name = "uintptr"
+	case VT_INT_PTR, VT_UINT_PTR:
 		t.Native = true
 		t.Size = utils.PtrSize
 	default:
-		panic("???")
-	}
-	if t.Align == 0 {
-		t.Align = t.Size
+		return t, false
 	}
-	return &t
+	return t, true
 }
 
-func getStructSize(pti *win32.ITypeInfo, pta *win32.TYPEATTR) (int, int) {
-	count := int(pta.CVars)
-	fieldSizes := make([]utils.SizeInfo, count)
-
-	for n := 0; n < count; n++ {
-		var pvd *win32.VARDESC
-		hr := pti.GetVarDesc(uint32(n), &pvd)
-		win32.ASSERT_SUCCEEDED(hr)
-		vt := _newVarType(pti, &pvd.ElemdescVar.Tdesc, false)
-		fieldSizes[n] = utils.SizeInfo{
-			vt.Size, vt.Align,
-		}
-		pti.ReleaseVarDesc(pvd)
+// setPtrVarType makes t a VT_PTR to ref, nil if it is not resolved
+func setPtrVarType(t *VarType, ref *VarType) {
+	t.Vt = VT_PTR
+	t.RefType = ref
+	if ref == nil || ref.Name == "" || ref.Name == GoTypeNames[VT_PTR] {
+		t.Name = GoTypeNames[VT_PTR]
+	} else {
+		t.Name = "*" + ref.Name
 	}
-	size := utils.StructSize(fieldSizes...)
-	return size.TotalSize, size.AlignSize
+	t.Pointer = true
+	t.Size = utils.PtrSize
 }
 
-func getUnionSize(pti *win32.ITypeInfo, pta *win32.TYPEATTR) (int, int) {
-	count := int(pta.CVars)
-	var maxSize, maxAlign int
-	for n := 0; n < count; n++ {
-		var pvd *win32.VARDESC
-		hr := pti.GetVarDesc(uint32(n), &pvd)
-		win32.ASSERT_SUCCEEDED(hr)
-		vt := _newVarType(pti, &pvd.ElemdescVar.Tdesc, false)
-		if vt.Align == 0 {
-			vt.Align = vt.Size
-		}
-		if vt.Size > maxSize {
-			maxSize = vt.Size
-		}
-		if vt.Align > maxAlign {
-			maxAlign = vt.Align
+// setArrayVarType makes t a VT_CARRAY of elem with dims
+func setArrayVarType(t *VarType, elem *VarType, dims []int) {
+	t.Vt = VT_CARRAY
+	t.RefType = elem
+	t.Dims = dims
+	t.Array = true
+	t.Name = ""
+	totalElemCount := 0
+	for n, elemCount := range dims {
+		if n == 0 {
+			totalElemCount = elemCount
+		} else {
+			totalElemCount *= elemCount
 		}
-		pti.ReleaseVarDesc(pvd)
+		t.Name += "[" + strconv.Itoa(elemCount) + "]"
 	}
-	return maxSize, maxAlign
+	t.Name += elem.Name
+	t.Size = totalElemCount * elem.Size
+	t.Align = elem.Align
+}
+
+//sizes and alignments of the ole structs, as laid out by win32
+
+func variantSize() (int, int) {
+	return 8 + 2*utils.PtrSize, 8
+}
+
+func decimalSize() (int, int) {
+	return 16, 8
+}
+
+func guidSize() (int, int) {
+	return 16, 4
 }
@@ -0,0 +1,247 @@
+package typelib
+
+import (
+	"fmt"
+	"github.com/zzl/go-com/com"
+	"github.com/zzl/go-tlbimp/utils"
+	"github.com/zzl/go-win32api/v2/win32"
+	"strings"
+	"unsafe"
+)
+
+func NewVarType(pTypeInfo *win32.ITypeInfo, pTypeDesc *win32.TYPEDESC) (*VarType, error) {
+	return _newVarType(nil, pTypeInfo, pTypeDesc, true)
+}
+
+// _newVarType reads the type of pTypeDesc, the user defined ones pointing
+// to their TypeInfo in g if not nil
+func _newVarType(g *comGraph, pTypeInfo *win32.ITypeInfo, pTypeDesc *win32.TYPEDESC,
+	resolveIndirectRefType bool) (*VarType, error) {
+
+	t, ok := newAutomationVarType(VARENUM(pTypeDesc.Vt), resolveIndirectRefType)
+	if ok {
+		if t.Align == 0 {
+			t.Align = t.Size
+		}
+		return &t, nil
+	}
+	var err error
+	switch win32.VARENUM(pTypeDesc.Vt) {
+	case win32.VT_PTR:
+		var ref *VarType
+		if resolveIndirectRefType {
+			ref, err = _newVarType(g, pTypeInfo, pTypeDesc.LptdescVal(), true)
+			if err != nil {
+				return nil, err
+			}
+		}
+		setPtrVarType(&t, ref)
+	case win32.VT_CARRAY:
+		elem, err := _newVarType(g, pTypeInfo, &pTypeDesc.LpadescVal().TdescElem, resolveIndirectRefType)
+		if err != nil {
+			return nil, err
+		}
+		dimCount := int(pTypeDesc.LpadescVal().CDims)
+		bounds := unsafe.Slice((*win32.SAFEARRAYBOUND)(
+			unsafe.Pointer(&pTypeDesc.LpadescVal().Rgbounds)), dimCount)
+		dims := make([]int, dimCount)
+		for n, b := range bounds {
+			dims[n] = int(b.CElements)
+		}
+		setArrayVarType(&t, elem, dims)
+	case win32.VT_USERDEFINED:
+		var ptiRef *win32.ITypeInfo
+		hr := pTypeInfo.GetRefTypeInfo(pTypeDesc.HreftypeVal(), &ptiRef)
+		if win32.FAILED(hr) {
+			return nil, newHrError("GetRefTypeInfo", int32(hr))
+		}
+		defer ptiRef.Release()
+
+		var bs com.BStr
+		hr = ptiRef.GetDocumentation(win32.MEMBERID_NIL, bs.PBSTR(), nil, nil, nil)
+		if win32.FAILED(hr) {
+			return nil, newHrError("GetDocumentation", int32(hr))
+		}
+		var ptaRef *win32.TYPEATTR
+		hr = ptiRef.GetTypeAttr(&ptaRef)
+		if win32.FAILED(hr) {
+			return nil, newHrError("GetTypeAttr", int32(hr))
+		}
+		defer ptiRef.ReleaseTypeAttr(ptaRef)
+
+		//
+		t.Vt = VT_USERDEFINED
+		t.Kind = TYPEKIND(ptaRef.Typekind)
+		t.TypeName = bs.ToStringAndFree()
+		t.Name = utils.CapName(t.TypeName)
+
+		if strings.HasPrefix(t.Name, "MIDL_IWinTypes") {
+			t.Native = true
+			t.Name = "uintptr"
+			t.Unsigned = true
+			t.Size = utils.PtrSize
+			break
+		}
+
+		if strings.HasPrefix(t.Name, "Wire") { //?
+			t.Name = "win32." + t.Name[4:]
+			t.Native = true
+			t.Unsigned = true
+			t.Size = utils.PtrSize
+			break
+		}
+
+		switch ptaRef.Typekind {
+		case win32.TKIND_ENUM:
+			//t.Native = true
+			var pVarDesc *win32.VARDESC
+			hr = ptiRef.GetVarDesc(0, &pVarDesc)
+			if win32.FAILED(hr) {
+				return nil, newHrError("GetVarDesc", int32(hr))
+			}
+			base, err := _newVarType(g, ptiRef, &pVarDesc.ElemdescVar.Tdesc, true)
+			ptiRef.ReleaseVarDesc(pVarDesc)
+			if err != nil {
+				return nil, err
+			}
+			setEnumVarType(&t, base)
+		case win32.TKIND_RECORD:
+			if t.Name == "GUID" {
+				setGuidVarType(&t)
+				break
+			}
+			t.Struct = true
+			t.Size, t.Align, err = getStructSize(ptiRef, ptaRef)
+		case win32.TKIND_COCLASS: //?
+			t.Interface = true
+			for n := uint32(0); n < uint32(ptaRef.CImplTypes); n++ {
+				var implType win32.IMPLTYPEFLAGS
+				hr = ptiRef.GetImplTypeFlags(n, &implType)
+				if win32.FAILED(hr) {
+					return nil, newHrError("GetImplTypeFlags", int32(hr))
+				}
+				if implType&win32.IMPLTYPEFLAG_FDEFAULT == 0 ||
+					implType&win32.IMPLTYPEFLAG_FSOURCE != 0 {
+					continue
+				}
+				t.DispInterface, err = isDispImplType(ptiRef, n)
+				if err != nil {
+					return nil, err
+				}
+			}
+		case win32.TKIND_INTERFACE:
+			t.Interface = true
+		case win32.TKIND_DISPATCH:
+			t.Interface = true
+			t.DispInterface = true
+		case win32.TKIND_ALIAS:
+			if t.Name == "GUID" {
+				setGuidVarType(&t)
+				break
+			}
+			name0, typeName := t.Name, t.TypeName
+			t.RefType, err = _newVarType(g, ptiRef, &ptaRef.TdescAlias, resolveIndirectRefType)
+			if err != nil {
+				return nil, err
+			}
+			t = *t.RefType
+			t.TypeName = typeName
+			if t.Native {
+				//
+			} else {
+				t.Name = name0
+			}
+		case win32.TKIND_UNION:
+			t.Struct = true
+			t.Size, t.Align, err = getUnionSize(ptiRef, ptaRef)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if g != nil {
+			t.TypeInfo, _ = g.typeInfo(ptiRef)
+		}
+		t.ImpLib = getImpLib(pTypeInfo, ptiRef)
+	default:
+		return nil, newTypeError(fmt.Sprintf("unsupported VT 0x%x", pTypeDesc.Vt))
+	}
+	if t.Align == 0 {
+		t.Align = t.Size
+	}
+	return &t, nil
+}
+
+// isDispImplType tells if the implemented type of index is a dispinterface
+func isDispImplType(pti *win32.ITypeInfo, index uint32) (bool, error) {
+	var hRefType win32.HREFTYPE
+	hr := pti.GetRefTypeOfImplType(index, &hRefType)
+	if win32.FAILED(hr) {
+		return false, newHrError("GetRefTypeOfImplType", int32(hr))
+	}
+	var ptiImpl *win32.ITypeInfo
+	hr = pti.GetRefTypeInfo(hRefType, &ptiImpl)
+	if win32.FAILED(hr) {
+		return false, newHrError("GetRefTypeInfo", int32(hr))
+	}
+	defer ptiImpl.Release()
+	var ptaImpl *win32.TYPEATTR
+	hr = ptiImpl.GetTypeAttr(&ptaImpl)
+	if win32.FAILED(hr) {
+		return false, newHrError("GetTypeAttr", int32(hr))
+	}
+	defer ptiImpl.ReleaseTypeAttr(ptaImpl)
+	return ptaImpl.Typekind == win32.TKIND_DISPATCH, nil
+}
+
+func getStructSize(pti *win32.ITypeInfo, pta *win32.TYPEATTR) (int, int, error) {
+	count := int(pta.CVars)
+	fieldSizes := make([]utils.SizeInfo, count)
+
+	for n := 0; n < count; n++ {
+		var pvd *win32.VARDESC
+		hr := pti.GetVarDesc(uint32(n), &pvd)
+		if win32.FAILED(hr) {
+			return 0, 0, newHrError("GetVarDesc", int32(hr))
+		}
+		vt, err := _newVarType(nil, pti, &pvd.ElemdescVar.Tdesc, false)
+		pti.ReleaseVarDesc(pvd)
+		if err != nil {
+			return 0, 0, err
+		}
+		fieldSizes[n] = utils.SizeInfo{
+			TotalSize: vt.Size, AlignSize: vt.Align,
+		}
+	}
+	size := utils.StructSize(fieldSizes...)
+	return size.TotalSize, size.AlignSize, nil
+}
+
+func getUnionSize(pti *win32.ITypeInfo, pta *win32.TYPEATTR) (int, int, error) {
+	count := int(pta.CVars)
+	var maxSize, maxAlign int
+	for n := 0; n < count; n++ {
+		var pvd *win32.VARDESC
+		hr := pti.GetVarDesc(uint32(n), &pvd)
+		if win32.FAILED(hr) {
+			return 0, 0, newHrError("GetVarDesc", int32(hr))
+		}
+		vt, err := _newVarType(nil, pti, &pvd.ElemdescVar.Tdesc, false)
+		pti.ReleaseVarDesc(pvd)
+		if err != nil {
+			return 0, 0, err
+		}
+		if vt.Align == 0 {
+			vt.Align = vt.Size
+		}
+		if vt.Size > maxSize {
+			maxSize = vt.Size
+		}
+		if vt.Align > maxAlign {
+			maxAlign = vt.Align
+		}
+	}
+	if maxAlign > 1 {
+		maxSize = (maxSize + maxAlign - 1) / maxAlign * maxAlign
+	}
+	return maxSize, maxAlign, nil
+}
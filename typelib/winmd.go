@@ -0,0 +1,1086 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Reader for Windows metadata (.winmd) files, as produced for WinRT and
+// by the win32metadata project. Interfaces, delegates, structs, enums
+// and literal constants are mapped to the typelib descriptors, WinRT
+// members being given their ABI signature: an HRESULT return with the
+// result as an [out, retval] parameter and arrays passed as a length
+// and a pointer. Runtime classes are referred to by their default
+// interface; generic types have no typelib counterpart and are skipped,
+// references to them becoming IUnknown pointers.
+//
+// Types of other metadata files are looked up in the files named after
+// their assembly next to the one being read, like importlib does.
+
+// TypeAttributes, FieldAttributes, MethodAttributes and ParamAttributes
+const (
+	mdTdInterface      = 0x20
+	mdTdLayoutMask     = 0x18
+	mdTdExplicitLayout = 0x10
+	mdTdWindowsRuntime = 0x4000
+
+	mdFdStatic  = 0x10
+	mdFdLiteral = 0x40
+
+	mdMdStatic      = 0x10
+	mdMdSpecialName = 0x800
+
+	mdPdIn         = 0x1
+	mdPdOut        = 0x2
+	mdPdOptional   = 0x10
+	mdPdHasDefault = 0x1000
+)
+
+// signature element types
+const (
+	elemVoid        = 0x01
+	elemBoolean     = 0x02
+	elemChar        = 0x03
+	elemI1          = 0x04
+	elemU1          = 0x05
+	elemI2          = 0x06
+	elemU2          = 0x07
+	elemI4          = 0x08
+	elemU4          = 0x09
+	elemI8          = 0x0a
+	elemU8          = 0x0b
+	elemR4          = 0x0c
+	elemR8          = 0x0d
+	elemString      = 0x0e
+	elemPtr         = 0x0f
+	elemByRef       = 0x10
+	elemValueType   = 0x11
+	elemClass       = 0x12
+	elemVar         = 0x13
+	elemArray       = 0x14
+	elemGenericInst = 0x15
+	elemTypedByRef  = 0x16
+	elemI           = 0x18
+	elemU           = 0x19
+	elemFnPtr       = 0x1b
+	elemObject      = 0x1c
+	elemSzArray     = 0x1d
+	elemMVar        = 0x1e
+	elemCModReqd    = 0x1f
+	elemCModOpt     = 0x20
+	elemPinned      = 0x45
+)
+
+var winmdElemTypes = map[byte]VARENUM{
+	elemBoolean: VT_UI1,
+	elemChar:    VT_UI2,
+	elemI1:      VT_I1,
+	elemU1:      VT_UI1,
+	elemI2:      VT_I2,
+	elemU2:      VT_UI2,
+	elemI4:      VT_I4,
+	elemU4:      VT_UI4,
+	elemI8:      VT_I8,
+	elemU8:      VT_UI8,
+	elemR4:      VT_R4,
+	elemR8:      VT_R8,
+	elemI:       VT_INT_PTR,
+	elemU:       VT_UINT_PTR,
+}
+
+const (
+	winmdInspectable = "Windows.Win32.System.WinRT.IInspectable"
+	winmdHString     = "Windows.Win32.System.WinRT.HSTRING"
+)
+
+// types that have a typelib counterpart, by full name
+var winmdKnownTypes = map[string]VARENUM{
+	"System.Guid":                             VT_CLSID,
+	"Windows.Foundation.HResult":              VT_HRESULT,
+	"Windows.Win32.Foundation.HRESULT":        VT_HRESULT,
+	"Windows.Win32.Foundation.BSTR":           VT_BSTR,
+	"Windows.Win32.Foundation.PWSTR":          VT_LPWSTR,
+	"Windows.Win32.Foundation.PSTR":           VT_LPSTR,
+	"Windows.Win32.Foundation.VARIANT_BOOL":   VT_BOOL,
+	"Windows.Win32.Foundation.DECIMAL":        VT_DECIMAL,
+	"Windows.Win32.System.Com.CY":             VT_CY,
+	"Windows.Win32.System.Variant.VARIANT":    VT_VARIANT,
+	"Windows.Win32.System.Com.IUnknown":       VT_UNKNOWN,
+	"Windows.Win32.System.Com.IDispatch":      VT_DISPATCH,
+	"Windows.Win32.System.WinRT.IInspectable": VT_USERDEFINED,
+	"Windows.Win32.System.WinRT.HSTRING":      VT_USERDEFINED,
+}
+
+type winmdAttr struct {
+	name  string
+	value []byte
+}
+
+type winmdType struct {
+	row       int
+	name      string
+	namespace string
+	flags     int
+	extends   string
+	enclosing int
+
+	entry        *typeEntry
+	defaultIface *typeEntry //runtime classes
+}
+
+type winmdReader struct {
+	md      *mdReader
+	dir     string
+	ptrSize int
+
+	name    string
+	attr    LibAttr
+	entries []*typeEntry
+
+	types           []*winmdType //by TypeDef row - 1
+	byName          map[string]*winmdType
+	attrs           map[int][]*winmdAttr
+	constants       map[int]int
+	layouts         map[int]int
+	fieldOffsets    map[int]int
+	explicitOffsets map[*varEntry]int
+	impls           map[int][]int
+	methodOwner     map[int]int
+	builtins        map[string]*typeEntry
+	included        map[*typeEntry]bool
+
+	//other metadata files, shared with the readers of the files
+	//they refer to
+	imports map[string]*winmdReader
+}
+
+func isWinmdFile(filePath string) bool {
+	return strings.ToLower(filepath.Ext(filePath)) == ".winmd"
+}
+
+// ReadWinmdFile reads a Windows metadata file.
+func ReadWinmdFile(filePath string) (*TypeLib, error) {
+	r, err := readWinmdFile(filePath, make(map[string]*winmdReader))
+	if err != nil {
+		return nil, err
+	}
+	return &TypeLib{name: r.name, attr: r.attr, entries: r.entries, laidOut: true}, nil
+}
+
+// NewTypeLibFromWinmd decodes Windows metadata. Types of other
+// metadata files are not resolved.
+func NewTypeLibFromWinmd(data []byte) (*TypeLib, error) {
+	r, err := readWinmd(data, "", make(map[string]*winmdReader))
+	if err != nil {
+		return nil, err
+	}
+	return &TypeLib{name: r.name, attr: r.attr, entries: r.entries, laidOut: true}, nil
+}
+
+func readWinmdFile(filePath string, imports map[string]*winmdReader) (*winmdReader, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	return readWinmd(data, filepath.Dir(filePath), imports)
+}
+
+func readWinmd(data []byte, dir string, imports map[string]*winmdReader) (*winmdReader, error) {
+	md, err := readMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	r := &winmdReader{
+		md:              md,
+		dir:             dir,
+		ptrSize:         utils.PtrSize,
+		byName:          make(map[string]*winmdType),
+		attrs:           make(map[int][]*winmdAttr),
+		constants:       make(map[int]int),
+		layouts:         make(map[int]int),
+		fieldOffsets:    make(map[int]int),
+		explicitOffsets: make(map[*varEntry]int),
+		impls:           make(map[int][]int),
+		methodOwner:     make(map[int]int),
+		builtins:        make(map[string]*typeEntry),
+		included:        make(map[*typeEntry]bool),
+		imports:         imports,
+	}
+	r.read()
+	if md.bad {
+		return nil, errWinmdFormat
+	}
+	return r, nil
+}
+
+func (this *winmdReader) read() {
+	md := this.md
+	if md.rowCount(mdAssembly) > 0 {
+		this.name = md.str(md.cell(mdAssembly, 1, 7))
+		this.attr.MajorVer = uint16(md.cell(mdAssembly, 1, 1))
+		this.attr.MinorVer = uint16(md.cell(mdAssembly, 1, 2))
+	} else if md.rowCount(mdModule) > 0 {
+		name := md.str(md.cell(mdModule, 1, 1))
+		this.name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+	this.attr.SysKind = SYS_WIN32
+	if this.ptrSize == 8 {
+		this.attr.SysKind = SYS_WIN64
+	}
+	//registered before the types are read, for files referring back
+	this.imports[this.name] = this
+
+	this.index()
+	for row := 1; row <= md.rowCount(mdTypeDef); row++ {
+		t := &winmdType{
+			row:       row,
+			flags:     md.cell(mdTypeDef, row, 0),
+			name:      md.str(md.cell(mdTypeDef, row, 1)),
+			namespace: md.str(md.cell(mdTypeDef, row, 2)),
+		}
+		table, extRow := md.coded(mdTypeDefOrRef, md.cell(mdTypeDef, row, 3))
+		if extRow != 0 && table == mdTypeRef {
+			t.extends = this.typeRefName(extRow)
+		}
+		this.types = append(this.types, t)
+	}
+	for row := 1; row <= md.rowCount(mdNestedClass); row++ {
+		nested := md.cell(mdNestedClass, row, 0)
+		if nested >= 1 && nested <= len(this.types) {
+			this.types[nested-1].enclosing = md.cell(mdNestedClass, row, 1)
+		}
+	}
+	for _, t := range this.types {
+		this.byName[this.fullName(t)] = t
+	}
+
+	//entries first, so that the members can refer to any of them
+	var mapped []*winmdType
+	for _, t := range this.types {
+		kind, ok := this.typeKind(t)
+		if !ok {
+			continue
+		}
+		t.entry = &typeEntry{kind: kind, guid: this.typeGuid(t)}
+		mapped = append(mapped, t)
+	}
+	this.nameEntries(mapped)
+	for _, t := range this.types {
+		if t.entry == nil && t.flags&mdTdWindowsRuntime != 0 && t.flags&mdTdInterface == 0 {
+			t.defaultIface = this.defaultInterface(t)
+		}
+	}
+	for _, t := range mapped {
+		this.entries = append(this.entries, t.entry)
+	}
+	//value types first, the files imported for the interfaces may
+	//refer back to them
+	for _, t := range mapped {
+		switch t.entry.kind {
+		case TKIND_ENUM:
+			this.readEnum(t)
+		case TKIND_RECORD, TKIND_UNION:
+			this.readStruct(t)
+		case TKIND_ALIAS:
+			this.readAlias(t)
+		case TKIND_MODULE:
+			this.readConstants(t)
+		}
+	}
+	for _, t := range mapped {
+		if t.entry.kind == TKIND_INTERFACE {
+			this.readInterface(t)
+		}
+	}
+	for _, e := range this.entries {
+		if this.included[e] {
+			continue
+		}
+		layoutEntry(e, this.ptrSize)
+		for _, v := range e.vars {
+			if off, ok := this.explicitOffsets[v]; ok {
+				v.oInst = off
+			}
+		}
+	}
+	for _, t := range mapped {
+		row, ok := this.layouts[t.row]
+		if ok && md.cell(mdClassLayout, row, 1) != 0 {
+			t.entry.sizeInstance = md.cell(mdClassLayout, row, 1)
+		}
+	}
+}
+
+// index builds the maps from the rows of the tables to the rows that
+// refer to them.
+func (this *winmdReader) index() {
+	md := this.md
+	for row := 1; row <= md.rowCount(mdTypeDef); row++ {
+		end := md.listEnd(mdTypeDef, row, 5, mdMethodDef)
+		for m := md.cell(mdTypeDef, row, 5); m < end; m++ {
+			this.methodOwner[m] = row
+		}
+	}
+	for row := 1; row <= md.rowCount(mdCustomAttribute); row++ {
+		parent := md.cell(mdCustomAttribute, row, 0)
+		table, ctor := md.coded(mdCustomAttributeType, md.cell(mdCustomAttribute, row, 1))
+		this.attrs[parent] = append(this.attrs[parent], &winmdAttr{
+			name:  this.attrName(table, ctor),
+			value: md.blob(md.cell(mdCustomAttribute, row, 2)),
+		})
+	}
+	for row := 1; row <= md.rowCount(mdConstant); row++ {
+		this.constants[md.cell(mdConstant, row, 1)] = row
+	}
+	for row := 1; row <= md.rowCount(mdClassLayout); row++ {
+		this.layouts[md.cell(mdClassLayout, row, 2)] = row
+	}
+	for row := 1; row <= md.rowCount(mdFieldLayout); row++ {
+		this.fieldOffsets[md.cell(mdFieldLayout, row, 1)] = md.cell(mdFieldLayout, row, 0)
+	}
+	for row := 1; row <= md.rowCount(mdInterfaceImpl); row++ {
+		class := md.cell(mdInterfaceImpl, row, 0)
+		this.impls[class] = append(this.impls[class], row)
+	}
+}
+
+// attrName returns the name of the attribute type of a constructor
+func (this *winmdReader) attrName(table int, row int) string {
+	md := this.md
+	switch table {
+	case mdMethodDef:
+		if owner := this.methodOwner[row]; owner != 0 {
+			return md.str(md.cell(mdTypeDef, owner, 1))
+		}
+	case mdMemberRef:
+		table, row := md.coded(mdMemberRefParent, md.cell(mdMemberRef, row, 0))
+		switch table {
+		case mdTypeRef:
+			return md.str(md.cell(mdTypeRef, row, 1))
+		case mdTypeDef:
+			return md.str(md.cell(mdTypeDef, row, 1))
+		}
+	}
+	return ""
+}
+
+func (this *winmdReader) findAttr(table int, row int, name string) *winmdAttr {
+	for _, a := range this.attrs[codedValue(mdHasCustomAttribute, table, row)] {
+		if a.name == name {
+			return a
+		}
+	}
+	return nil
+}
+
+// attrString reads the first fixed argument of an attribute as a string
+func attrString(a *winmdAttr) string {
+	if len(a.value) < 3 || a.value[2] == 0xff {
+		return ""
+	}
+	size, n := mdUncompress(a.value[2:])
+	if 2+n+size > len(a.value) {
+		return ""
+	}
+	return string(a.value[2+n : 2+n+size])
+}
+
+func (this *winmdReader) typeGuid(t *winmdType) GUID {
+	a := this.findAttr(mdTypeDef, t.row, "GuidAttribute")
+	if a == nil {
+		return GUID{}
+	}
+	if len(a.value) == 2+16+2 {
+		//Windows.Foundation.Metadata.GuidAttribute(uint, ushort, ushort, byte..)
+		r := &dataReader{data: a.value}
+		return r.guidAt(2)
+	}
+	guid, _ := ParseGuid(attrString(a))
+	return guid
+}
+
+func (this *winmdReader) fullName(t *winmdType) string {
+	if t.enclosing != 0 {
+		return this.fullName(this.types[t.enclosing-1]) + "/" + t.name
+	}
+	return t.namespace + "." + t.name
+}
+
+func (this *winmdReader) typeRefName(row int) string {
+	md := this.md
+	name := md.str(md.cell(mdTypeRef, row, 1))
+	table, scope := md.coded(mdResolutionScope, md.cell(mdTypeRef, row, 0))
+	if table == mdTypeRef && scope != 0 {
+		return this.typeRefName(scope) + "/" + name
+	}
+	return md.str(md.cell(mdTypeRef, row, 2)) + "." + name
+}
+
+// typeKind tells what a type definition maps to, if anything
+func (this *winmdReader) typeKind(t *winmdType) (TYPEKIND, bool) {
+	if strings.HasPrefix(t.name, "<") || strings.IndexByte(t.name, '`') != -1 {
+		return 0, false
+	}
+	if _, ok := winmdKnownTypes[this.fullName(t)]; ok {
+		return 0, false
+	}
+	if t.flags&mdTdInterface != 0 {
+		return TKIND_INTERFACE, true
+	}
+	switch t.extends {
+	case "System.Enum":
+		return TKIND_ENUM, true
+	case "System.ValueType":
+		if this.findAttr(mdTypeDef, t.row, "ApiContractAttribute") != nil {
+			return 0, false //versioning marker
+		}
+		fields := this.instanceFields(t)
+		if this.findAttr(mdTypeDef, t.row, "NativeTypedefAttribute") != nil && len(fields) == 1 {
+			return TKIND_ALIAS, true
+		}
+		if t.flags&mdTdLayoutMask == mdTdExplicitLayout {
+			for _, field := range fields {
+				if this.fieldOffsets[field] != 0 {
+					return TKIND_RECORD, true
+				}
+			}
+			return TKIND_UNION, true
+		}
+		return TKIND_RECORD, true
+	case "System.MulticastDelegate":
+		if this.findAttr(mdTypeDef, t.row, "GuidAttribute") != nil {
+			return TKIND_INTERFACE, true
+		}
+		//a callback, seen as a function pointer
+		return TKIND_ALIAS, true
+	}
+	if t.flags&mdTdWindowsRuntime == 0 && len(this.constantFields(t)) > 0 {
+		return TKIND_MODULE, true
+	}
+	return 0, false
+}
+
+func (this *winmdReader) instanceFields(t *winmdType) []int {
+	var fields []int
+	md := this.md
+	end := md.listEnd(mdTypeDef, t.row, 4, mdField)
+	for row := md.cell(mdTypeDef, t.row, 4); row < end; row++ {
+		if md.cell(mdField, row, 0)&mdFdStatic == 0 {
+			fields = append(fields, row)
+		}
+	}
+	return fields
+}
+
+func (this *winmdReader) constantFields(t *winmdType) []int {
+	var fields []int
+	md := this.md
+	end := md.listEnd(mdTypeDef, t.row, 4, mdField)
+	for row := md.cell(mdTypeDef, t.row, 4); row < end; row++ {
+		if md.cell(mdField, row, 0)&mdFdLiteral != 0 {
+			if _, ok := this.constants[codedValue(mdHasConstant, mdField, row)]; ok {
+				fields = append(fields, row)
+			}
+		}
+	}
+	return fields
+}
+
+// nameEntries names the entries after their types, nested types after
+// the enclosing one. Names found in several namespaces are qualified.
+func (this *winmdReader) nameEntries(mapped []*winmdType) {
+	var shortName func(t *winmdType) string
+	shortName = func(t *winmdType) string {
+		if t.enclosing != 0 {
+			return shortName(this.types[t.enclosing-1]) + "_" + t.name
+		}
+		return t.name
+	}
+	outerNamespace := func(t *winmdType) string {
+		for t.enclosing != 0 {
+			t = this.types[t.enclosing-1]
+		}
+		return t.namespace
+	}
+	counts := make(map[string]int)
+	for _, t := range mapped {
+		counts[shortName(t)]++
+	}
+	for _, t := range mapped {
+		name := shortName(t)
+		if counts[name] > 1 {
+			name = strings.ReplaceAll(outerNamespace(t), ".", "_") + "_" + name
+		}
+		t.entry.name = name
+	}
+}
+
+// defaultInterface returns the [default] interface of a runtime class
+func (this *winmdReader) defaultInterface(t *winmdType) *typeEntry {
+	md := this.md
+	for _, row := range this.impls[t.row] {
+		if this.findAttr(mdInterfaceImpl, row, "DefaultAttribute") == nil {
+			continue
+		}
+		table, ifRow := md.coded(mdTypeDefOrRef, md.cell(mdInterfaceImpl, row, 1))
+		d := this.typeRef(table, ifRow, false)
+		if d.vt == VT_PTR && d.elem.vt == VT_USERDEFINED {
+			return d.elem.ref
+		}
+	}
+	return nil
+}
+
+// typeRef returns the type a TypeDefOrRef token stands for in a
+// signature: value types by value and reference types by pointer.
+func (this *winmdReader) typeRef(table int, row int, valueType bool) *typeDesc {
+	var t *winmdType
+	var fullName string
+	switch table {
+	case mdTypeDef:
+		if row < 1 || row > len(this.types) {
+			this.md.bad = true
+			return vtDesc(VT_VOID)
+		}
+		t = this.types[row-1]
+		fullName = this.fullName(t)
+	case mdTypeRef:
+		fullName = this.typeRefName(row)
+		t = this.byName[fullName]
+		if t == nil {
+			return this.importedType(row, fullName, valueType)
+		}
+	default:
+		//a generic instance
+		return vtDesc(VT_UNKNOWN)
+	}
+	if d := this.knownType(fullName); d != nil {
+		return d
+	}
+	return this.typeDesc(t, valueType)
+}
+
+func (this *winmdReader) typeDesc(t *winmdType, valueType bool) *typeDesc {
+	if t.defaultIface != nil {
+		return ptrDesc(refDesc(t.defaultIface))
+	}
+	if t.entry == nil {
+		if valueType {
+			//from a type not mapped, like a generic struct
+			return refDesc(&typeEntry{})
+		}
+		return vtDesc(VT_UNKNOWN)
+	}
+	if t.entry.kind == TKIND_INTERFACE {
+		return ptrDesc(refDesc(t.entry))
+	}
+	return refDesc(t.entry)
+}
+
+// importedType resolves a type of another metadata file, read from the
+// directory of this one.
+func (this *winmdReader) importedType(row int, fullName string, valueType bool) *typeDesc {
+	if d := this.knownType(fullName); d != nil {
+		return d
+	}
+	md := this.md
+	table, scope := md.coded(mdResolutionScope, md.cell(mdTypeRef, row, 0))
+	for table == mdTypeRef && scope != 0 {
+		table, scope = md.coded(mdResolutionScope, md.cell(mdTypeRef, scope, 0))
+	}
+	if table == mdAssemblyRef && this.dir != "" {
+		name := md.str(md.cell(mdAssemblyRef, scope, 6))
+		lib, ok := this.imports[name]
+		if !ok {
+			lib, _ = readWinmdFile(filepath.Join(this.dir, name+".winmd"), this.imports)
+			this.imports[name] = lib
+		}
+		if lib != nil {
+			if t := lib.byName[fullName]; t != nil && (t.entry != nil || t.defaultIface != nil) {
+				d := lib.typeDesc(t, valueType)
+				if d.vt == VT_USERDEFINED {
+					this.includeValueType(d.ref)
+				}
+				return d
+			}
+		}
+	}
+	if valueType {
+		return refDesc(&typeEntry{})
+	}
+	return vtDesc(VT_UNKNOWN)
+}
+
+// includeValueType adds a struct or enum of another file to the entries,
+// as the generated code needs its definition. Aliases are not, WinRT only
+// has HSTRING which is seen as uintptr.
+func (this *winmdReader) includeValueType(e *typeEntry) {
+	if e.kind != TKIND_RECORD && e.kind != TKIND_UNION && e.kind != TKIND_ENUM ||
+		this.included[e] {
+		return
+	}
+	this.included[e] = true
+	this.entries = append(this.entries, e)
+	for _, v := range e.vars {
+		d := v.typ
+		for d.vt == VT_CARRAY {
+			d = d.elem
+		}
+		if d.vt == VT_USERDEFINED {
+			this.includeValueType(d.ref)
+		}
+	}
+}
+
+func (this *winmdReader) knownType(fullName string) *typeDesc {
+	vt, ok := winmdKnownTypes[fullName]
+	if !ok {
+		return nil
+	}
+	switch fullName {
+	case winmdInspectable:
+		return ptrDesc(refDesc(this.inspectable()))
+	case winmdHString:
+		return refDesc(this.hstring())
+	}
+	if vt == VT_CLSID {
+		return refDesc(stdoleTypes[0])
+	}
+	return vtDesc(vt)
+}
+
+// builtin adds a type that winmd files refer to without defining it
+func (this *winmdReader) builtin(e *typeEntry) *typeEntry {
+	this.builtins[e.name] = e
+	this.entries = append(this.entries, e)
+	return e
+}
+
+func (this *winmdReader) hstring() *typeEntry {
+	if e := this.builtins["HSTRING"]; e != nil {
+		return e
+	}
+	return this.builtin(&typeEntry{name: "HSTRING", kind: TKIND_ALIAS,
+		alias: vtDesc(VT_UINT_PTR)})
+}
+
+func (this *winmdReader) inspectable() *typeEntry {
+	if e := this.builtins["IInspectable"]; e != nil {
+		return e
+	}
+	out := PARAMFLAG_FOUT
+	e := &typeEntry{
+		name:  "IInspectable",
+		guid:  mustParseGuid("AF86E2E0-B12D-4C6A-9C5A-D7AA65101E90"),
+		kind:  TKIND_INTERFACE,
+		impls: []*implEntry{{ref: stdoleTypes[3]}},
+	}
+	e.funcs = []*funcEntry{
+		stdFunc("GetIids", MEMBERID_NIL, VT_HRESULT,
+			stdParam("iidCount", ptrDesc(vtDesc(VT_UI4)), out),
+			stdParam("iids", ptrDesc(ptrDesc(refDesc(stdoleTypes[0]))), out)),
+		stdFunc("GetRuntimeClassName", MEMBERID_NIL, VT_HRESULT,
+			stdParam("className", ptrDesc(refDesc(this.hstring())), out)),
+		stdFunc("GetTrustLevel", MEMBERID_NIL, VT_HRESULT,
+			stdParam("trustLevel", ptrDesc(vtDesc(VT_I4)), out)),
+	}
+	for _, f := range e.funcs {
+		f.flags = 0
+	}
+	return this.builtin(e)
+}
+
+func (this *winmdReader) readInterface(t *winmdType) {
+	md := this.md
+	e := t.entry
+	winrt := t.flags&mdTdWindowsRuntime != 0
+	delegate := t.extends == "System.MulticastDelegate"
+	switch {
+	case delegate:
+		e.impls = []*implEntry{{ref: stdoleTypes[3]}}
+	case winrt:
+		//the other interfaces are required, not inherited
+		e.impls = []*implEntry{{ref: this.inspectable()}}
+	case len(this.impls[t.row]) > 0:
+		table, row := md.coded(mdTypeDefOrRef, md.cell(mdInterfaceImpl, this.impls[t.row][0], 1))
+		var base *typeEntry
+		switch d := this.typeRef(table, row, false); d.vt {
+		case VT_UNKNOWN:
+			base = stdoleTypes[3]
+		case VT_DISPATCH:
+			base = stdoleDispatch()
+		case VT_PTR:
+			base = d.elem.ref
+		}
+		if base != nil {
+			e.impls = []*implEntry{{ref: base}}
+		}
+	}
+	end := md.listEnd(mdTypeDef, t.row, 5, mdMethodDef)
+	for row := md.cell(mdTypeDef, t.row, 5); row < end; row++ {
+		if md.cell(mdMethodDef, row, 2)&mdMdStatic != 0 {
+			continue
+		}
+		if delegate && md.str(md.cell(mdMethodDef, row, 3)) != "Invoke" {
+			continue
+		}
+		e.funcs = append(e.funcs, this.readMethod(row, winrt))
+	}
+}
+
+// winmdSig reads a signature blob
+type winmdSig struct {
+	r   *winmdReader
+	b   []byte
+	pos int
+}
+
+func (this *winmdSig) peek() byte {
+	if this.pos >= len(this.b) {
+		return 0
+	}
+	return this.b[this.pos]
+}
+
+func (this *winmdSig) u8() byte {
+	if this.pos >= len(this.b) {
+		this.r.md.bad = true
+		return 0
+	}
+	this.pos++
+	return this.b[this.pos-1]
+}
+
+func (this *winmdSig) uncompress() int {
+	if this.pos >= len(this.b) {
+		this.r.md.bad = true
+		return 0
+	}
+	v, n := mdUncompress(this.b[this.pos:])
+	this.pos += n
+	return v
+}
+
+// token reads a TypeDefOrRefOrSpecEncoded value
+func (this *winmdSig) token() (int, int) {
+	v := this.uncompress()
+	return []int{mdTypeDef, mdTypeRef, mdTypeSpec, -1}[v&3], v >> 2
+}
+
+func (this *winmdSig) skipMods() {
+	for this.peek() == elemCModReqd || this.peek() == elemCModOpt || this.peek() == elemPinned {
+		if this.u8() != elemPinned {
+			this.token()
+		}
+	}
+}
+
+func (this *winmdSig) readType() *typeDesc {
+	this.skipMods()
+	c := this.u8()
+	if vt, ok := winmdElemTypes[c]; ok {
+		return vtDesc(vt)
+	}
+	switch c {
+	case elemVoid:
+		return vtDesc(VT_VOID)
+	case elemString:
+		return refDesc(this.r.hstring())
+	case elemPtr, elemByRef, elemSzArray:
+		return ptrDesc(this.readType())
+	case elemValueType, elemClass:
+		table, row := this.token()
+		return this.r.typeRef(table, row, c == elemValueType)
+	case elemArray:
+		elem := this.readType()
+		this.uncompress() //rank
+		dims := make([]int, this.uncompress())
+		for n := range dims {
+			dims[n] = this.uncompress()
+		}
+		for n := this.uncompress(); n > 0; n-- {
+			this.uncompress() //lower bound
+		}
+		return &typeDesc{vt: VT_CARRAY, elem: elem, dims: dims}
+	case elemGenericInst:
+		this.u8()
+		this.token()
+		for n := this.uncompress(); n > 0; n-- {
+			this.readType()
+		}
+		return vtDesc(VT_UNKNOWN)
+	case elemVar, elemMVar:
+		this.uncompress()
+		return vtDesc(VT_UNKNOWN)
+	case elemFnPtr:
+		this.readMethodSig()
+		return vtDesc(VT_UINT_PTR)
+	case elemObject:
+		return vtDesc(VT_UNKNOWN)
+	case elemTypedByRef:
+		return vtDesc(VT_VARIANT)
+	}
+	this.r.md.bad = true
+	return vtDesc(VT_VOID)
+}
+
+// paramType reads the type of a parameter, telling apart the arrays
+// that WinRT passes as a length and a pointer.
+func (this *winmdSig) paramType() (t *typeDesc, byRef bool, array bool) {
+	this.skipMods()
+	if this.peek() == elemByRef {
+		this.u8()
+		this.skipMods()
+		byRef = true
+	}
+	if this.peek() == elemSzArray {
+		this.u8()
+		return this.readType(), byRef, true
+	}
+	t = this.readType()
+	if byRef {
+		t = ptrDesc(t)
+	}
+	return t, byRef, false
+}
+
+// readMethodSig skips a method signature, returning the parameter count
+func (this *winmdSig) readMethodSig() int {
+	if this.u8()&0x10 != 0 {
+		this.uncompress() //generic parameter count
+	}
+	count := this.uncompress()
+	for n := 0; n <= count; n++ {
+		this.paramType()
+	}
+	return count
+}
+
+func (this *winmdReader) readMethod(row int, winrt bool) *funcEntry {
+	md := this.md
+	flags := md.cell(mdMethodDef, row, 2)
+	f := &funcEntry{
+		memid:    MEMBERID_NIL,
+		name:     md.str(md.cell(mdMethodDef, row, 3)),
+		funcKind: FUNC_PUREVIRTUAL,
+		invKind:  INVOKE_FUNC,
+		callConv: CC_STDCALL,
+	}
+	if a := this.findAttr(mdMethodDef, row, "OverloadAttribute"); a != nil {
+		f.name = attrString(a)
+	}
+	paramRows := make(map[int]int)
+	end := md.listEnd(mdMethodDef, row, 5, mdParam)
+	for p := md.cell(mdMethodDef, row, 5); p < end; p++ {
+		paramRows[md.cell(mdParam, p, 1)] = p
+	}
+
+	sig := &winmdSig{r: this, b: md.blob(md.cell(mdMethodDef, row, 4))}
+	if sig.u8()&0x10 != 0 {
+		sig.uncompress()
+	}
+	count := sig.uncompress()
+	ret, _, retArray := sig.paramType()
+	for n := 1; n <= count; n++ {
+		t, byRef, array := sig.paramType()
+		p := &paramEntry{name: "p" + strconv.Itoa(n), typ: t}
+		if prow, ok := paramRows[n]; ok {
+			p.name = md.str(md.cell(mdParam, prow, 2))
+			pflags := md.cell(mdParam, prow, 0)
+			if pflags&mdPdIn != 0 {
+				p.flags |= PARAMFLAG_FIN
+			}
+			if pflags&mdPdOut != 0 {
+				p.flags |= PARAMFLAG_FOUT
+			}
+			if pflags&mdPdOptional != 0 {
+				p.flags |= PARAMFLAG_FOPT
+			}
+			if pflags&mdPdHasDefault != 0 {
+				if v, _, ok := this.constant(mdParam, prow); ok {
+					p.flags |= PARAMFLAG_FHASDEFAULT
+					p.value = v
+				}
+			}
+			if this.findAttr(mdParam, prow, "RetValAttribute") != nil {
+				p.flags |= PARAMFLAG_FRETVAL
+			}
+		}
+		if !array {
+			f.params = append(f.params, p)
+			continue
+		}
+		size := &paramEntry{name: p.name + "Size", typ: vtDesc(VT_UI4), flags: PARAMFLAG_FIN}
+		p.typ = ptrDesc(t)
+		if byRef {
+			//ReceiveArray, allocated by the callee
+			size.typ, size.flags = ptrDesc(size.typ), PARAMFLAG_FOUT
+			p.typ, p.flags = ptrDesc(p.typ), PARAMFLAG_FOUT
+		}
+		f.params = append(f.params, size, p)
+	}
+	if !winrt {
+		f.ret = ret
+		if retArray {
+			f.ret = ptrDesc(ret)
+		}
+		return f
+	}
+
+	f.ret = vtDesc(VT_HRESULT)
+	if ret.vt != VT_VOID {
+		name := "result"
+		if prow, ok := paramRows[0]; ok && md.str(md.cell(mdParam, prow, 2)) != "" {
+			name = md.str(md.cell(mdParam, prow, 2))
+		}
+		if retArray {
+			f.params = append(f.params,
+				stdParam(name+"Size", ptrDesc(vtDesc(VT_UI4)), PARAMFLAG_FOUT),
+				stdParam(name, ptrDesc(ptrDesc(ret)), PARAMFLAG_FOUT))
+		} else {
+			f.params = append(f.params, stdParam(name, ptrDesc(ret), PARAMFLAG_FOUT|PARAMFLAG_FRETVAL))
+		}
+	}
+	if flags&mdMdSpecialName != 0 {
+		if strings.HasPrefix(f.name, "get_") {
+			f.name, f.invKind = f.name[4:], INVOKE_PROPERTYGET
+		} else if strings.HasPrefix(f.name, "put_") {
+			f.name, f.invKind = f.name[4:], INVOKE_PROPERTYPUT
+		}
+	}
+	return f
+}
+
+// constant returns the value of a field or parameter constant, with the
+// variant type it is stored as.
+func (this *winmdReader) constant(table int, row int) (interface{}, VARENUM, bool) {
+	md := this.md
+	crow, ok := this.constants[codedValue(mdHasConstant, table, row)]
+	if !ok {
+		return nil, VT_EMPTY, false
+	}
+	typ := byte(md.cell(mdConstant, crow, 0))
+	b := md.blob(md.cell(mdConstant, crow, 2))
+	switch typ {
+	case elemString:
+		return utf16ToStr(b), VT_LPWSTR, true
+	case elemClass:
+		return nil, VT_EMPTY, false
+	}
+	vt, ok := winmdElemTypes[typ]
+	if !ok {
+		return nil, VT_EMPTY, false
+	}
+	var buf [8]byte
+	copy(buf[:], b)
+	bits := binary.LittleEndian.Uint64(buf[:])
+	if typ == elemBoolean {
+		return bits&0xff != 0, VT_BOOL, true
+	}
+	return convertValue(vt, bits), vt, true
+}
+
+func (this *winmdReader) fieldType(row int) *typeDesc {
+	md := this.md
+	sig := &winmdSig{r: this, b: md.blob(md.cell(mdField, row, 2))}
+	sig.u8() //FIELD
+	return sig.readType()
+}
+
+func (this *winmdReader) readEnum(t *winmdType) {
+	md := this.md
+	e := t.entry
+	end := md.listEnd(mdTypeDef, t.row, 4, mdField)
+	for row := md.cell(mdTypeDef, t.row, 4); row < end; row++ {
+		if md.cell(mdField, row, 0)&mdFdLiteral == 0 {
+			continue //value__
+		}
+		v, vt, ok := this.constant(mdField, row)
+		if !ok {
+			continue
+		}
+		typ, value := vtDesc(VT_I4), interface{}(int32(toInt64(v)))
+		if vt == VT_I8 || vt == VT_UI8 {
+			typ, value = vtDesc(vt), v
+		}
+		e.vars = append(e.vars, &varEntry{
+			memid:   MEMBERID_NIL,
+			name:    md.str(md.cell(mdField, row, 1)),
+			typ:     typ,
+			varKind: VAR_CONST,
+			value:   value,
+		})
+	}
+}
+
+func (this *winmdReader) readStruct(t *winmdType) {
+	md := this.md
+	e := t.entry
+	explicit := t.flags&mdTdLayoutMask == mdTdExplicitLayout
+	for _, row := range this.instanceFields(t) {
+		v := &varEntry{
+			memid:   MEMBERID_NIL,
+			name:    md.str(md.cell(mdField, row, 1)),
+			typ:     this.fieldType(row),
+			varKind: VAR_PERINSTANCE,
+		}
+		if explicit {
+			this.explicitOffsets[v] = this.fieldOffsets[row]
+		}
+		e.vars = append(e.vars, v)
+	}
+}
+
+func (this *winmdReader) readAlias(t *winmdType) {
+	e := t.entry
+	if t.extends == "System.MulticastDelegate" {
+		e.alias = vtDesc(VT_UINT_PTR)
+		return
+	}
+	e.alias = this.fieldType(this.instanceFields(t)[0])
+}
+
+func (this *winmdReader) readConstants(t *winmdType) {
+	md := this.md
+	e := t.entry
+	for _, row := range this.constantFields(t) {
+		v, vt, _ := this.constant(mdField, row)
+		typ := this.fieldType(row)
+		if vt == VT_LPWSTR {
+			typ = vtDesc(VT_LPWSTR)
+		}
+		e.vars = append(e.vars, &varEntry{
+			memid:   MEMBERID_NIL,
+			name:    md.str(md.cell(mdField, row, 1)),
+			typ:     typ,
+			varKind: VAR_CONST,
+			value:   v,
+		})
+	}
+}
+
+func toInt64(v interface{}) int64 {
+	switch v := v.(type) {
+	case int8:
+		return int64(v)
+	case uint8:
+		return int64(v)
+	case int16:
+		return int64(v)
+	case uint16:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case uint32:
+		return int64(v)
+	case int64:
+		return v
+	case uint64:
+		return int64(v)
+	}
+	return 0
+}
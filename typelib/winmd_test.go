@@ -0,0 +1,451 @@
+package typelib
+
+import (
+	"encoding/binary"
+	"github.com/zzl/go-tlbimp/utils"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"unicode/utf16"
+)
+
+// mdWriter builds ECMA-335 metadata in memory, with the small heap
+// indexes, for the winmd reader tests
+
+type mdWriter struct {
+	rows [mdTableCount][][]int
+
+	strs      msftBuf
+	strIndex  map[string]int
+	blobs     msftBuf
+	guids     msftBuf
+	guidCount int
+}
+
+func newMdWriter() *mdWriter {
+	w := &mdWriter{strIndex: make(map[string]int)}
+	w.strs.b = []byte{0}
+	w.blobs.b = []byte{0}
+	return w
+}
+
+func (this *mdWriter) str(s string) int {
+	if s == "" {
+		return 0
+	}
+	if o, ok := this.strIndex[s]; ok {
+		return o
+	}
+	o := len(this.strs.b)
+	this.strs.b = append(this.strs.b, s...)
+	this.strs.b = append(this.strs.b, 0)
+	this.strIndex[s] = o
+	return o
+}
+
+func (this *mdWriter) blob(b ...byte) int {
+	o := len(this.blobs.b)
+	this.blobs.b = append(this.blobs.b, mdCompress(len(b))...)
+	this.blobs.b = append(this.blobs.b, b...)
+	return o
+}
+
+func (this *mdWriter) guid(g GUID) int {
+	this.guids.i32(int32(g.Data1))
+	this.guids.u16(g.Data2)
+	this.guids.u16(g.Data3)
+	this.guids.b = append(this.guids.b, g.Data4[:]...)
+	this.guidCount++
+	return this.guidCount
+}
+
+// add appends a row, returning its 1 based index
+func (this *mdWriter) add(table int, cells ...int) int {
+	this.rows[table] = append(this.rows[table], cells)
+	return len(this.rows[table])
+}
+
+func (this *mdWriter) next(table int) int {
+	return len(this.rows[table]) + 1
+}
+
+func (this *mdWriter) typeRef(namespace, name string) int {
+	scope := codedValue(mdResolutionScope, mdModule, 1)
+	return this.add(mdTypeRef, scope, this.str(name), this.str(namespace))
+}
+
+// attr adds a custom attribute whose constructor is a member of the
+// type ref given
+func (this *mdWriter) attr(parentTable, parentRow, typeRef int, value ...byte) {
+	ctor := this.add(mdMemberRef, codedValue(mdMemberRefParent, mdTypeRef, typeRef),
+		this.str(".ctor"), this.blob(0x20, 0, elemVoid))
+	this.add(mdCustomAttribute, codedValue(mdHasCustomAttribute, parentTable, parentRow),
+		codedValue(mdCustomAttributeType, mdMemberRef, ctor), this.blob(value...))
+}
+
+func (this *mdWriter) guidAttr(typeDef, typeRef int, g GUID) {
+	var b msftBuf
+	b.u16(1)
+	b.i32(int32(g.Data1))
+	b.u16(g.Data2)
+	b.u16(g.Data3)
+	b.b = append(b.b, g.Data4[:]...)
+	b.u16(0)
+	this.attr(mdTypeDef, typeDef, typeRef, b.b...)
+}
+
+func (this *mdWriter) constant(parentTable, parentRow int, elem byte, value ...byte) {
+	this.add(mdConstant, int(elem), codedValue(mdHasConstant, parentTable, parentRow), this.blob(value...))
+}
+
+// metadata lays out the metadata root with the tables, strings, blob
+// and guid streams
+func (this *mdWriter) metadata() []byte {
+	r := &mdReader{}
+	var valid uint64
+	for n, rows := range this.rows {
+		r.tables[n].rows = len(rows)
+		if len(rows) > 0 {
+			valid |= 1 << n
+		}
+	}
+	var tables msftBuf
+	tables.i32(0)
+	tables.b = append(tables.b, 2, 0, 0, 1)
+	tables.i32(int32(uint32(valid)))
+	tables.i32(int32(valid >> 32))
+	tables.i32(0)
+	tables.i32(0)
+	for _, rows := range this.rows {
+		if len(rows) > 0 {
+			tables.i32(int32(len(rows)))
+		}
+	}
+	for n, rows := range this.rows {
+		for _, row := range rows {
+			for col, v := range row {
+				if r.columnSize(mdTableColumns[n][col], 0) == 4 {
+					tables.i32(int32(v))
+				} else {
+					tables.u16(uint16(v))
+				}
+			}
+		}
+	}
+
+	streams := []struct {
+		name string
+		data []byte
+	}{{"#~", tables.b}, {"#Strings", this.strs.b}, {"#Blob", this.blobs.b}, {"#GUID", this.guids.b}}
+	version := "v4.0.30319\x00\x00"
+	headerSize := 16 + len(version) + 4
+	for _, s := range streams {
+		headerSize += 8 + (len(s.name)+4)&^3
+	}
+	var b msftBuf
+	b.i32(mdMetadataMagic)
+	b.u16(1)
+	b.u16(1)
+	b.i32(0)
+	b.i32(int32(len(version)))
+	b.b = append(b.b, version...)
+	b.u16(0)
+	b.u16(uint16(len(streams)))
+	off := headerSize
+	for _, s := range streams {
+		size := (len(s.data) + 3) &^ 3
+		b.i32(int32(off))
+		b.i32(int32(size))
+		b.b = append(b.b, s.name...)
+		b.b = append(b.b, make([]byte, 4-len(s.name)%4)...)
+		off += size
+	}
+	for _, s := range streams {
+		b.b = append(b.b, s.data...)
+		for len(b.b)%4 != 0 {
+			b.b = append(b.b, 0)
+		}
+	}
+	return b.b
+}
+
+// image wraps the metadata in a PE image with a CLI header
+func (this *mdWriter) image() []byte {
+	const cliHeaderSize = 72
+	md := this.metadata()
+	section := make([]byte, cliHeaderSize, cliHeaderSize+len(md))
+	binary.LittleEndian.PutUint32(section[0:], cliHeaderSize)
+	binary.LittleEndian.PutUint32(section[8:], peSecRva+cliHeaderSize)
+	binary.LittleEndian.PutUint32(section[12:], uint32(len(md)))
+	return peImage(".text", 14, append(section, md...), cliHeaderSize)
+}
+
+func mdCompress(v int) []byte {
+	switch {
+	case v < 0x80:
+		return []byte{byte(v)}
+	case v < 0x4000:
+		return []byte{byte(v>>8) | 0x80, byte(v)}
+	}
+	return []byte{byte(v>>24) | 0xc0, byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+// mdToken encodes a TypeDefOrRef token of a signature
+func mdToken(table, row int) byte {
+	if table == mdTypeRef {
+		return byte(row<<2 | 1)
+	}
+	return byte(row << 2)
+}
+
+func utf16Bytes(s string) []byte {
+	var b []byte
+	for _, c := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, c)
+	}
+	return b
+}
+
+const (
+	mdTdTest       = 0x100 //sequential layout
+	mdTdTestIface  = mdTdInterface | 0x80 | 0x01
+	mdMdTestMethod = 0x0400 | 0x0100 | 0x0040 | 0x0080 | 0x0006 //abstract virtual public
+)
+
+// buildWinmd builds the metadata of Test.Api: an enum, a struct, a
+// union, constants, a win32 interface and a WinRT one. The struct a
+// method of the win32 interface takes is of Test.Other, see
+// buildOtherWinmd.
+func buildWinmd() []byte {
+	w := newMdWriter()
+	w.add(mdModule, 0, w.str("Test.Api.winmd"), w.guid(GUID{Data1: 1}), 0, 0)
+	w.add(mdAssembly, 0x8004, 1, 2, 0, 0, 0, 0, w.str("Test.Api"), 0)
+	other := w.add(mdAssemblyRef, 1, 0, 0, 0, 0, 0, w.str("Test.Other"), 0, 0)
+
+	sysEnum := w.typeRef("System", "Enum")
+	valueType := w.typeRef("System", "ValueType")
+	object := w.typeRef("System", "Object")
+	guidAttr := w.typeRef("Windows.Win32.Foundation.Metadata", "GuidAttribute")
+	hresult := w.typeRef("Windows.Win32.Foundation", "HRESULT")
+	pwstr := w.typeRef("Windows.Win32.Foundation", "PWSTR")
+	unknown := w.typeRef("Windows.Win32.System.Com", "IUnknown")
+	size := w.add(mdTypeRef, codedValue(mdResolutionScope, mdAssemblyRef, other),
+		w.str("SIZE"), w.str("Test.Other"))
+
+	typeDef := func(flags int, name string, extends int) int {
+		ext := 0
+		if extends != 0 {
+			ext = codedValue(mdTypeDefOrRef, mdTypeRef, extends)
+		}
+		return w.add(mdTypeDef, flags, w.str(name), w.str("Test.Api"), ext,
+			w.next(mdField), w.next(mdMethodDef))
+	}
+	field := func(flags int, name string, sig ...byte) int {
+		return w.add(mdField, flags, w.str(name), w.blob(append([]byte{0x06}, sig...)...))
+	}
+	method := func(flags int, name string, params []string, sig ...byte) {
+		w.add(mdMethodDef, 0, 0, mdMdTestMethod|flags, w.str(name), w.blob(sig...), w.next(mdParam))
+		for n, p := range params {
+			w.add(mdParam, mdPdIn, n+1, w.str(p))
+		}
+	}
+
+	w.add(mdTypeDef, 0, w.str("<Module>"), 0, 0, 1, 1)
+
+	typeDef(mdTdTest, "Color", sysEnum)
+	field(0x0606, "value__", elemI4)
+	red := field(0x8056, "Red", elemValueType, mdToken(mdTypeDef, 2))
+	w.constant(mdField, red, elemI4, 1, 0, 0, 0)
+	blue := field(0x8056, "Blue", elemValueType, mdToken(mdTypeDef, 2))
+	w.constant(mdField, blue, elemI4, 0xfe, 0xff, 0xff, 0xff)
+
+	typeDef(mdTdTest, "POINT", valueType)
+	field(0x0006, "x", elemI4)
+	field(0x0006, "y", elemI4)
+
+	value := typeDef(mdTdExplicitLayout, "VALUE", valueType)
+	w.add(mdFieldLayout, 0, field(0x0006, "l", elemI8))
+	w.add(mdFieldLayout, 0, field(0x0006, "d", elemR8))
+	w.add(mdClassLayout, 8, 0, value)
+
+	typeDef(mdTdTest, "Apis", object)
+	maxCount := field(0x8056, "MAX_COUNT", elemI4)
+	w.constant(mdField, maxCount, elemI4, 10, 0, 0, 0)
+	title := field(0x8056, "TITLE", elemString)
+	w.constant(mdField, title, elemString, utf16Bytes("api")...)
+
+	widget := typeDef(mdTdTestIface, "IWidget", 0)
+	w.guidAttr(widget, guidAttr, mustParseGuid("40000001-0000-0000-0000-000000000000"))
+	w.add(mdInterfaceImpl, widget, codedValue(mdTypeDefOrRef, mdTypeRef, unknown))
+	method(0, "Draw", []string{"pt", "color"}, 0x20, 2,
+		elemValueType, mdToken(mdTypeRef, hresult),
+		elemPtr, elemValueType, mdToken(mdTypeDef, 3),
+		elemValueType, mdToken(mdTypeDef, 2))
+	method(0, "GetTitle", []string{"title"}, 0x20, 1,
+		elemValueType, mdToken(mdTypeRef, hresult),
+		elemPtr, elemValueType, mdToken(mdTypeRef, pwstr))
+	method(0, "Resize", []string{"size"}, 0x20, 1,
+		elemVoid, elemValueType, mdToken(mdTypeRef, size))
+
+	thing := typeDef(mdTdTestIface|mdTdWindowsRuntime, "IThing", 0)
+	w.guidAttr(thing, guidAttr, mustParseGuid("40000002-0000-0000-0000-000000000000"))
+	method(mdMdSpecialName, "get_Name", nil, 0x20, 0, elemString)
+	method(0, "Fill", []string{"values"}, 0x20, 1, elemVoid, elemSzArray, elemI4)
+	return w.image()
+}
+
+// buildOtherWinmd builds the metadata of Test.Other, of the SIZE struct
+func buildOtherWinmd() []byte {
+	w := newMdWriter()
+	w.add(mdModule, 0, w.str("Test.Other.winmd"), w.guid(GUID{Data1: 2}), 0, 0)
+	w.add(mdAssembly, 0x8004, 1, 0, 0, 0, 0, 0, w.str("Test.Other"), 0)
+	valueType := w.typeRef("System", "ValueType")
+	w.add(mdTypeDef, 0, w.str("<Module>"), 0, 0, 1, 1)
+	w.add(mdTypeDef, mdTdTest, w.str("SIZE"), w.str("Test.Other"),
+		codedValue(mdTypeDefOrRef, mdTypeRef, valueType), 1, 1)
+	w.add(mdField, 0x0006, w.str("cx"), w.blob(0x06, elemI4))
+	w.add(mdField, 0x0006, w.str("cy"), w.blob(0x06, elemI4))
+	return w.image()
+}
+
+func TestWinmdTypes(t *testing.T) {
+	lib, err := NewTypeLibFromWinmd(buildWinmd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lib.GetName() != "Test.Api" || lib.GetLibAttr().MajorVer != 1 || lib.GetLibAttr().MinorVer != 2 {
+		t.Errorf("lib %s %+v", lib.GetName(), lib.GetLibAttr())
+	}
+	tests := []struct {
+		name   string
+		kind   TYPEKIND
+		guid   string
+		fields []string //name type
+		funcs  []string
+	}{
+		{"Color", TKIND_ENUM, "", []string{"Red int32", "Blue int32"}, nil},
+		{"POINT", TKIND_RECORD, "", []string{"x int32", "y int32"}, nil},
+		{"VALUE", TKIND_UNION, "", []string{"l int64", "d float64"}, nil},
+		{"Apis", TKIND_MODULE, "", []string{"MAX_COUNT int32", "TITLE win32.PWSTR"}, nil},
+		{"IWidget", TKIND_INTERFACE, "40000001-0000-0000-0000-000000000000", nil, []string{
+			"Draw win32.HRESULT(*POINT,Color)", "GetTitle win32.HRESULT(*win32.PWSTR)",
+			"Resize (*win32.IUnknown)"}},
+		{"IThing", TKIND_INTERFACE, "40000002-0000-0000-0000-000000000000", nil, []string{
+			"Name win32.HRESULT(*uintptr)", "Fill win32.HRESULT(uint32,*int32)"}},
+		{"HSTRING", TKIND_ALIAS, "", nil, nil},
+		{"IInspectable", TKIND_INTERFACE, "AF86E2E0-B12D-4C6A-9C5A-D7AA65101E90", nil, []string{
+			"GetIids win32.HRESULT(*uint32,**syscall.GUID)",
+			"GetRuntimeClassName win32.HRESULT(*uintptr)",
+			"GetTrustLevel win32.HRESULT(*int32)"}},
+	}
+	if lib.GetTypeInfoCount() != len(tests) {
+		t.Errorf("%d types, want %d", lib.GetTypeInfoCount(), len(tests))
+	}
+	for n, test := range tests {
+		ti, err := lib.GetTypeInfo(n)
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		guid := ""
+		if ti.Guid != (GUID{}) {
+			guid = ti.Guid.String()
+		}
+		if ti.Name != test.name || ti.Kind != test.kind || guid != test.guid {
+			t.Errorf("type %d: %s %v %s, want %s %v %s", n, ti.Name, ti.Kind, guid,
+				test.name, test.kind, test.guid)
+		}
+		var fields []string
+		for _, f := range ti.Fields {
+			fields = append(fields, f.Name+" "+f.Type.Name)
+		}
+		if !reflect.DeepEqual(fields, test.fields) {
+			t.Errorf("%s fields %q, want %q", test.name, fields, test.fields)
+		}
+		if funcs := formatFuncs(ti); !reflect.DeepEqual(funcs, test.funcs) {
+			t.Errorf("%s funcs %q, want %q", test.name, funcs, test.funcs)
+		}
+	}
+}
+
+func TestWinmdMembers(t *testing.T) {
+	lib, err := NewTypeLibFromWinmd(buildWinmd())
+	if err != nil {
+		t.Fatal(err)
+	}
+	color, _ := lib.GetTypeInfo(0)
+	if color.Fields[0].Value != int32(1) || color.Fields[1].Value != int32(-2) {
+		t.Errorf("Color values %v, %v", color.Fields[0].Value, color.Fields[1].Value)
+	}
+	value, _ := lib.GetTypeInfo(2)
+	if value.Size != 8 || value.SizeInstance != 8 ||
+		value.Fields[0].Offset != 0 || value.Fields[1].Offset != 0 {
+		t.Errorf("VALUE size %d, %d", value.Size, value.SizeInstance)
+	}
+	apis, _ := lib.GetTypeInfo(3)
+	if apis.Fields[0].Value != int32(10) || apis.Fields[1].Value != "api" {
+		t.Errorf("Apis values %v, %v", apis.Fields[0].Value, apis.Fields[1].Value)
+	}
+
+	widget, _ := lib.GetTypeInfo(4)
+	if widget.Super == nil || widget.Super.Name != "IUnknown" {
+		t.Errorf("IWidget super %v", widget.Super)
+	}
+	thing, _ := lib.GetTypeInfo(5)
+	if thing.Super == nil || thing.Super.Name != "IInspectable" {
+		t.Fatalf("IThing super %v", thing.Super)
+	}
+	name := thing.Funcs[0]
+	if !name.Flags.PropGet || name.VtblOffset != 6*utils.PtrSize ||
+		name.Params[0].Name != "result" || !name.Params[0].Flags.Retval {
+		t.Errorf("Name flags %+v, vtbl offset %d, params %+v", name.Flags, name.VtblOffset, name.Params)
+	}
+	fill := thing.Funcs[1]
+	if fill.Params[0].Name != "valuesSize" || fill.Params[1].Name != "values" {
+		t.Errorf("Fill params %s, %s", fill.Params[0].Name, fill.Params[1].Name)
+	}
+}
+
+func TestWinmdImports(t *testing.T) {
+	dir := t.TempDir()
+	for name, data := range map[string][]byte{
+		"Test.Api.winmd":   buildWinmd(),
+		"Test.Other.winmd": buildOtherWinmd(),
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lib, err := ReadTypeLibFile(filepath.Join(dir, "Test.Api.winmd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	widget, _ := lib.GetTypeInfo(4)
+	if p := widget.Funcs[2].Params[0]; p.Type.Name != "SIZE" {
+		t.Errorf("Resize takes %s", p.Type.Name)
+	}
+	size, _ := lib.GetTypeInfo(7)
+	if size.Name != "SIZE" || size.Kind != TKIND_RECORD || len(size.Fields) != 2 || size.Size != 8 {
+		t.Errorf("type 7: %s %v", size.Name, size.Kind)
+	}
+}
+
+func TestWinmdErrors(t *testing.T) {
+	data := buildWinmd()
+	const rootOff = 0x200 + 72 //after the headers and the CLI header
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"no CLI header", testPE()},
+		{"magic", append(append([]byte{}, data[:rootOff]...), make([]byte, len(data)-rootOff)...)},
+		{"truncated", data[:rootOff+0x60]},
+	}
+	for _, test := range tests {
+		if _, err := NewTypeLibFromWinmd(test.data); err == nil {
+			t.Errorf("%s: no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,394 @@
+package typelib
+
+import (
+	"bytes"
+	"debug/pe"
+	"errors"
+)
+
+// Decoder for the ECMA-335 metadata tables (partition II, 22 and 24)
+// found in .winmd files. All the tables are sized since the row layout
+// of one depends on the row counts of the others, but only the ones the
+// winmd reader uses are named.
+
+const (
+	mdModule          = 0x00
+	mdTypeRef         = 0x01
+	mdTypeDef         = 0x02
+	mdField           = 0x04
+	mdMethodDef       = 0x06
+	mdParam           = 0x08
+	mdInterfaceImpl   = 0x09
+	mdMemberRef       = 0x0a
+	mdConstant        = 0x0b
+	mdCustomAttribute = 0x0c
+	mdClassLayout     = 0x0f
+	mdFieldLayout     = 0x10
+	mdModuleRef       = 0x1a
+	mdTypeSpec        = 0x1b
+	mdAssembly        = 0x20
+	mdAssemblyRef     = 0x23
+	mdNestedClass     = 0x29
+	mdGenericParam    = 0x2a
+	mdTableCount      = 0x2d
+)
+
+// column kinds, table indexes are the table number itself
+const (
+	mdU16 = 0x100 + iota
+	mdU32
+	mdStr
+	mdGuid
+	mdBlob
+)
+
+// coded indexes
+const (
+	mdTypeDefOrRef = 0x200 + iota
+	mdHasConstant
+	mdHasCustomAttribute
+	mdHasFieldMarshal
+	mdHasDeclSecurity
+	mdMemberRefParent
+	mdHasSemantics
+	mdMethodDefOrRef
+	mdMemberForwarded
+	mdImplementation
+	mdCustomAttributeType
+	mdResolutionScope
+	mdTypeOrMethodDef
+)
+
+// tables of each coded index in tag order, -1 for unused tags
+var mdCodedTables = [][]int{
+	mdTypeDefOrRef - 0x200:        {mdTypeDef, mdTypeRef, mdTypeSpec},
+	mdHasConstant - 0x200:         {mdField, mdParam, 0x17},
+	mdHasCustomAttribute - 0x200:  {mdMethodDef, mdField, mdTypeRef, mdTypeDef, mdParam, mdInterfaceImpl, mdMemberRef, mdModule, 0x0e, 0x17, 0x14, 0x11, mdModuleRef, mdTypeSpec, mdAssembly, mdAssemblyRef, 0x26, 0x27, 0x28, mdGenericParam, 0x2c, 0x2b},
+	mdHasFieldMarshal - 0x200:     {mdField, mdParam},
+	mdHasDeclSecurity - 0x200:     {mdTypeDef, mdMethodDef, mdAssembly},
+	mdMemberRefParent - 0x200:     {mdTypeDef, mdTypeRef, mdModuleRef, mdMethodDef, mdTypeSpec},
+	mdHasSemantics - 0x200:        {0x14, 0x17},
+	mdMethodDefOrRef - 0x200:      {mdMethodDef, mdMemberRef},
+	mdMemberForwarded - 0x200:     {mdField, mdMethodDef},
+	mdImplementation - 0x200:      {0x26, mdAssemblyRef, 0x27},
+	mdCustomAttributeType - 0x200: {-1, -1, mdMethodDef, mdMemberRef, -1},
+	mdResolutionScope - 0x200:     {mdModule, mdModuleRef, mdAssemblyRef, mdTypeRef},
+	mdTypeOrMethodDef - 0x200:     {mdTypeDef, mdMethodDef},
+}
+
+var mdTableColumns = [mdTableCount][]int{
+	0x00: {mdU16, mdStr, mdGuid, mdGuid, mdGuid},                            //Module
+	0x01: {mdResolutionScope, mdStr, mdStr},                                 //TypeRef
+	0x02: {mdU32, mdStr, mdStr, mdTypeDefOrRef, mdField, mdMethodDef},       //TypeDef
+	0x03: {mdField},                                                         //FieldPtr
+	0x04: {mdU16, mdStr, mdBlob},                                            //Field
+	0x05: {mdMethodDef},                                                     //MethodPtr
+	0x06: {mdU32, mdU16, mdU16, mdStr, mdBlob, mdParam},                     //MethodDef
+	0x07: {mdParam},                                                         //ParamPtr
+	0x08: {mdU16, mdU16, mdStr},                                             //Param
+	0x09: {mdTypeDef, mdTypeDefOrRef},                                       //InterfaceImpl
+	0x0a: {mdMemberRefParent, mdStr, mdBlob},                                //MemberRef
+	0x0b: {mdU16, mdHasConstant, mdBlob},                                    //Constant
+	0x0c: {mdHasCustomAttribute, mdCustomAttributeType, mdBlob},             //CustomAttribute
+	0x0d: {mdHasFieldMarshal, mdBlob},                                       //FieldMarshal
+	0x0e: {mdU16, mdHasDeclSecurity, mdBlob},                                //DeclSecurity
+	0x0f: {mdU16, mdU32, mdTypeDef},                                         //ClassLayout
+	0x10: {mdU32, mdField},                                                  //FieldLayout
+	0x11: {mdBlob},                                                          //StandAloneSig
+	0x12: {mdTypeDef, 0x14},                                                 //EventMap
+	0x13: {0x14},                                                            //EventPtr
+	0x14: {mdU16, mdStr, mdTypeDefOrRef},                                    //Event
+	0x15: {mdTypeDef, 0x17},                                                 //PropertyMap
+	0x16: {0x17},                                                            //PropertyPtr
+	0x17: {mdU16, mdStr, mdBlob},                                            //Property
+	0x18: {mdU16, mdMethodDef, mdHasSemantics},                              //MethodSemantics
+	0x19: {mdTypeDef, mdMethodDefOrRef, mdMethodDefOrRef},                   //MethodImpl
+	0x1a: {mdStr},                                                           //ModuleRef
+	0x1b: {mdBlob},                                                          //TypeSpec
+	0x1c: {mdU16, mdMemberForwarded, mdStr, mdModuleRef},                    //ImplMap
+	0x1d: {mdU32, mdField},                                                  //FieldRVA
+	0x1e: {mdU32, mdU32},                                                    //ENCLog
+	0x1f: {mdU32},                                                           //ENCMap
+	0x20: {mdU32, mdU16, mdU16, mdU16, mdU16, mdU32, mdBlob, mdStr, mdStr},  //Assembly
+	0x21: {mdU32},                                                           //AssemblyProcessor
+	0x22: {mdU32, mdU32, mdU32},                                             //AssemblyOS
+	0x23: {mdU16, mdU16, mdU16, mdU16, mdU32, mdBlob, mdStr, mdStr, mdBlob}, //AssemblyRef
+	0x24: {mdU32, mdAssemblyRef},                                            //AssemblyRefProcessor
+	0x25: {mdU32, mdU32, mdU32, mdAssemblyRef},                              //AssemblyRefOS
+	0x26: {mdU32, mdStr, mdBlob},                                            //File
+	0x27: {mdU32, mdU32, mdStr, mdStr, mdImplementation},                    //ExportedType
+	0x28: {mdU32, mdU32, mdStr, mdImplementation},                           //ManifestResource
+	0x29: {mdTypeDef, mdTypeDef},                                            //NestedClass
+	0x2a: {mdU16, mdU16, mdTypeOrMethodDef, mdStr},                          //GenericParam
+	0x2b: {mdMethodDefOrRef, mdBlob},                                        //MethodSpec
+	0x2c: {mdGenericParam, mdTypeDefOrRef},                                  //GenericParamConstraint
+}
+
+const mdMetadataMagic = 0x424a5342 //"BSJB"
+
+var errWinmdFormat = errors.New("invalid or truncated metadata")
+
+type mdTable struct {
+	rows     int
+	rowSize  int
+	offset   int
+	colOffs  []int
+	colSizes []int
+}
+
+type mdReader struct {
+	dataReader
+
+	stringsOff int
+	blobOff    int
+	guidOff    int
+	tablesOff  int
+
+	tables [mdTableCount]mdTable
+}
+
+// findMetadata returns the offset of the metadata root of a PE image
+// with a CLI header.
+func findMetadata(data []byte) (int, error) {
+	f, err := pe.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	var dir pe.DataDirectory
+	switch oh := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		if oh.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR {
+			dir = oh.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR]
+		}
+	case *pe.OptionalHeader64:
+		if oh.NumberOfRvaAndSizes > pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR {
+			dir = oh.DataDirectory[pe.IMAGE_DIRECTORY_ENTRY_COM_DESCRIPTOR]
+		}
+	}
+	if dir.VirtualAddress == 0 {
+		return 0, errors.New("no CLI metadata in image")
+	}
+	rvaToOffset := func(rva uint32) int {
+		for _, s := range f.Sections {
+			size := s.VirtualSize
+			if s.Size > size {
+				size = s.Size
+			}
+			if rva >= s.VirtualAddress && rva < s.VirtualAddress+size {
+				return int(rva - s.VirtualAddress + s.Offset)
+			}
+		}
+		return -1
+	}
+	r := &dataReader{data: data}
+	cliOff := rvaToOffset(dir.VirtualAddress)
+	mdOff := rvaToOffset(uint32(r.i32(cliOff + 8)))
+	if r.bad || mdOff == -1 {
+		return 0, errWinmdFormat
+	}
+	return mdOff, nil
+}
+
+func readMetadata(data []byte) (*mdReader, error) {
+	rootOff, err := findMetadata(data)
+	if err != nil {
+		return nil, err
+	}
+	r := &mdReader{dataReader: dataReader{data: data}}
+	if uint32(r.i32(rootOff)) != mdMetadataMagic {
+		return nil, errWinmdFormat
+	}
+	verLen := int(r.i32(rootOff + 12))
+	off := rootOff + 16 + verLen
+	streamCount := int(r.u16(off + 2))
+	off += 4
+	for n := 0; n < streamCount && !r.bad; n++ {
+		streamOff := rootOff + int(r.i32(off))
+		off += 8
+		end := off
+		for end < len(data) && data[end] != 0 {
+			end++
+		}
+		name := string(r.bytes(off, end-off))
+		off = (end + 4) &^ 3
+		switch name {
+		case "#~", "#-":
+			r.tablesOff = streamOff
+		case "#Strings":
+			r.stringsOff = streamOff
+		case "#Blob":
+			r.blobOff = streamOff
+		case "#GUID":
+			r.guidOff = streamOff
+		}
+	}
+	if r.bad || r.tablesOff == 0 {
+		return nil, errWinmdFormat
+	}
+	r.readTables()
+	if r.bad {
+		return nil, errWinmdFormat
+	}
+	return r, nil
+}
+
+func (this *mdReader) readTables() {
+	heapSizes := this.u8(this.tablesOff + 6)
+	valid := uint64(uint32(this.i32(this.tablesOff+8))) |
+		uint64(uint32(this.i32(this.tablesOff+12)))<<32
+	off := this.tablesOff + 24
+	for n := 0; n < 64; n++ {
+		if valid&(1<<n) == 0 {
+			continue
+		}
+		if n >= mdTableCount {
+			this.bad = true
+			return
+		}
+		this.tables[n].rows = int(this.i32(off))
+		off += 4
+	}
+	if heapSizes&0x40 != 0 {
+		off += 4 //extra data
+	}
+	for n := range this.tables {
+		t := &this.tables[n]
+		t.offset = off
+		for _, col := range mdTableColumns[n] {
+			size := this.columnSize(col, heapSizes)
+			t.colOffs = append(t.colOffs, t.rowSize)
+			t.colSizes = append(t.colSizes, size)
+			t.rowSize += size
+		}
+		off += t.rows * t.rowSize
+	}
+}
+
+func (this *mdReader) columnSize(col int, heapSizes byte) int {
+	switch {
+	case col < mdTableCount:
+		if this.tables[col].rows > 0xffff {
+			return 4
+		}
+		return 2
+	case col == mdU16:
+		return 2
+	case col == mdU32:
+		return 4
+	case col == mdStr:
+		return 2 + 2*int(heapSizes&0x01)
+	case col == mdGuid:
+		return 2 + int(heapSizes&0x02)
+	case col == mdBlob:
+		return 2 + int(heapSizes&0x04)>>1
+	}
+	tables := mdCodedTables[col-0x200]
+	bits := mdTagBits(len(tables))
+	maxRows := 0
+	for _, t := range tables {
+		if t != -1 && this.tables[t].rows > maxRows {
+			maxRows = this.tables[t].rows
+		}
+	}
+	if maxRows < 1<<(16-bits) {
+		return 2
+	}
+	return 4
+}
+
+func mdTagBits(count int) int {
+	bits := 0
+	for 1<<bits < count {
+		bits++
+	}
+	return bits
+}
+
+func (this *mdReader) rowCount(table int) int {
+	return this.tables[table].rows
+}
+
+// cell reads a column of a row, rows are 1 based
+func (this *mdReader) cell(table int, row int, col int) int {
+	t := &this.tables[table]
+	off := t.offset + (row-1)*t.rowSize + t.colOffs[col]
+	if t.colSizes[col] == 2 {
+		return int(this.u16(off))
+	}
+	return int(uint32(this.i32(off)))
+}
+
+// coded splits a coded index into the table and the row
+func (this *mdReader) coded(kind int, value int) (int, int) {
+	tables := mdCodedTables[kind-0x200]
+	bits := mdTagBits(len(tables))
+	tag := value & (1<<bits - 1)
+	if tag >= len(tables) || tables[tag] == -1 {
+		return -1, 0
+	}
+	return tables[tag], value >> bits
+}
+
+// codedValue builds a coded index
+func codedValue(kind int, table int, row int) int {
+	tables := mdCodedTables[kind-0x200]
+	for tag, t := range tables {
+		if t == table {
+			return row<<mdTagBits(len(tables)) | tag
+		}
+	}
+	return -1
+}
+
+// listEnd returns the end (exclusive) of the run of rows of target
+// that starts at the list column of a row.
+func (this *mdReader) listEnd(table int, row int, col int, target int) int {
+	if row < this.rowCount(table) {
+		return this.cell(table, row+1, col)
+	}
+	return this.rowCount(target) + 1
+}
+
+func (this *mdReader) str(index int) string {
+	off := this.stringsOff + index
+	end := off
+	for end < len(this.data) && this.data[end] != 0 {
+		end++
+	}
+	return string(this.bytes(off, end-off))
+}
+
+func (this *mdReader) guid(index int) GUID {
+	if index == 0 {
+		return GUID{}
+	}
+	return this.guidAt(this.guidOff + (index-1)*16)
+}
+
+func (this *mdReader) blob(index int) []byte {
+	off := this.blobOff + index
+	if off < 0 || off >= len(this.data) {
+		this.bad = true
+		return nil
+	}
+	size, n := mdUncompress(this.data[off:])
+	return this.bytes(off+n, size)
+}
+
+// mdUncompress decodes a compressed unsigned integer, returning the
+// value and the number of bytes read.
+func mdUncompress(b []byte) (int, int) {
+	if len(b) == 0 {
+		return 0, 0
+	}
+	switch {
+	case b[0]&0x80 == 0:
+		return int(b[0]), 1
+	case b[0]&0xc0 == 0x80 && len(b) >= 2:
+		return int(b[0]&0x3f)<<8 | int(b[1]), 2
+	case b[0]&0xe0 == 0xc0 && len(b) >= 4:
+		return int(b[0]&0x1f)<<24 | int(b[1])<<16 | int(b[2])<<8 | int(b[3]), 4
+	}
+	return 0, len(b)
+}
@@ -2,12 +2,50 @@ package utils
 
 import (
 	"os"
+	"runtime"
 	"strconv"
 	"strings"
 	"unsafe"
 )
 
-const PtrSize = int(unsafe.Sizeof(uintptr(0)))
+// HostPtrSize is the pointer size of the running process
+const HostPtrSize = int(unsafe.Sizeof(uintptr(0)))
+
+// Arch is the GOARCH code is generated for, and PtrSize its pointer
+// size. Both are set by SetArch, the layouts computed depend on them.
+var Arch = runtime.GOARCH
+var PtrSize = HostPtrSize
+
+// Archs are the GOARCHs code can be generated for
+var Archs = []string{"386", "amd64", "arm64"}
+
+// ArchPtrSize returns the pointer size of a GOARCH of Archs, 0 if unknown
+func ArchPtrSize(arch string) int {
+	switch arch {
+	case "386":
+		return 4
+	case "amd64", "arm64":
+		return 8
+	}
+	return 0
+}
+
+func SetArch(arch string) {
+	Arch = arch
+	PtrSize = ArchPtrSize(arch)
+}
+
+// GoAlign returns the alignment Go gives a value that C aligns to align,
+// on the arch code is generated for: Go aligns 8 byte numbers to 4 on 386
+func GoAlign(align int) int {
+	if align == 0 {
+		return 1
+	}
+	if align > PtrSize {
+		return PtrSize
+	}
+	return align
+}
 
 func UncapName(name string) string {
 	name2 := ""
@@ -41,7 +79,10 @@ func CapName(name string) string {
 }
 
 func SafeGoName(name string) string {
-	reservedNames := []string{"type", "var", "range", "map"}
+	reservedNames := []string{"type", "var", "range", "map", "package", "import",
+		"func", "interface", "struct", "chan", "select", "go", "defer", "goto",
+		"fallthrough", "default", "case", "switch", "break", "continue",
+		"return", "const", "else", "for", "if"}
 	for _, it := range reservedNames {
 		if name == it {
 			return name + "_"